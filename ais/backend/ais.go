@@ -384,6 +384,11 @@ func (*AISBackendProvider) CreateBucket(_ *meta.Bck) (errCode int, err error) {
 	return 0, nil
 }
 
+func (*AISBackendProvider) DestroyBucket(_ *meta.Bck) (errCode int, err error) {
+	debug.Assert(false) // ditto
+	return 0, nil
+}
+
 // TODO: remote AIS clusters provide native frontend API with additional capabilities
 // that, in particular, include `dontAddRemote` = (true | false).
 // Here we have to hardcode the value to keep HeadBucket() consistent across all backends.
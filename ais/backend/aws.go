@@ -81,8 +81,54 @@ func (*awsProvider) MaxPageSize() uint { return apc.DefaultPageSizeCloud }
 // CREATE BUCKET
 //
 
-func (*awsProvider) CreateBucket(_ *meta.Bck) (int, error) {
-	return http.StatusNotImplemented, cmn.NewErrNotImpl("create", "s3:// bucket")
+// NOTE: region (if any) comes from bucket props `Extra.AWS.CloudRegion`;
+// S3 has no bucket-level storage class (the latter is per object)
+func (*awsProvider) CreateBucket(bck *meta.Bck) (int, error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		input    = &s3.CreateBucketInput{Bucket: aws.String(cloudBck.Name)}
+	)
+	svc, region, err := newClient(sessConf{bck: cloudBck}, "") // (no region: S3 default)
+	if err != nil {
+		return awsErrorToAISError(err, cloudBck, "")
+	}
+	// us-east-1 is the default and must not be specified as location constraint
+	if region != "" && region != endpoints.UsEast1RegionID {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{LocationConstraint: aws.String(region)}
+	}
+	if _, err := svc.CreateBucket(input); err != nil {
+		if reqErr, ok := err.(awserr.RequestFailure); ok {
+			switch reqErr.Code() {
+			case s3.ErrCodeBucketAlreadyExists, s3.ErrCodeBucketAlreadyOwnedByYou:
+				return http.StatusConflict, cmn.NewErrBckAlreadyExists(cloudBck)
+			}
+		}
+		return awsErrorToAISError(err, cloudBck, "")
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[create_bucket]", cloudBck.Cname(""), region)
+	}
+	return 0, nil
+}
+
+//
+// DESTROY BUCKET
+//
+
+// NOTE: S3 requires the bucket to be empty
+func (*awsProvider) DestroyBucket(bck *meta.Bck) (int, error) {
+	cloudBck := bck.RemoteBck()
+	svc, _, err := newClient(sessConf{bck: cloudBck}, "[destroy_bucket]")
+	if err != nil && cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Warningln(err)
+	}
+	if _, err := svc.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(cloudBck.Name)}); err != nil {
+		return awsErrorToAISError(err, cloudBck, "")
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[destroy_bucket]", cloudBck.Cname(""))
+	}
+	return 0, nil
 }
 
 //
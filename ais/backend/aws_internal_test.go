@@ -0,0 +1,137 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const s3ErrFmt = `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`
+
+// minimal S3: create, destroy, and (non-)empty buckets
+type mockS3 struct {
+	buckets map[string]int    // bucket => number of objects
+	regions map[string]string // bucket => location constraint
+	mu      sync.Mutex
+}
+
+func (m *mockS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := strings.Trim(r.URL.Path, "/")
+	n, exists := m.buckets[name]
+	switch r.Method {
+	case http.MethodPut:
+		if exists {
+			m.fail(w, http.StatusConflict, s3.ErrCodeBucketAlreadyOwnedByYou)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		if i := strings.Index(string(b), "<LocationConstraint>"); i >= 0 {
+			region := string(b[i+len("<LocationConstraint>"):])
+			m.regions[name] = region[:strings.IndexByte(region, '<')]
+		}
+		m.buckets[name] = 0
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		switch {
+		case !exists:
+			m.fail(w, http.StatusNotFound, s3.ErrCodeNoSuchBucket)
+		case n > 0:
+			m.fail(w, http.StatusConflict, "BucketNotEmpty")
+		default:
+			delete(m.buckets, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (*mockS3) fail(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, s3ErrFmt, code, code)
+}
+
+func _mockS3(t *testing.T, region string) (*mockS3, *awsProvider, func(string) *meta.Bck) {
+	m := &mockS3{buckets: make(map[string]int), regions: make(map[string]string)}
+	srv := httptest.NewServer(m)
+	t.Cleanup(srv.Close)
+
+	bp, err := NewAWS(nil)
+	tassert.CheckFatal(t, err)
+
+	// pre-populate the client cache (see newClient) - path-style, static credentials
+	sess := session.Must(session.NewSession(&aws.Config{
+		Endpoint:         aws.String(srv.URL),
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	clients[_cid("", region, srv.URL)] = s3.New(sess)
+
+	newBck := func(name string) *meta.Bck {
+		props := &cmn.Bprops{Extra: cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{CloudRegion: region, Endpoint: srv.URL}}}
+		return meta.NewBck(name, apc.AWS, cmn.NsGlobal, props)
+	}
+	return m, bp.(*awsProvider), newBck
+}
+
+func TestAWSCreateDestroyBucket(t *testing.T) {
+	m, ap, newBck := _mockS3(t, "us-west-2")
+	bck := newBck("abc")
+
+	_, err := ap.CreateBucket(bck)
+	tassert.CheckFatal(t, err)
+	_, exists := m.buckets["abc"]
+	tassert.Fatalf(t, exists, "expected bucket to be created")
+	tassert.Errorf(t, m.regions["abc"] == "us-west-2", "expected location constraint %q, got %q", "us-west-2", m.regions["abc"])
+
+	errCode, err := ap.CreateBucket(bck)
+	tassert.Fatalf(t, err != nil && errCode == http.StatusConflict, "expected %d, got %d (%v)", http.StatusConflict, errCode, err)
+	tassert.Errorf(t, cmn.IsErrBucketAlreadyExists(err), "expected bucket-already-exists, got %v", err)
+
+	_, err = ap.DestroyBucket(bck)
+	tassert.CheckFatal(t, err)
+	_, exists = m.buckets["abc"]
+	tassert.Fatalf(t, !exists, "expected bucket to be destroyed")
+
+	errCode, err = ap.DestroyBucket(bck)
+	tassert.Fatalf(t, err != nil && errCode == http.StatusNotFound, "expected %d, got %d (%v)", http.StatusNotFound, errCode, err)
+	tassert.Errorf(t, cmn.IsErrRemoteBckNotFound(err), "expected remote-bucket-not-found, got %v", err)
+}
+
+func TestAWSDestroyNonEmptyBucket(t *testing.T) {
+	m, ap, newBck := _mockS3(t, "us-east-1")
+	bck := newBck("nonempty")
+
+	_, err := ap.CreateBucket(bck)
+	tassert.CheckFatal(t, err)
+	m.buckets["nonempty"] = 1
+
+	errCode, err := ap.DestroyBucket(bck)
+	tassert.Fatalf(t, err != nil && errCode == http.StatusConflict, "expected %d, got %d (%v)", http.StatusConflict, errCode, err)
+	_, exists := m.buckets["nonempty"]
+	tassert.Errorf(t, exists, "non-empty bucket must remain intact")
+	tassert.Errorf(t, m.regions["nonempty"] == "", "us-east-1 must not be specified as location constraint")
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -195,8 +196,47 @@ func (*azureProvider) MaxPageSize() uint { return 5000 }
 // CREATE BUCKET
 //
 
-func (*azureProvider) CreateBucket(_ *meta.Bck) (int, error) {
-	return http.StatusNotImplemented, cmn.NewErrNotImpl("create", "azure:// bucket")
+func (ap *azureProvider) CreateBucket(bck *meta.Bck) (int, error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
+	)
+	if _, err := cntURL.Create(azctx, azblob.Metadata{}, azblob.PublicAccessNone); err != nil {
+		if stgErr, ok := err.(azblob.StorageError); ok && stgErr.ServiceCode() == azblob.ServiceCodeContainerAlreadyExists {
+			return http.StatusConflict, cmn.NewErrBckAlreadyExists(cloudBck)
+		}
+		return azureErrorToAISError(err, cloudBck, "")
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[create_bucket]", cloudBck.Cname(""))
+	}
+	return 0, nil
+}
+
+//
+// DESTROY BUCKET
+//
+
+// unlike S3 and GCS, Azure deletes non-empty containers - hence, the explicit check
+func (ap *azureProvider) DestroyBucket(bck *meta.Bck) (int, error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
+	)
+	resp, err := cntURL.ListBlobsFlatSegment(azctx, azblob.Marker{}, azblob.ListBlobsSegmentOptions{MaxResults: 1})
+	if err != nil {
+		return azureErrorToAISError(err, cloudBck, "")
+	}
+	if len(resp.Segment.BlobItems) > 0 {
+		return http.StatusConflict, fmt.Errorf("cannot destroy %s: container is not empty", cloudBck.Cname(""))
+	}
+	if _, err := cntURL.Delete(azctx, azblob.ContainerAccessConditions{}); err != nil {
+		return azureErrorToAISError(err, cloudBck, "")
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[destroy_bucket]", cloudBck.Cname(""))
+	}
+	return 0, nil
 }
 
 //
@@ -123,8 +123,45 @@ func (*gcpProvider) MaxPageSize() uint { return apc.DefaultPageSizeCloud }
 // CREATE BUCKET
 //
 
-func (*gcpProvider) CreateBucket(_ *meta.Bck) (int, error) {
-	return http.StatusNotImplemented, cmn.NewErrNotImpl("create", "gs:// bucket")
+// NOTE: location and storage class (if any) come from bucket props `Extra.GCP`
+func (gcpp *gcpProvider) CreateBucket(bck *meta.Bck) (int, error) {
+	if gcpp.projectID == "" {
+		return http.StatusBadRequest, fmt.Errorf("cannot create %s: %s is required", bck.Cname(""), projectIDField)
+	}
+	var (
+		attrs    = &storage.BucketAttrs{}
+		cloudBck = bck.RemoteBck()
+	)
+	if bck.Props != nil {
+		attrs.Location = bck.Props.Extra.GCP.Location
+		attrs.StorageClass = bck.Props.Extra.GCP.StorageClass
+	}
+	if err := gcpClient.Bucket(cloudBck.Name).Create(gctx, gcpp.projectID, attrs); err != nil {
+		if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == http.StatusConflict {
+			return http.StatusConflict, cmn.NewErrBckAlreadyExists(cloudBck)
+		}
+		return gcpErrorToAISError(err, cloudBck)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[create_bucket]", cloudBck.Cname(""), attrs.Location, attrs.StorageClass)
+	}
+	return 0, nil
+}
+
+//
+// DESTROY BUCKET
+//
+
+// NOTE: GCS requires the bucket to be empty
+func (*gcpProvider) DestroyBucket(bck *meta.Bck) (int, error) {
+	cloudBck := bck.RemoteBck()
+	if err := gcpClient.Bucket(cloudBck.Name).Delete(gctx); err != nil {
+		return gcpErrorToAISError(err, cloudBck)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[destroy_bucket]", cloudBck.Cname(""))
+	}
+	return 0, nil
 }
 
 //
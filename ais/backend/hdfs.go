@@ -91,6 +91,10 @@ func (hp *hdfsProvider) CreateBucket(bck *meta.Bck) (errCode int, err error) {
 	return hp.checkDirectoryExists(bck)
 }
 
+func (*hdfsProvider) DestroyBucket(bck *meta.Bck) (int, error) {
+	return http.StatusBadRequest, cmn.NewErrUnsupp("destroy", bck.Cname("")+" (HDFS directory)")
+}
+
 func (hp *hdfsProvider) checkDirectoryExists(bck *meta.Bck) (errCode int, err error) {
 	debug.Assert(bck.Props != nil)
 	refDirectory := bck.Props.Extra.HDFS.RefDirectory
@@ -51,6 +51,10 @@ func (hp *httpProvider) CreateBucket(*meta.Bck) (int, error) {
 	return http.StatusNotImplemented, cmn.NewErrNotImpl("create", hp.Provider()+" bucket")
 }
 
+func (hp *httpProvider) DestroyBucket(*meta.Bck) (int, error) {
+	return http.StatusNotImplemented, cmn.NewErrNotImpl("destroy", hp.Provider()+" bucket")
+}
+
 func (hp *httpProvider) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error) {
 	// TODO: we should use `bck.RemoteBck()`.
 
@@ -85,11 +89,6 @@ func (hp *httpProvider) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps
 	return
 }
 
-func (*httpProvider) ListObjects(*meta.Bck, *apc.LsoMsg, *cmn.LsoResult) (errCode int, err error) {
-	debug.Assert(false)
-	return
-}
-
 func (*httpProvider) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error) {
 	debug.Assert(false)
 	return
@@ -208,10 +207,78 @@ func (hp *httpProvider) GetObjReader(ctx context.Context, lom *core.LOM, offset,
 	return res
 }
 
-func (*httpProvider) PutObj(io.ReadCloser, *core.LOM) (int, error) {
-	return http.StatusBadRequest, cmn.NewErrUnsupp("PUT", " objects => HTTP backend")
+//
+// write-through (requires `extra.http.writable`)
+//
+
+func (hp *httpProvider) PutObj(r io.ReadCloser, lom *core.LOM) (int, error) {
+	bck := lom.Bck()
+	if !bck.Props.Extra.HTTP.Writable {
+		cos.Close(r)
+		return http.StatusBadRequest, cmn.NewErrUnsupp("PUT", " objects => HTTP backend (not writable)")
+	}
+	origURL, err := getOriginalURL(context.Background(), bck, lom.ObjName)
+	if err != nil {
+		cos.Close(r)
+		return http.StatusBadRequest, err
+	}
+	req, err := http.NewRequest(http.MethodPut, origURL, r)
+	if err != nil {
+		cos.Close(r)
+		return http.StatusInternalServerError, err
+	}
+	req.ContentLength = lom.SizeBytes()
+	resp, err := hp.client(origURL).Do(req) // (closes r)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		return resp.StatusCode, fmt.Errorf("PUT(%s) failed, status %d", origURL, resp.StatusCode)
+	}
+
+	lom.SetCustomKey(cmn.SourceObjMD, apc.HTTP)
+	lom.SetCustomKey(cmn.OrigURLObjMD, origURL)
+	if v, ok := cmn.BackendHelpers.HTTP.EncodeVersion(resp.Header.Get(cos.HdrETag)); ok {
+		lom.SetCustomKey(cmn.ETag, v)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infof("[put_object] %s => %q", lom, origURL)
+	}
+	return 0, nil
 }
 
-func (*httpProvider) DeleteObj(*core.LOM) (int, error) {
-	return http.StatusBadRequest, cmn.NewErrUnsupp("DELETE", " objects from HTTP backend")
+func (hp *httpProvider) DeleteObj(lom *core.LOM) (int, error) {
+	bck := lom.Bck()
+	if !bck.Props.Extra.HTTP.Writable {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("DELETE", " objects from HTTP backend (not writable)")
+	}
+	origURL, err := getOriginalURL(context.Background(), bck, lom.ObjName)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	req, err := http.NewRequest(http.MethodDelete, origURL, http.NoBody)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	resp, err := hp.client(origURL).Do(req)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound:
+		return resp.StatusCode, cos.NewErrNotFound(core.T, lom.Cname())
+	default:
+		return resp.StatusCode, fmt.Errorf("DELETE(%s) failed, status %d", origURL, resp.StatusCode)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infof("[delete_object] %s (%q)", lom, origURL)
+	}
+	return 0, nil
 }
@@ -0,0 +1,281 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// List ht:// bucket - the origin's directory tree - via either WebDAV PROPFIND or
+// by parsing HTML index pages (e.g., nginx `autoindex`, Apache `mod_autoindex`).
+// Listing method is configured per bucket (`extra.http.listing`).
+// Similar to POSIX, the tree is walked depth-first in lexicographical order of the
+// object names, starting from the continuation token - previously listed subtrees are
+// skipped (not fetched), and the walk stops as soon as the page is full.
+
+const (
+	htMaxDepth    = 64       // against link loops
+	htMaxIndexLen = 64 << 20 // max size of an HTML index page
+)
+
+const davPropfind = `<?xml version="1.0" encoding="utf-8"?>
+<propfind xmlns="DAV:"><prop><resourcetype/><getcontentlength/><getetag/><getlastmodified/></prop></propfind>`
+
+type (
+	htEntry struct {
+		name  string
+		etag  string
+		mtime string
+		size  int64
+	}
+	htWalk struct {
+		hp      *httpProvider
+		msg     *apc.LsoMsg
+		listing string
+		marker  string // max(continuation token, start-after)
+		entries []htEntry
+	}
+
+	// WebDAV multistatus response (RFC 4918)
+	davMultistatus struct {
+		Responses []davResponse `xml:"response"`
+	}
+	davResponse struct {
+		Href     string        `xml:"href"`
+		Propstat []davPropstat `xml:"propstat"`
+	}
+	davPropstat struct {
+		Prop   davProp `xml:"prop"`
+		Status string  `xml:"status"`
+	}
+	davProp struct {
+		ResourceType struct {
+			Collection *struct{} `xml:"collection"`
+		} `xml:"resourcetype"`
+		ContentLength string `xml:"getcontentlength"`
+		ETag          string `xml:"getetag"`
+		LastModified  string `xml:"getlastmodified"`
+	}
+)
+
+var reHref = regexp.MustCompile(`(?i)<a\s[^>]*href\s*=\s*["']([^"'#?]+)["']`)
+
+func (hp *httpProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	var (
+		h    = cmn.BackendHelpers.HTTP
+		conf = &bck.Props.Extra.HTTP
+	)
+	if conf.Listing == "" {
+		return http.StatusNotImplemented,
+			cmn.NewErrNotImpl("list", bck.Cname("")+" objects (extra.http.listing not configured)")
+	}
+	base, err := url.Parse(conf.OrigURLBck)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if base.Path == "" || !cos.IsLastB(base.Path, '/') {
+		base.Path += "/"
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, hp.MaxPageSize())
+
+	w := &htWalk{hp: hp, msg: msg, listing: conf.Listing, marker: max(msg.ContinuationToken, msg.StartAfter)}
+	if err := w.walk(base, "", 0); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	n := len(w.entries)
+	for k := len(lst.Entries); k < n; k++ {
+		lst.Entries = append(lst.Entries, &cmn.LsoEntry{}) // add missing empty
+	}
+	for k := 0; k < n; k++ {
+		e, entry := &w.entries[k], lst.Entries[k]
+		*entry = cmn.LsoEntry{Name: e.name, Size: e.size}
+		if msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize) {
+			continue
+		}
+		if v, ok := h.EncodeVersion(e.etag); ok {
+			entry.Checksum = v
+		}
+		if msg.WantProp(apc.GetPropsCustom) && (e.etag != "" || e.mtime != "") {
+			custom := cos.StrKVs{}
+			if entry.Checksum != "" {
+				custom[cmn.ETag] = entry.Checksum
+			}
+			if e.mtime != "" {
+				custom[cmn.LastModified] = e.mtime
+			}
+			entry.Custom = cmn.CustomMD2S(custom)
+		}
+	}
+	lst.Entries = lst.Entries[:n]
+	// set continuation token only if we reached the page size
+	if uint(n) >= msg.PageSize {
+		lst.ContinuationToken = w.entries[n-1].name
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", bck.Cname(""), conf.Listing, len(lst.Entries))
+	}
+	return 0, nil
+}
+
+func (w *htWalk) walk(dir *url.URL, dirName string, depth int) error {
+	if depth > htMaxDepth {
+		return fmt.Errorf("%s: max listing depth (%d) exceeded", dir, htMaxDepth)
+	}
+	var (
+		files   []htEntry
+		subdirs []string
+		err     error
+	)
+	if w.listing == cmn.HTTPListingWebDAV {
+		files, subdirs, err = w.hp.propfind(dir)
+	} else {
+		files, subdirs, err = w.hp.index(dir)
+	}
+	if err != nil {
+		return err
+	}
+	// NOTE: directories are sorted as if their names were terminated by '/' (see posixWalk)
+	for _, sub := range subdirs {
+		files = append(files, htEntry{name: sub + "/"})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+
+	for _, f := range files {
+		if uint(len(w.entries)) >= w.msg.PageSize {
+			return nil
+		}
+		name := dirName + f.name
+		if cos.IsLastB(name, '/') {
+			if !cmn.DirHasOrIsPrefix(name, w.msg.Prefix) {
+				continue
+			}
+			// skip entire subtree that's been already listed
+			if w.marker != "" && name <= w.marker && !strings.HasPrefix(w.marker, name) {
+				continue
+			}
+			u := *dir
+			u.Path = dir.Path + f.name
+			u.RawPath = ""
+			if err := w.walk(&u, name, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+		if !cmn.ObjHasPrefix(name, w.msg.Prefix) || (w.marker != "" && name <= w.marker) {
+			continue
+		}
+		f.name = name
+		w.entries = append(w.entries, f)
+	}
+	return nil
+}
+
+// returns the name of the `dir`'s immediate child, if it is one
+func htChild(dir *url.URL, href string) (name string, isDir, ok bool) {
+	u, err := dir.Parse(href)
+	if err != nil || u.Host != dir.Host || !strings.HasPrefix(u.Path, dir.Path) {
+		return
+	}
+	name = strings.TrimPrefix(u.Path, dir.Path)
+	if isDir = name != "" && cos.IsLastB(name, '/'); isDir {
+		name = name[:len(name)-1]
+	}
+	ok = name != "" && !strings.Contains(name, "/") && name != "." && name != ".."
+	return
+}
+
+// WebDAV: PROPFIND Depth: 1
+func (hp *httpProvider) propfind(dir *url.URL) (files []htEntry, subdirs []string, _ error) {
+	req, err := http.NewRequest("PROPFIND", dir.String(), strings.NewReader(davPropfind))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Depth", "1")
+	req.Header.Set(cos.HdrContentType, "application/xml")
+	resp, err := hp.client(dir.String()).Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, nil, fmt.Errorf("PROPFIND(%s) failed, status %d", dir, resp.StatusCode)
+	}
+	var ms davMultistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, nil, fmt.Errorf("PROPFIND(%s): failed to parse response: %v", dir, err)
+	}
+	for _, r := range ms.Responses {
+		name, isDir, ok := htChild(dir, r.Href)
+		if !ok {
+			continue // (self)
+		}
+		var prop *davProp
+		for i := range r.Propstat {
+			if strings.Contains(r.Propstat[i].Status, " 200 ") {
+				prop = &r.Propstat[i].Prop
+				break
+			}
+		}
+		if prop != nil && prop.ResourceType.Collection != nil {
+			isDir = true
+		}
+		if isDir {
+			subdirs = append(subdirs, name)
+			continue
+		}
+		e := htEntry{name: name}
+		if prop != nil {
+			e.size, _ = strconv.ParseInt(strings.TrimSpace(prop.ContentLength), 10, 64)
+			e.etag, e.mtime = prop.ETag, prop.LastModified
+		}
+		files = append(files, e)
+	}
+	return files, subdirs, nil
+}
+
+// HTML index page: links to the immediate children (directories end with '/'); no sizes
+func (hp *httpProvider) index(dir *url.URL) (files []htEntry, subdirs []string, _ error) {
+	resp, err := hp.client(dir.String()).Get(dir.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("GET(%s) index failed, status %d", dir, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, htMaxIndexLen))
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := make(cos.StrSet, 16)
+	for _, m := range reHref.FindAllSubmatch(b, -1) {
+		name, isDir, ok := htChild(dir, string(m[1]))
+		if !ok || seen.Contains(name) {
+			continue
+		}
+		seen.Add(name)
+		if isDir {
+			subdirs = append(subdirs, name)
+		} else {
+			files = append(files, htEntry{name: name})
+		}
+	}
+	return files, subdirs, nil
+}
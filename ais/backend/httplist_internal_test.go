@@ -0,0 +1,155 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// origin that serves both HTML index pages and WebDAV PROPFIND for a given
+// set of files; counts directory fetches
+type htOrigin struct {
+	files   []string
+	fetches map[string]int
+	mu      sync.Mutex
+}
+
+// immediate children of a given directory (e.g. "/", "/d/")
+func (o *htOrigin) children(dir string) (files, subdirs []string) {
+	seen := make(map[string]bool)
+	for _, f := range o.files {
+		p := "/" + f
+		if !strings.HasPrefix(p, dir) {
+			continue
+		}
+		rest := p[len(dir):]
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			if sub := rest[:i]; !seen[sub] {
+				seen[sub] = true
+				subdirs = append(subdirs, sub)
+			}
+			continue
+		}
+		files = append(files, rest)
+	}
+	return files, subdirs
+}
+
+func (o *htOrigin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.mu.Lock()
+	o.fetches[r.URL.Path]++
+	o.mu.Unlock()
+	files, subdirs := o.children(r.URL.Path)
+	if len(files) == 0 && len(subdirs) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var sb strings.Builder
+	if r.Method == "PROPFIND" {
+		sb.WriteString(`<?xml version="1.0"?><D:multistatus xmlns:D="DAV:">`)
+		fmt.Fprintf(&sb, `<D:response><D:href>%s</D:href><D:propstat><D:prop><D:resourcetype><D:collection/></D:resourcetype></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>`, r.URL.Path)
+		for _, f := range files {
+			fmt.Fprintf(&sb, `<D:response><D:href>%s%s</D:href><D:propstat><D:prop><D:resourcetype/><D:getcontentlength>%d</D:getcontentlength></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>`,
+				r.URL.Path, f, len(f))
+		}
+		for _, sub := range subdirs {
+			fmt.Fprintf(&sb, `<D:response><D:href>%s%s/</D:href><D:propstat><D:prop><D:resourcetype><D:collection/></D:resourcetype></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>`,
+				r.URL.Path, sub)
+		}
+		sb.WriteString(`</D:multistatus>`)
+		w.WriteHeader(http.StatusMultiStatus)
+	} else {
+		sb.WriteString(`<html><body><a href="../">../</a>`)
+		for _, sub := range subdirs {
+			fmt.Fprintf(&sb, `<a href="%s/">%s/</a>`, sub, sub)
+		}
+		for _, f := range files {
+			fmt.Fprintf(&sb, `<a href="%s">%s</a>`, f, f)
+		}
+		sb.WriteString(`</body></html>`)
+	}
+	w.Write([]byte(sb.String()))
+}
+
+func _htList(t *testing.T, hp *httpProvider, bck *meta.Bck, msg *apc.LsoMsg) (names []string, pages int) {
+	for {
+		lst := &cmn.LsoResult{}
+		_, err := hp.ListObjects(bck, msg, lst)
+		tassert.CheckFatal(t, err)
+		pages++
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+		}
+		if lst.ContinuationToken == "" {
+			return names, pages
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func TestHTTPListObjects(t *testing.T) {
+	files := []string{"a", "d/b", "d/e/c", "d/e/f", "d-x", "z/y/x"}
+	expected := append([]string{}, files...)
+	sort.Strings(expected)
+
+	for _, listing := range []string{cmn.HTTPListingIndex, cmn.HTTPListingWebDAV} {
+		origin := &htOrigin{files: files, fetches: make(map[string]int)}
+		srv := httptest.NewServer(origin)
+		hp := &httpProvider{cliH: srv.Client()}
+		props := &cmn.Bprops{Extra: cmn.ExtraProps{HTTP: cmn.ExtraPropsHTTP{OrigURLBck: srv.URL, Listing: listing}}}
+		bck := meta.NewBck("ht", apc.HTTP, cmn.NsGlobal, props)
+
+		for _, pageSize := range []uint{0, 1, 2, 4} {
+			names, _ := _htList(t, hp, bck, &apc.LsoMsg{PageSize: pageSize})
+			tassert.Fatalf(t, strings.Join(names, ",") == strings.Join(expected, ","),
+				"%s, page size %d: expected %v, got %v", listing, pageSize, expected, names)
+		}
+
+		// prefix
+		names, _ := _htList(t, hp, bck, &apc.LsoMsg{Prefix: "d/e"})
+		tassert.Fatalf(t, strings.Join(names, ",") == "d/e/c,d/e/f", "%s: prefix: got %v", listing, names)
+
+		// continuation: already listed subtrees must not be fetched again
+		clear(origin.fetches)
+		names, _ = _htList(t, hp, bck, &apc.LsoMsg{PageSize: 1, ContinuationToken: "d/e/f"})
+		tassert.Fatalf(t, strings.Join(names, ",") == "z/y/x", "%s: continuation: got %v", listing, names)
+		tassert.Errorf(t, origin.fetches["/d/"] == 1 && origin.fetches["/d/e/"] == 1,
+			"%s: expected single fetch of the marker's path, got %v", listing, origin.fetches)
+
+		names, _ = _htList(t, hp, bck, &apc.LsoMsg{StartAfter: "d/b"})
+		tassert.Fatalf(t, strings.Join(names, ",") == "d/e/c,d/e/f,z/y/x", "%s: start-after: got %v", listing, names)
+		srv.Close()
+	}
+}
+
+func TestHTTPListObjectsPageStopsWalk(t *testing.T) {
+	files := []string{"a/1", "b/2", "c/3", "d/4"}
+	origin := &htOrigin{files: files, fetches: make(map[string]int)}
+	srv := httptest.NewServer(origin)
+	defer srv.Close()
+
+	hp := &httpProvider{cliH: srv.Client()}
+	props := &cmn.Bprops{Extra: cmn.ExtraProps{HTTP: cmn.ExtraPropsHTTP{OrigURLBck: srv.URL, Listing: cmn.HTTPListingIndex}}}
+	bck := meta.NewBck("ht", apc.HTTP, cmn.NsGlobal, props)
+
+	lst := &cmn.LsoResult{}
+	_, err := hp.ListObjects(bck, &apc.LsoMsg{PageSize: 2}, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 2 && lst.ContinuationToken == "b/2", "unexpected page: %d, %q",
+		len(lst.Entries), lst.ContinuationToken)
+	tassert.Errorf(t, origin.fetches["/c/"] == 0 && origin.fetches["/d/"] == 0,
+		"expected walk to stop once the page is full, got %v", origin.fetches)
+}
@@ -35,6 +35,10 @@ func (*mockBP) CreateBucket(*meta.Bck) (int, error) {
 	return http.StatusBadRequest, cmn.NewErrUnsupp("create", mock+" bucket")
 }
 
+func (*mockBP) DestroyBucket(*meta.Bck) (int, error) {
+	return http.StatusBadRequest, cmn.NewErrUnsupp("destroy", mock+" bucket")
+}
+
 func (*mockBP) HeadBucket(_ ctx, bck *meta.Bck) (cos.StrKVs, int, error) {
 	return cos.StrKVs{}, http.StatusNotFound, cmn.NewErrRemoteBckOffline(bck.Bucket())
 }
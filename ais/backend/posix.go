@@ -0,0 +1,416 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// POSIX backend: bucket is a directory (`extra.posix.root_dir`) on a locally
+// mounted filesystem (e.g., NFS, Lustre) accessible by all targets;
+// object name is the file's pathname relative to the root directory, and object
+// version is the file's mtime (in nanoseconds)
+
+// (PUT) work files are written next to their destinations and are not listed
+const posixWorkPrefix = ".ais-work."
+
+type (
+	posixProvider struct {
+		t core.TargetPut
+	}
+	// (list-objects) depth-first walk in lexicographical order of the object names
+	posixWalk struct {
+		msg    *apc.LsoMsg
+		lst    *cmn.LsoResult
+		marker string // max(continuation token, start-after)
+		idx    int
+	}
+)
+
+// interface guard
+var _ core.BackendProvider = (*posixProvider)(nil)
+
+func NewPOSIX(t core.TargetPut) core.BackendProvider { return &posixProvider{t: t} }
+
+func (*posixProvider) Provider() string  { return apc.POSIX }
+func (*posixProvider) MaxPageSize() uint { return 10000 }
+
+func posixErrorToAISError(err error) (int, error) {
+	switch {
+	case os.IsNotExist(err):
+		return http.StatusNotFound, err
+	case os.IsExist(err):
+		return http.StatusConflict, err
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	default:
+		return http.StatusInternalServerError, err
+	}
+}
+
+func posixVersion(fi os.FileInfo) string { return strconv.FormatInt(fi.ModTime().UnixNano(), 10) }
+
+// resolve object's pathname while making sure it doesn't escape bucket's root
+func posixPath(lom *core.LOM) (string, int, error) {
+	root := lom.Bck().Props.Extra.POSIX.RootDir
+	debug.Assert(root != "")
+	fqn := filepath.Join(root, lom.ObjName)
+	if !strings.HasPrefix(fqn, filepath.Clean(root)+string(filepath.Separator)) {
+		return "", http.StatusBadRequest, fmt.Errorf("invalid object name %q (resolves outside %s root %q)",
+			lom.ObjName, apc.DisplayProvider(apc.POSIX), root)
+	}
+	return fqn, 0, nil
+}
+
+// same as above for the list-objects prefix (the directory part thereof)
+func posixDir(root, dirPrefix string) (string, int, error) {
+	root = filepath.Clean(root)
+	dir := filepath.Join(root, dirPrefix)
+	if dir != root && !strings.HasPrefix(dir, root+string(filepath.Separator)) {
+		return "", http.StatusBadRequest, fmt.Errorf("invalid prefix %q (resolves outside %s root %q)",
+			dirPrefix, apc.DisplayProvider(apc.POSIX), root)
+	}
+	return dir, 0, nil
+}
+
+//
+// CREATE BUCKET
+//
+
+func (*posixProvider) CreateBucket(bck *meta.Bck) (errCode int, err error) {
+	return checkRootDir(bck)
+}
+
+func (*posixProvider) DestroyBucket(bck *meta.Bck) (int, error) {
+	return http.StatusBadRequest, cmn.NewErrUnsupp("destroy", bck.Cname("")+" (root directory)")
+}
+
+func checkRootDir(bck *meta.Bck) (int, error) {
+	debug.Assert(bck.Props != nil)
+	root := bck.Props.Extra.POSIX.RootDir
+	debug.Assert(root != "")
+
+	fi, err := os.Stat(root)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if !fi.IsDir() {
+		return http.StatusBadRequest, fmt.Errorf("specified path %q does not point to directory", root)
+	}
+	return 0, nil
+}
+
+//
+// HEAD BUCKET
+//
+
+func (*posixProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error) {
+	if errCode, err = checkRootDir(bck); err != nil {
+		return
+	}
+	bckProps = make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.POSIX
+	bckProps[apc.HdrBucketVerEnabled] = "true" // mtime
+	return
+}
+
+//
+// LIST OBJECTS
+//
+
+func (pp *posixProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	var (
+		root = bck.Props.Extra.POSIX.RootDir
+		w    = &posixWalk{msg: msg, lst: lst, marker: max(msg.ContinuationToken, msg.StartAfter)}
+	)
+	msg.PageSize = calcPageSize(msg.PageSize, pp.MaxPageSize())
+
+	// start from the deepest directory that contains the prefix
+	var dirPrefix string
+	if i := strings.LastIndexByte(msg.Prefix, '/'); i >= 0 {
+		dirPrefix = msg.Prefix[:i+1]
+	}
+	dir, errCode, err := posixDir(root, dirPrefix)
+	if err != nil {
+		return errCode, err
+	}
+	if err := w.walk(dir, dirPrefix); err != nil {
+		if dirPrefix != "" && os.IsNotExist(err) {
+			lst.Entries = lst.Entries[:0]
+			return 0, nil
+		}
+		return posixErrorToAISError(err)
+	}
+	lst.Entries = lst.Entries[:w.idx]
+	// set continuation token only if we reached the page size
+	if uint(len(lst.Entries)) >= msg.PageSize {
+		lst.ContinuationToken = lst.Entries[len(lst.Entries)-1].Name
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", bck.Cname(""), len(lst.Entries))
+	}
+	return 0, nil
+}
+
+// NOTE: directories are sorted as if their names were terminated by '/' - that is, in
+// the same order their objects (file pathnames) are sorted - for pagination to work
+func (w *posixWalk) walk(dir, prefix string) error {
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(des))
+	for _, de := range des {
+		name := de.Name()
+		if de.IsDir() {
+			name += "/"
+		} else if !de.Type().IsRegular() || strings.HasPrefix(name, posixWorkPrefix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if uint(w.idx) >= w.msg.PageSize {
+			return nil
+		}
+		objName := prefix + name
+		if cos.IsLastB(name, '/') {
+			if !cmn.DirHasOrIsPrefix(objName, w.msg.Prefix) {
+				continue
+			}
+			// skip entire subtree that's been already listed
+			if w.marker != "" && objName <= w.marker && !strings.HasPrefix(w.marker, objName) {
+				continue
+			}
+			if err := w.walk(filepath.Join(dir, name), objName); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		if !cmn.ObjHasPrefix(objName, w.msg.Prefix) || (w.marker != "" && objName <= w.marker) {
+			continue
+		}
+		if err := w.add(filepath.Join(dir, name), objName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *posixWalk) add(fqn, objName string) error {
+	var (
+		msg   = w.msg
+		entry *cmn.LsoEntry
+	)
+	fi, err := os.Stat(fqn)
+	if err != nil {
+		return err // (removed in the meantime)
+	}
+	if w.idx < len(w.lst.Entries) {
+		entry = w.lst.Entries[w.idx]
+		*entry = cmn.LsoEntry{Name: objName}
+	} else {
+		entry = &cmn.LsoEntry{Name: objName}
+		w.lst.Entries = append(w.lst.Entries, entry)
+	}
+	w.idx++
+	entry.Size = fi.Size()
+	if msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize) {
+		return nil
+	}
+	if msg.WantProp(apc.GetPropsVersion) {
+		entry.Version = posixVersion(fi)
+	}
+	if msg.WantProp(apc.GetPropsCustom) {
+		custom := cos.StrKVs{cmn.LastModified: fmtTime(fi.ModTime())}
+		entry.Custom = cmn.CustomMD2S(custom)
+	}
+	return nil
+}
+
+//
+// LIST BUCKETS
+//
+
+func (*posixProvider) ListBuckets(cmn.QueryBcks) (buckets cmn.Bcks, errCode int, err error) {
+	debug.Assert(false) // (is served from BMD)
+	return
+}
+
+//
+// HEAD OBJECT
+//
+
+func (*posixProvider) HeadObj(_ ctx, lom *core.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+	var (
+		fqn string
+		fi  os.FileInfo
+	)
+	if fqn, errCode, err = posixPath(lom); err != nil {
+		return
+	}
+	if fi, err = os.Stat(fqn); err != nil {
+		errCode, err = posixErrorToAISError(err)
+		return
+	}
+	if fi.IsDir() {
+		errCode, err = http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+		return
+	}
+	oa = &cmn.ObjAttrs{}
+	oa.CustomMD = make(cos.StrKVs, 3)
+	oa.SetCustomKey(cmn.SourceObjMD, apc.POSIX)
+	oa.Size = fi.Size()
+	oa.Ver = posixVersion(fi)
+	oa.SetCustomKey(cmn.VersionObjMD, oa.Ver)
+	oa.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[head_object]", lom.String(), oa.Ver)
+	}
+	return
+}
+
+//
+// GET OBJECT
+//
+
+func (pp *posixProvider) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT) (int, error) {
+	res := pp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := pp.t.PutObject(lom, params)
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (*posixProvider) GetObjReader(_ context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	var fqn string
+	if fqn, res.ErrCode, res.Err = posixPath(lom); res.Err != nil {
+		return
+	}
+	fi, err := os.Stat(fqn)
+	if err != nil {
+		res.ErrCode, res.Err = posixErrorToAISError(err)
+		return
+	}
+	if fi.IsDir() {
+		res.ErrCode, res.Err = http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+		return
+	}
+	if length > 0 {
+		// range read (is not cold GET)
+		fh, err := cos.NewFileSectionHandle(fqn, offset, length)
+		if err != nil {
+			res.ErrCode, res.Err = posixErrorToAISError(err)
+			return
+		}
+		res.R, res.Size = fh, length
+		return
+	}
+	fh, err := cos.NewFileHandle(fqn)
+	if err != nil {
+		res.ErrCode, res.Err = posixErrorToAISError(err)
+		return
+	}
+	v := posixVersion(fi)
+	lom.SetCustomKey(cmn.SourceObjMD, apc.POSIX)
+	lom.SetVersion(v)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	res.R, res.Size = fh, fi.Size()
+	return
+}
+
+//
+// PUT OBJECT
+//
+
+// write-and-rename, to never expose partially written files
+func (*posixProvider) PutObj(r io.ReadCloser, lom *core.LOM) (int, error) {
+	fqn, errCode, err := posixPath(lom)
+	if err != nil {
+		cos.Close(r)
+		return errCode, err
+	}
+	var (
+		fi      os.FileInfo
+		workFQN = filepath.Join(filepath.Dir(fqn), posixWorkPrefix+filepath.Base(fqn)+"."+cos.GenTie())
+		fh, e   = cos.CreateFile(workFQN)
+	)
+	if e != nil {
+		cos.Close(r)
+		return posixErrorToAISError(e)
+	}
+	_, err = io.Copy(fh, r)
+	cos.Close(r)
+	if err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = os.Rename(workFQN, fqn)
+	}
+	if err == nil {
+		fi, err = os.Stat(fqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(workFQN); errRm != nil {
+			nlog.Errorln("nested error:", errRm)
+		}
+		return posixErrorToAISError(err)
+	}
+
+	v := posixVersion(fi)
+	lom.SetCustomKey(cmn.SourceObjMD, apc.POSIX)
+	lom.SetVersion(v)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object]", lom.String(), v)
+	}
+	return 0, nil
+}
+
+//
+// DELETE OBJECT
+//
+
+func (*posixProvider) DeleteObj(lom *core.LOM) (int, error) {
+	fqn, errCode, err := posixPath(lom)
+	if err != nil {
+		return errCode, err
+	}
+	if err := os.Remove(fqn); err != nil {
+		return posixErrorToAISError(err)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[delete_object]", lom.String())
+	}
+	return 0, nil
+}
@@ -0,0 +1,84 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func _posixBck(t *testing.T, files ...string) (*meta.Bck, string) {
+	var (
+		tmp  = t.TempDir()
+		root = filepath.Join(tmp, "root")
+	)
+	for _, name := range append(files, "../outside/secret") {
+		fqn := filepath.Join(root, name)
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), 0o644))
+	}
+	props := &cmn.Bprops{Extra: cmn.ExtraProps{POSIX: cmn.ExtraPropsPOSIX{RootDir: root}}}
+	return meta.NewBck("posix", apc.POSIX, cmn.NsGlobal, props), root
+}
+
+func _posixList(t *testing.T, bck *meta.Bck, prefix string, pageSize uint) (names []string) {
+	pp := &posixProvider{}
+	msg := &apc.LsoMsg{Prefix: prefix, PageSize: pageSize}
+	for {
+		lst := &cmn.LsoResult{}
+		_, err := pp.ListObjects(bck, msg, lst)
+		tassert.CheckFatal(t, err)
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+		}
+		if lst.ContinuationToken == "" {
+			return names
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func TestPOSIXListObjects(t *testing.T) {
+	files := []string{"a", "d/b", "d/e/c", "d/e/f", "d-x", "z"}
+	bck, _ := _posixBck(t, files...)
+
+	tests := []struct {
+		prefix   string
+		pageSize uint
+		expected []string
+	}{
+		{"", 0, []string{"a", "d-x", "d/b", "d/e/c", "d/e/f", "z"}},
+		{"", 2, []string{"a", "d-x", "d/b", "d/e/c", "d/e/f", "z"}},
+		{"d/", 1, []string{"d/b", "d/e/c", "d/e/f"}},
+		{"d/e/", 0, []string{"d/e/c", "d/e/f"}},
+		{"nonexistent/", 0, nil},
+	}
+	for _, test := range tests {
+		names := _posixList(t, bck, test.prefix, test.pageSize)
+		tassert.Fatalf(t, len(names) == len(test.expected), "prefix %q: expected %v, got %v", test.prefix, test.expected, names)
+		for i := range names {
+			tassert.Fatalf(t, names[i] == test.expected[i], "prefix %q: expected %v, got %v", test.prefix, test.expected, names)
+		}
+	}
+}
+
+func TestPOSIXListObjectsTraversal(t *testing.T) {
+	bck, _ := _posixBck(t, "a", "d/b")
+	pp := &posixProvider{}
+	for _, prefix := range []string{"../", "../outside/", "../../", "d/../../outside/", "/../outside/"} {
+		lst := &cmn.LsoResult{}
+		errCode, err := pp.ListObjects(bck, &apc.LsoMsg{Prefix: prefix}, lst)
+		tassert.Fatalf(t, err != nil, "prefix %q: expected error, listed %d entries", prefix, len(lst.Entries))
+		tassert.Errorf(t, errCode == http.StatusBadRequest, "prefix %q: expected status %d, got %d",
+			prefix, http.StatusBadRequest, errCode)
+	}
+}
@@ -521,6 +521,17 @@ func defaultBckProps(args bckPropsArgs) (props *cmn.Bprops) {
 		}
 		// Use HDFS props.
 		props.Extra.HDFS = args.bck.Props.Extra.HDFS
+	case args.bck.IsPOSIX():
+		// versioned by mtime; root directory must be specified upon creation (see also: HDFS)
+		if args.hdr != nil {
+			props = mergeRemoteBckProps(props, args.hdr)
+		}
+		if args.bck.Props != nil {
+			props.Extra.POSIX = args.bck.Props.Extra.POSIX
+		}
+	case args.bck.IsCloud() && args.hdr == nil:
+		// cloud bucket that is yet to be created (see also: proxy._bcr)
+		props.Versioning.Enabled = false
 	case args.bck.IsRemote():
 		debug.Assert(args.hdr != nil)
 		props.Versioning.Enabled = false
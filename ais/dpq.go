@@ -18,10 +18,12 @@ import (
 type dpq struct {
 	provider, namespace string // bucket
 	pid, ptime          string // proxy ID, timestamp
+	uid, usig           string // AuthN user (redirect) and its signature
 	uuid                string // xaction
 	skipVC              string // (skip loading existing object's metadata)
 	archpath, archmime  string // archive
 	isGFN               string // ditto
+	coldRedir           string // QparamColdRedir
 	origURL             string // ht://url->
 	appendTy, appendHdl string // APPEND { apc.AppendOp, ... }
 	owt                 string // object write transaction { OwtPut, ... }
@@ -84,6 +86,12 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.pid = value
 		case apc.QparamUnixTime:
 			dpq.ptime = value
+		case apc.QparamUserID:
+			if dpq.uid, err = url.QueryUnescape(value); err != nil {
+				return
+			}
+		case apc.QparamUserSig:
+			dpq.usig = value
 		case apc.QparamUUID:
 			dpq.uuid = value
 		case apc.QparamArchpath:
@@ -96,6 +104,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			}
 		case apc.QparamIsGFNRequest:
 			dpq.isGFN = value
+		case apc.QparamColdRedir:
+			dpq.coldRedir = value
 		case apc.QparamOrigURL:
 			if dpq.origURL, err = url.QueryUnescape(value); err != nil {
 				return
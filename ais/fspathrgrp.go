@@ -40,6 +40,13 @@ func (g *fsprungroup) init(t *target, newVol bool) {
 // enableMpath enables mountpath and notifies necessary runners about the
 // change if mountpath actually was enabled.
 func (g *fsprungroup) enableMpath(mpath string) (enabledMi *fs.Mountpath, err error) {
+	// read-only => read-write
+	if enabledMi, err = fs.ReadOnlyMpath(mpath, false, g.redistributeMD); err != nil || enabledMi != nil {
+		if enabledMi != nil {
+			g._postRO(apc.ActMountpathEnable, enabledMi)
+		}
+		return
+	}
 	enabledMi, err = fs.EnableMpath(mpath, g.t.SID(), g.redistributeMD)
 	if err != nil || enabledMi == nil {
 		return
@@ -50,8 +57,8 @@ func (g *fsprungroup) enableMpath(mpath string) (enabledMi *fs.Mountpath, err er
 
 // attachMpath adds mountpath and notifies necessary runners about the change
 // if the mountpath was actually added.
-func (g *fsprungroup) attachMpath(mpath string, force bool) (addedMi *fs.Mountpath, err error) {
-	addedMi, err = fs.AddMpath(mpath, g.t.SID(), g.redistributeMD, force)
+func (g *fsprungroup) attachMpath(mpath, label string, force bool) (addedMi *fs.Mountpath, err error) {
+	addedMi, err = fs.AddMpath(mpath, label, g.t.SID(), g.redistributeMD, force)
 	if err != nil || addedMi == nil {
 		return
 	}
@@ -67,7 +74,7 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 	// - review all xact.IsMountpath(kind) == true
 	dsort.Managers.AbortAll(fmt.Errorf("%q %s", action, mi))
 
-	fspathsConfigAddDel(mi.Path, true /*add*/)
+	fspathsConfigAddDel(mi, true /*add*/)
 	go func() {
 		if cmn.GCO.Get().Resilver.Enabled {
 			g.t.runResilver(res.Args{}, nil /*wg*/)
@@ -83,6 +90,47 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 	}
 }
 
+//
+// read-only (aka draining)
+//
+
+// readOnlyMpath marks mountpath read-only, so that it keeps serving reads while
+// new writes get placed elsewhere and (throttled) resilver gradually drains it.
+func (g *fsprungroup) readOnlyMpath(mpath string) (rmi *fs.Mountpath, err error) {
+	rmi, err = fs.ReadOnlyMpath(mpath, true, g.redistributeMD)
+	if err != nil || rmi == nil {
+		return
+	}
+	g._postRO(apc.ActMountpathReadOnly, rmi)
+	return
+}
+
+// common for (read-write => read-only) and vice versa: hrw placement changes
+func (g *fsprungroup) _postRO(action string, mi *fs.Mountpath) {
+	dsort.Managers.AbortAll(fmt.Errorf("%q %s", action, mi)) // NOTE: see _postAdd
+	core.UncacheMountpath(mi)
+
+	if !cmn.GCO.Get().Resilver.Enabled {
+		nlog.Warningf("%s: %q %s: resilvering disabled", g.t, action, mi)
+		return
+	}
+	args := res.Args{}
+	if action == apc.ActMountpathReadOnly {
+		prevActive := g.t.res.IsActive(1 /*interval-of-inactivity multiplier*/)
+		args = res.Args{
+			Rmi:             mi,
+			Action:          action,
+			SingleRmiJogger: !prevActive, // only the content that's stored on `mi` must move
+			Throttle:        true,
+		}
+		nlog.Infof("%s: %q %s: starting to drain", g.t, action, mi)
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go g.t.runResilver(args, wg)
+	wg.Wait()
+}
+
 //
 // remove | disable
 //
@@ -175,7 +223,7 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 		nlog.Errorln(err)
 		return
 	}
-	fspathsConfigAddDel(rmi.Path, false /*add*/)
+	fspathsConfigAddDel(rmi, false /*add*/)
 	nlog.Infof("%s: %s %q %s done", g.t, rmi, action, xres)
 
 	// 3. the case of multiple overlapping detach _or_ disable operations
@@ -196,23 +244,23 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 			nlog.Errorln(err)
 			return
 		}
-		fspathsConfigAddDel(mi.Path, false /*add*/)
+		fspathsConfigAddDel(mi, false /*add*/)
 		nlog.Infof("%s: %s %s %s was previously aborted and now done", g.t, action, mi, xres)
 	}
 }
 
 // store updated fspaths locally as part of the 'OverrideConfigFname'
 // and commit new version of the config
-func fspathsConfigAddDel(mpath string, add bool) {
+func fspathsConfigAddDel(mi *fs.Mountpath, add bool) {
 	if cmn.Rom.TestingEnv() { // since testing fspaths are counted, not enumerated
 		return
 	}
 	config := cmn.GCO.BeginUpdate()
 	localConfig := &config.LocalConfig
 	if add {
-		localConfig.AddPath(mpath)
+		localConfig.AddPath(mi.Path, mi.Label)
 	} else {
-		localConfig.DelPath(mpath)
+		localConfig.DelPath(mi.Path)
 	}
 	if err := localConfig.FSP.Validate(config); err != nil {
 		debug.AssertNoErr(err)
@@ -881,18 +881,25 @@ func _reMirror(bprops, nprops *cmn.Bprops) bool {
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
 		if bprops.EC.Enabled {
-			// abort running ec-encode xaction, if exists
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
-			xreg.DoAbort(flt, errors.New("ec-disabled"))
+			// abort running ec-encode (ec-reencode) xaction, if exists
+			errV := errors.New("ec-disabled")
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: bck}, errV)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: bck}, errV)
 		}
 		return
 	}
 	if smap != nil {
 		targetCnt = smap.CountActiveTs()
 	}
-	if !bprops.EC.Enabled ||
-		(bprops.EC.DataSlices != nprops.EC.DataSlices || bprops.EC.ParitySlices != nprops.EC.ParitySlices) {
-		yes = true
-	}
+	yes = !bprops.EC.Enabled || _reencode(bprops, nprops)
 	return
 }
+
+// EC is (and remains) enabled, and the existing layout is outdated
+func _reencode(bprops, nprops *cmn.Bprops) bool {
+	if !bprops.EC.Enabled || !nprops.EC.Enabled {
+		return false
+	}
+	return bprops.EC.DataSlices != nprops.EC.DataSlices || bprops.EC.ParitySlices != nprops.EC.ParitySlices ||
+		bprops.EC.ObjSizeLimit != nprops.EC.ObjSizeLimit
+}
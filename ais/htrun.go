@@ -64,8 +64,9 @@ type htrun struct {
 		cluster atomic.Int64 // mono.NanoTime() since cluster startup, zero prior to that
 		node    atomic.Int64 // ditto - for the node
 	}
-	gmm *memsys.MMSA // system pagesize-based memory manager and slab allocator
-	smm *memsys.MMSA // system MMSA for small-size allocations
+	gmm        *memsys.MMSA // system pagesize-based memory manager and slab allocator
+	smm        *memsys.MMSA // system MMSA for small-size allocations
+	msyncEpoch atomic.Int64 // highest primary lease epoch seen via metasync (see lease.go)
 }
 
 ///////////
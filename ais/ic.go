@@ -82,6 +82,10 @@ begin:
 	}
 	if selfIC {
 		if !exists && !retry {
+			// may be queued by the primary (see jobq)
+			if !smap.isPrimary(ic.p.si) && ic.p.forwardCP(w, r, nil, uuid, cos.MustMarshal(msg)) {
+				return true
+			}
 			err := fmt.Errorf("x-[%s] not found (%s)", uuid, smap.StrIC(ic.p.si))
 			ic.p.writeErr(w, r, err, http.StatusNotFound, Silent)
 			return true
@@ -159,7 +163,7 @@ func (ic *ic) redirectToIC(w http.ResponseWriter, r *http.Request) bool {
 			break
 		}
 	}
-	redirectURL := ic.p.redirectURL(r, node, time.Now(), cmn.NetIntraControl)
+	redirectURL := ic.p.redirectURL(r, node, time.Now(), nil /*tk*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 	return true
 }
@@ -224,6 +228,13 @@ func (ic *ic) xstatusOne(w http.ResponseWriter, r *http.Request) {
 	if msg.ID == "" && ic.redirectToIC(w, r) {
 		return
 	}
+	// queued (not yet started) job - see jobq
+	if msg.ID != "" {
+		if status := ic.p.jobq.status(msg.ID); status != nil {
+			ic.p.writeJSON(w, r, status, "queued-job-status")
+			return
+		}
+	}
 	if msg.ID != "" && ic.reverseToOwner(w, r, msg.ID, msg) {
 		return
 	}
@@ -6,6 +6,7 @@ package ais
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	ratomic "sync/atomic"
 	"time"
@@ -30,6 +31,14 @@ const (
 	kaNumRetries = 3
 )
 
+// phi-accrual
+const (
+	phiWindow     = 100              // inter-arrival samples (per node)
+	phiMaxRetries = 2 * kaNumRetries // remove regardless of phi
+	phiMinSamples = 10               // history required to suspect prior to `kaNumRetries`
+	phiMax        = 100              // (display; JSON cannot carry +Inf)
+)
+
 const (
 	waitSelfJoin = 300 * time.Millisecond
 	waitStandby  = 5 * time.Second
@@ -45,6 +54,7 @@ type (
 		paused() bool
 		cfg(config *cmn.Config) *cmn.KeepaliveTrackerConf
 		cluUptime(int64) time.Duration
+		suspicion() map[string]float64
 	}
 	talive struct {
 		t *target
@@ -58,7 +68,7 @@ type (
 	}
 	keepalive struct {
 		k            keepaliver
-		hb           hbTracker
+		hbox         ratomic.Pointer[hbBox] // current tracker (switchable at runtime - see configUpdate)
 		statsT       stats.Tracker
 		controlCh    chan controlSignal
 		startedUp    *atomic.Bool
@@ -73,16 +83,40 @@ type (
 	}
 
 	hbTracker interface {
-		HeardFrom(id string, now int64) // callback for 'id' to respond
-		TimedOut(id string) bool        // true if 'id` didn't keepalive or called (via "heard") within the interval (above)
+		HeardFrom(id string, now int64)       // callback for 'id' to respond
+		TimedOut(id string) bool              // true if 'id` didn't keepalive or called (via "heard") within the interval (above)
+		Suspected(id string, failed int) bool // true if 'id' that failed to respond 'failed' times in a row is down
+		Suspicion() map[string]float64        // per node suspicion levels (nil if not supported)
 
+		name() string // cmn.KeepaliveHeartbeat, etc.
 		reg(id string)
-		set(interval time.Duration) bool
+		set(cfg *cmn.KeepaliveTrackerConf) bool
+	}
+	hbBox struct {
+		hbTracker
 	}
 	heartBeat struct {
 		last     sync.Map
 		interval time.Duration // timeout
 	}
+
+	// adaptive failure detector: learns (normal) distribution of keepalive inter-arrival times;
+	// node's suspicion level (phi) grows with the time since last heard, relative to its history -
+	// see cmn.KeepalivePhiAccrual
+	phiAccrual struct {
+		nodes     sync.Map // id => *phiNode
+		interval  atomic.Int64
+		threshold atomic.Int64 // phi threshold (config "factor")
+	}
+	phiNode struct {
+		samples [phiWindow]float64 // inter-arrival times, ns
+		sum     float64
+		sumsq   float64
+		last    int64 // mono time
+		idx     int
+		cnt     int
+		mu      sync.Mutex
+	}
 )
 
 // interface guard
@@ -94,8 +128,16 @@ var (
 	_ keepaliver = (*palive)(nil)
 
 	_ hbTracker = (*heartBeat)(nil)
+	_ hbTracker = (*phiAccrual)(nil)
 )
 
+func newTracker(cfg *cmn.KeepaliveTrackerConf) hbTracker {
+	if cfg.Name == cmn.KeepalivePhiAccrual {
+		return newPhi(cfg.Interval.D(), int(cfg.Factor))
+	}
+	return newHB(cfg.Interval.D())
+}
+
 ////////////
 // talive //
 ////////////
@@ -108,7 +150,7 @@ func newTalive(t *target, statsT stats.Tracker, startedUp *atomic.Bool) *talive
 	tkr.keepalive.k = tkr
 	tkr.statsT = statsT
 	tkr.keepalive.startedUp = startedUp
-	tkr.hb = newHB(config.Keepalive.Target.Interval.D())
+	tkr.setHB(newTracker(&config.Keepalive.Target))
 	tkr.controlCh = make(chan controlSignal) // unbuffered on purpose
 	tkr.interval = config.Keepalive.Target.Interval.D()
 	return tkr
@@ -171,7 +213,7 @@ func newPalive(p *proxy, statsT stats.Tracker, startedUp *atomic.Bool) *palive {
 	pkr.keepalive.k = pkr
 	pkr.statsT = statsT
 	pkr.keepalive.startedUp = startedUp
-	pkr.hb = newHB(config.Keepalive.Proxy.Interval.D())
+	pkr.setHB(newTracker(&config.Keepalive.Proxy))
 	pkr.controlCh = make(chan controlSignal) // unbuffered on purpose
 	pkr.interval = config.Keepalive.Proxy.Interval.D()
 	return pkr
@@ -217,8 +259,12 @@ func (pkr *palive) do(config *cmn.Config) (stopped bool) {
 		}
 		stopped = pkr.updateSmap(config)
 		pkr.inProgress.Store(false)
+		if !stopped {
+			pkr.p.renewLease(pkr.p.owner.smap.get(), config)
+		}
 		return
 	}
+	pkr.p.lease.reset()
 	if !pkr.timeToPing(smap.Primary.ID()) { // skip sending keepalive
 		return
 	}
@@ -259,7 +305,7 @@ func (pkr *palive) updateSmap(config *cmn.Config) (stopped bool) {
 			if _, _, err := pkr.p.reqHealth(si, config.Timeout.CplaneOperation.D(), nil, smap); err == nil {
 				now := mono.NanoTime()
 				pkr.statsT.Add(stats.KeepAliveLatency, now-started)
-				pkr.hb.HeardFrom(si.ID(), now) // effectively, yes
+				pkr.heard(si.ID(), now) // effectively, yes
 				continue
 			}
 			// otherwise, go keepalive with retries
@@ -311,7 +357,7 @@ func (pkr *palive) _pingRetry(si *meta.Snode, smap *smapX, config *cmn.Config) (
 	if err == nil {
 		now := mono.NanoTime()
 		pkr.statsT.Add(stats.KeepAliveLatency, now-started)
-		pkr.hb.HeardFrom(si.ID(), now) // effectively, yes
+		pkr.heard(si.ID(), now) // effectively, yes
 		return true, false
 	}
 
@@ -344,6 +390,15 @@ func (pkr *palive) _pre(ctx *smapModifier, clone *smapX) error {
 	if !ctx.smap.isPrimary(pkr.p.si) {
 		return newErrNotPrimary(pkr.p.si, ctx.smap)
 	}
+	// never shrink the membership (and, with it, the quorum) without the majority of the current one
+	var (
+		now = mono.NanoTime()
+		dur = leaseDur(cmn.GCO.Get())
+	)
+	if n, quorum := pkr.p.lease.quorum(ctx.smap, pkr.p.SID(), now, dur); n < quorum {
+		return fmt.Errorf("%s: %w - cannot remove nodes with %d acks of the required %d (%s)",
+			pkr.p.si, errLeaseExpired, n, quorum, ctx.smap.StringEx())
+	}
 	metaction := "keepalive: removing ["
 	cnt := 0
 loop:
@@ -386,6 +441,14 @@ func (pkr *palive) _final(ctx *smapModifier, clone *smapX) {
 	_ = pkr.p.metasyncer.sync(revsPair{clone, msg})
 }
 
+// primary: record acknowledgment for the lease (see lease.go)
+func (pkr *palive) heard(sid string, now int64) {
+	pkr.hb().HeardFrom(sid, now)
+	pkr.p.lease.ack(sid, now)
+}
+
+func (pkr *palive) heardFrom(sid string) { pkr.heard(sid, mono.NanoTime()) }
+
 func (pkr *palive) retry(si *meta.Snode, ticker *time.Ticker, timeout time.Duration) (ok, stopped bool) {
 	var i int
 	for {
@@ -405,13 +468,13 @@ func (pkr *palive) retry(si *meta.Snode, ticker *time.Ticker, timeout time.Durat
 			if err == nil {
 				now := mono.NanoTime()
 				pkr.statsT.Add(stats.KeepAliveLatency, now-started)
-				pkr.hb.HeardFrom(si.ID(), now) // effectively, yes
+				pkr.heard(si.ID(), now) // effectively, yes
 				return true, false
 			}
 
 			i++
-			if i == kaNumRetries {
-				nlog.Warningf("Failed after %d attempts - removing %s from %s", i, si.StringEx(), smap)
+			if pkr.hb().Suspected(si.ID(), i) {
+				nlog.Warningf("Failed after %d attempt%s - removing %s from %s", i, cos.Plural(i), si.StringEx(), smap)
 				return false, false
 			}
 			if cos.IsUnreachable(err, status) {
@@ -433,7 +496,7 @@ func (pkr *palive) retry(si *meta.Snode, ticker *time.Ticker, timeout time.Durat
 func (k *keepalive) Name() string { return k.name }
 
 func (k *keepalive) heardFrom(sid string) {
-	k.hb.HeardFrom(sid, 0 /*now*/)
+	k.hb().HeardFrom(sid, 0 /*now*/)
 }
 
 // wait for stats-runner to set startedUp=true
@@ -468,12 +531,13 @@ func (k *keepalive) _wait(ticker *time.Ticker) (stopped bool) {
 
 // pre-populate hb
 func (k *keepalive) init(smap *smapX, self string) {
+	hb := k.hb()
 	for _, nm := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
 		for sid := range nm {
 			if sid == self {
 				continue
 			}
-			k.hb.reg(sid)
+			hb.reg(sid)
 		}
 	}
 }
@@ -519,12 +583,27 @@ func (k *keepalive) _run() {
 	}
 }
 
+func (k *keepalive) hb() hbTracker      { return k.hbox.Load().hbTracker }
+func (k *keepalive) setHB(hb hbTracker) { k.hbox.Store(&hbBox{hb}) }
+
+// when the tracker's name changes, switch to the new one that starts without history
+// (nodes it hasn't heard from yet get pinged and, if need be, suspected after `kaNumRetries`);
+// otherwise, update interval and threshold in place
 func (k *keepalive) configUpdate(cfg *cmn.KeepaliveTrackerConf) {
-	if k.hb.set(cfg.Interval.D()) {
-		k.interval = cfg.Interval.D()
+	hb := k.hb()
+	if hb.name() == cfg.Name {
+		if hb.set(cfg) {
+			k.interval = cfg.Interval.D()
+		}
+		return
 	}
+	nlog.Infof("%s: switching keepalive tracker %q => %q", k.Name(), hb.name(), cfg.Name)
+	k.setHB(newTracker(cfg))
+	k.interval = cfg.Interval.D()
 }
 
+func (k *keepalive) suspicion() map[string]float64 { return k.hb().Suspicion() }
+
 // keepalive => primary
 // is called by non-primary proxies and all targets
 func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped bool) {
@@ -542,7 +621,7 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 	if err == nil {
 		now := mono.NanoTime()
 		k.statsT.Add(stats.KeepAliveLatency, now-started)
-		k.hb.HeardFrom(pid, now) // effectively, yes
+		k.hb().HeardFrom(pid, now) // effectively, yes
 		return
 	}
 
@@ -571,15 +650,15 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 			if err == nil {
 				now := mono.NanoTime()
 				k.statsT.Add(stats.KeepAliveLatency, now-started)
-				k.hb.HeardFrom(pid, now) // effectively, yes
+				k.hb().HeardFrom(pid, now) // effectively, yes
 				nlog.Infof("%s: OK after %d attempt%s", si, i, cos.Plural(i))
 				return
 			}
 			// repeat up to `kaNumRetries` with the max timeout
 			timeout = config.Timeout.MaxKeepalive.D()
 
-			if i == kaNumRetries {
-				nlog.Warningf("%s: failed %d attempts => %s (primary)", si, i, meta.Pname(pid))
+			if k.hb().Suspected(pid, i) {
+				nlog.Warningf("%s: failed %d attempt%s => %s (primary)", si, i, cos.Plural(i), meta.Pname(pid))
 				return true
 			}
 			if cos.IsUnreachable(err, status) {
@@ -600,7 +679,7 @@ func (k *keepalive) do(smap *smapX, si *meta.Snode, config *cmn.Config) (stopped
 }
 
 func (k *keepalive) timeToPing(sid string) bool {
-	return k.hb.TimedOut(sid)
+	return k.hb().TimedOut(sid)
 }
 
 func (k *keepalive) Stop(err error) {
@@ -650,10 +729,177 @@ func (hb *heartBeat) TimedOut(id string) bool {
 	return mono.Since(tim) > hb.interval
 }
 
+// fixed number of retries
+func (*heartBeat) Suspected(_ string, failed int) bool { return failed >= kaNumRetries }
+
+func (*heartBeat) Suspicion() map[string]float64 { return nil }
+
+func (*heartBeat) name() string { return cmn.KeepaliveHeartbeat }
+
 func (hb *heartBeat) reg(id string) { hb.last.Store(id, new(int64)) }
 
-func (hb *heartBeat) set(interval time.Duration) (changed bool) {
+func (hb *heartBeat) set(cfg *cmn.KeepaliveTrackerConf) (changed bool) {
+	interval := cfg.Interval.D()
 	changed = hb.interval != interval
 	hb.interval = interval
 	return
 }
+
+////////////////
+// phiAccrual //
+////////////////
+
+func newPhi(interval time.Duration, threshold int) *phiAccrual {
+	pa := &phiAccrual{}
+	pa.interval.Store(int64(interval))
+	pa.threshold.Store(int64(threshold))
+	return pa
+}
+
+func (pa *phiAccrual) HeardFrom(id string, now int64) {
+	if now == 0 {
+		now = mono.NanoTime()
+	}
+	pn := pa.node(id)
+	pn.mu.Lock()
+	if pn.last != 0 && now > pn.last {
+		pn.add(float64(now - pn.last))
+	}
+	pn.last = max(pn.last, now)
+	pn.mu.Unlock()
+}
+
+// same as heartBeat: time to ping (or send keepalive) if haven't heard within the interval;
+// phi is used to decide whether a non-responding node is down (see Suspected)
+func (pa *phiAccrual) TimedOut(id string) bool {
+	v, ok := pa.nodes.Load(id)
+	if !ok {
+		return true
+	}
+	pn := v.(*phiNode)
+	pn.mu.Lock()
+	last := pn.last
+	pn.mu.Unlock()
+	return mono.Since(last) > time.Duration(pa.interval.Load())
+}
+
+// - when phi exceeds the threshold - as early as the first failure, given at least `phiMinSamples` history;
+// - with a shorter history (or none at all) - never earlier than heartBeat (i.e., `kaNumRetries` failures);
+// - and regardless, after `phiMaxRetries` failures
+// In other words, phi gives more time to the nodes with irregular history and less - to the regular ones
+// (subject to the threshold and the stddev floor - see phi() below).
+func (pa *phiAccrual) Suspected(id string, failed int) bool {
+	return pa.suspected(id, failed, mono.NanoTime())
+}
+
+func (pa *phiAccrual) suspected(id string, failed int, now int64) bool {
+	if failed >= phiMaxRetries {
+		return true
+	}
+	v, ok := pa.nodes.Load(id)
+	if !ok {
+		return failed >= kaNumRetries
+	}
+	pn := v.(*phiNode)
+	phi, heard := pn.phi(now, pa.interval.Load())
+	switch {
+	case !heard:
+		return failed >= kaNumRetries
+	case failed < kaNumRetries && pn.history() < phiMinSamples:
+		return false
+	}
+	return phi >= float64(pa.threshold.Load())
+}
+
+func (pa *phiAccrual) Suspicion() map[string]float64 {
+	var (
+		levels   = make(map[string]float64, 8)
+		now      = mono.NanoTime()
+		interval = pa.interval.Load()
+	)
+	pa.nodes.Range(func(k, v any) bool {
+		if phi, heard := v.(*phiNode).phi(now, interval); heard {
+			levels[k.(string)] = math.Round(phi*100) / 100
+		}
+		return true
+	})
+	return levels
+}
+
+func (pa *phiAccrual) reg(id string) { pa.nodes.Store(id, &phiNode{}) }
+
+func (*phiAccrual) name() string { return cmn.KeepalivePhiAccrual }
+
+func (pa *phiAccrual) set(cfg *cmn.KeepaliveTrackerConf) bool {
+	pa.threshold.Store(int64(cfg.Factor))
+	return pa.interval.Swap(int64(cfg.Interval.D())) != int64(cfg.Interval.D())
+}
+
+func (pa *phiAccrual) node(id string) *phiNode {
+	if v, ok := pa.nodes.Load(id); ok {
+		return v.(*phiNode) // almost always
+	}
+	v, _ := pa.nodes.LoadOrStore(id, &phiNode{})
+	return v.(*phiNode)
+}
+
+func (pn *phiNode) add(sample float64) {
+	if pn.cnt == phiWindow {
+		old := pn.samples[pn.idx]
+		pn.sum -= old
+		pn.sumsq -= old * old
+	} else {
+		pn.cnt++
+	}
+	pn.samples[pn.idx] = sample
+	pn.sum += sample
+	pn.sumsq += sample * sample
+	pn.idx = (pn.idx + 1) % phiWindow
+}
+
+func (pn *phiNode) history() (cnt int) {
+	pn.mu.Lock()
+	cnt = pn.cnt
+	pn.mu.Unlock()
+	return
+}
+
+// phi = -log10(1 - CDF(now - last)), assuming normally distributed inter-arrival times;
+// until there's history, the mean is the configured interval (and stddev a quarter of it);
+// the stddev is never smaller than 1/4 of the interval, to tolerate perfectly regular keepalives
+// (with a smaller floor, phi >= 8 would fire at ~1.7x interval)
+func (pn *phiNode) phi(now, interval int64) (float64, bool) {
+	pn.mu.Lock()
+	var (
+		last      = pn.last
+		mean, std = float64(interval), float64(interval) / 4
+	)
+	if pn.cnt > 0 {
+		mean = pn.sum / float64(pn.cnt)
+		std = math.Sqrt(max(pn.sumsq/float64(pn.cnt)-mean*mean, 0))
+	}
+	pn.mu.Unlock()
+	if last == 0 {
+		return phiMax, false
+	}
+	std = max(std, float64(interval)/4)
+	return _phi(float64(now-last), mean, std), true
+}
+
+// logistic approximation of the normal CDF (as in Akka and Cassandra)
+func _phi(elapsed, mean, std float64) float64 {
+	var (
+		y = (elapsed - mean) / std
+		e = math.Exp(-y * (1.5976 + 0.070566*y*y))
+		p float64
+	)
+	if elapsed > mean {
+		p = -math.Log10(e / (1 + e))
+	} else {
+		p = -math.Log10(1 - 1/(1+e))
+	}
+	if math.IsInf(p, 0) || math.IsNaN(p) || p > phiMax {
+		return phiMax
+	}
+	return p
+}
@@ -5,8 +5,13 @@
 package ais
 
 import (
+	"math/rand"
 	"testing"
 	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 )
 
 func TestHB(t *testing.T) {
@@ -49,3 +54,215 @@ func TestHB(t *testing.T) {
 		t.Fatal("Expecting timeout")
 	}
 }
+
+// simulated keepalives: `n` inter-arrival times generated by `next`, in virtual time
+func phiFeed(pa *phiAccrual, id string, n int, next func(i int) time.Duration) (last int64) {
+	last = int64(time.Hour) // (any non-zero)
+	pa.HeardFrom(id, last)
+	for i := 0; i < n; i++ {
+		last += int64(next(i))
+		pa.HeardFrom(id, last)
+	}
+	return last
+}
+
+func TestPhiAccrualJitter(t *testing.T) {
+	const (
+		interval  = 100 * time.Millisecond
+		threshold = 8
+	)
+	var (
+		pa     = newPhi(interval, threshold)
+		rnd    = rand.New(rand.NewSource(1))
+		jitter = func(int) time.Duration { // 100ms +/- 20ms
+			return interval + time.Duration(rnd.Int63n(int64(40*time.Millisecond))) - 20*time.Millisecond
+		}
+		// every 10th keepalive delayed by 150ms (e.g., GC pause)
+		pauses = func(i int) time.Duration {
+			if i%10 == 9 {
+				return interval + 150*time.Millisecond
+			}
+			return interval
+		}
+	)
+	lastJ := phiFeed(pa, "jitter", 200, jitter)
+	lastP := phiFeed(pa, "pauses", 200, pauses)
+
+	phiAt := func(id string, last int64, elapsed time.Duration) float64 {
+		v, ok := pa.nodes.Load(id)
+		if !ok {
+			t.Fatalf("%s: not found", id)
+		}
+		phi, heard := v.(*phiNode).phi(last+int64(elapsed), int64(interval))
+		if !heard {
+			t.Fatalf("%s: expecting heard", id)
+		}
+		return phi
+	}
+
+	// on time
+	for _, id := range []string{"jitter", "pauses"} {
+		last := lastJ
+		if id == "pauses" {
+			last = lastP
+		}
+		if phi := phiAt(id, last, interval); phi >= 1 {
+			t.Errorf("%s: expecting low suspicion when on time, got phi=%.2f", id, phi)
+		}
+		if phi := phiAt(id, last, 10*interval); phi < threshold {
+			t.Errorf("%s: expecting suspicion after 10 intervals, got phi=%.2f", id, phi)
+		}
+		if phi := phiAt(id, last, time.Hour); phi != phiMax {
+			t.Errorf("%s: expecting max suspicion (%d), got phi=%.2f", id, phiMax, phi)
+		}
+	}
+
+	// same delay, different history: the detector adapts
+	const delay = interval + 150*time.Millisecond
+	if phi := phiAt("jitter", lastJ, delay); phi < threshold {
+		t.Errorf("regular keepalives: expecting %v delay to be suspicious, got phi=%.2f", delay, phi)
+	}
+	if phi := phiAt("pauses", lastP, delay); phi >= threshold {
+		t.Errorf("keepalives with periodic pauses: expecting %v delay to be tolerated, got phi=%.2f", delay, phi)
+	}
+	// monotonic
+	prev := 0.0
+	for elapsed := interval / 2; elapsed < 5*interval; elapsed += interval / 10 {
+		phi := phiAt("pauses", lastP, elapsed)
+		if phi < prev {
+			t.Fatalf("phi must not decrease with time: %.2f => %.2f at %v", prev, phi, elapsed)
+		}
+		prev = phi
+	}
+}
+
+func TestPhiAccrualSuspected(t *testing.T) {
+	const interval = 100 * time.Millisecond
+	pa := newPhi(interval, 8)
+
+	// registered but never heard from: fixed number of retries
+	pa.reg("new")
+	if !pa.TimedOut("new") {
+		t.Error("expecting time to ping")
+	}
+	if pa.Suspected("new", kaNumRetries-1) || !pa.Suspected("new", kaNumRetries) {
+		t.Errorf("never-heard node: expecting removal after exactly %d retries", kaNumRetries)
+	}
+	if _, ok := pa.Suspicion()["new"]; ok {
+		t.Error("never-heard node must not report suspicion level")
+	}
+
+	// regular keepalives that just stopped (in the distant past)
+	now := mono.NanoTime()
+	for i := 20; i >= 10; i-- {
+		pa.HeardFrom("gone", now-int64(i)*int64(interval))
+	}
+	if !pa.Suspected("gone", 1) {
+		t.Error("expecting removal after a single failed ping when phi is high (given enough history)")
+	}
+
+	// ditto, with a short history
+	for i := 20; i >= 18; i-- {
+		pa.HeardFrom("short", now-int64(i)*int64(interval))
+	}
+	if pa.Suspected("short", kaNumRetries-1) {
+		t.Errorf("short history: expecting at least %d failed pings prior to removal", kaNumRetries)
+	}
+	if !pa.Suspected("short", kaNumRetries) {
+		t.Errorf("short history: expecting removal after %d failed pings when phi is high", kaNumRetries)
+	}
+
+	// just heard from
+	for i := 10; i >= 0; i-- {
+		pa.HeardFrom("ok", now-int64(i)*int64(interval))
+	}
+	if pa.TimedOut("ok") {
+		t.Error("expecting no timeout")
+	}
+	if pa.Suspected("ok", kaNumRetries) {
+		t.Error("expecting no suspicion right after keepalive")
+	}
+	if !pa.Suspected("ok", phiMaxRetries) {
+		t.Errorf("expecting removal after %d retries regardless of phi", phiMaxRetries)
+	}
+
+	levels := pa.Suspicion()
+	if levels["gone"] < 8 || levels["short"] < 8 || levels["ok"] >= 1 {
+		t.Errorf("unexpected suspicion levels: %v", levels)
+	}
+
+	// config update
+	if pa.set(&cmn.KeepaliveTrackerConf{Name: cmn.KeepalivePhiAccrual, Interval: cos.Duration(interval), Factor: 16}) {
+		t.Error("interval did not change")
+	}
+	if pa.threshold.Load() != 16 {
+		t.Errorf("expecting updated threshold, got %d", pa.threshold.Load())
+	}
+}
+
+// compare with heartBeat (fixed number of retries), given default config:
+// the primary starts pinging after `interval` and then retries every `retry`
+func TestPhiAccrualVsHeartbeat(t *testing.T) {
+	const (
+		interval = 10 * time.Second
+		retry    = 4500 * time.Millisecond // min(cplane_operation * retry_factor, max_keepalive + 0.5s)
+	)
+	var (
+		hb = newHB(interval)
+		pa = newPhi(interval, cmn.KeepaliveDfltPhiThreshold)
+	)
+	regular := phiFeed(pa, "regular", 100, func(int) time.Duration { return interval })
+	irregular := phiFeed(pa, "irregular", 100, func(i int) time.Duration {
+		if i%10 == 9 {
+			return interval * 5 / 2
+		}
+		return interval
+	})
+
+	// first failed retry when phi (and heartBeat) suspect the node
+	removed := func(id string, last int64) int {
+		for failed := 1; failed <= phiMaxRetries; failed++ {
+			if pa.suspected(id, failed, last+int64(interval+time.Duration(failed)*retry)) {
+				return failed
+			}
+		}
+		return -1
+	}
+	nhb := 1
+	for !hb.Suspected("", nhb) {
+		nhb++
+	}
+	if n := removed("regular", regular); n != nhb {
+		t.Errorf("regular keepalives: expecting removal after %d retries (same as heartbeat), got %d", nhb, n)
+	}
+	if n := removed("irregular", irregular); n <= nhb || n > phiMaxRetries {
+		t.Errorf("irregular keepalives: expecting removal after more than %d (and at most %d) retries, got %d",
+			nhb, phiMaxRetries, n)
+	}
+
+	// lower threshold: regular nodes get removed sooner than with heartBeat
+	pa.threshold.Store(3)
+	if n := removed("regular", regular); n < 1 || n >= nhb {
+		t.Errorf("regular keepalives, threshold 3: expecting removal after fewer than %d retries, got %d", nhb, n)
+	}
+}
+
+func TestKeepaliveSwitchTracker(t *testing.T) {
+	k := &keepalive{name: "test"}
+	k.setHB(newTracker(&cmn.KeepaliveTrackerConf{Name: cmn.KeepaliveHeartbeat, Interval: cos.Duration(time.Second)}))
+	k.interval = time.Second
+
+	k.configUpdate(&cmn.KeepaliveTrackerConf{Name: cmn.KeepaliveHeartbeat, Interval: cos.Duration(2 * time.Second)})
+	if _, ok := k.hb().(*heartBeat); !ok || k.interval != 2*time.Second {
+		t.Fatalf("expecting heartbeat with updated interval, got %T, %v", k.hb(), k.interval)
+	}
+	k.configUpdate(&cmn.KeepaliveTrackerConf{Name: cmn.KeepalivePhiAccrual, Interval: cos.Duration(time.Second), Factor: 10})
+	pa, ok := k.hb().(*phiAccrual)
+	if !ok || k.interval != time.Second || pa.threshold.Load() != 10 {
+		t.Fatalf("expecting phi-accrual tracker, got %T, %v", k.hb(), k.interval)
+	}
+	k.configUpdate(&cmn.KeepaliveTrackerConf{Name: cmn.KeepaliveHeartbeat, Interval: cos.Duration(time.Second)})
+	if _, ok := k.hb().(*heartBeat); !ok {
+		t.Fatalf("expecting heartbeat tracker, got %T", k.hb())
+	}
+}
@@ -0,0 +1,200 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Primary lease (split-brain fencing)
+//
+// The primary holds a time-bounded lease that it (re)acquires, once per keepalive
+// cycle, by counting recent acknowledgments - keepalives, health pings, and successful
+// intra-cluster calls - from a majority of (non-maintenance) cluster nodes.
+// A primary that cannot renew steps down: it refuses to metasync, to modify cluster
+// membership, and to execute control-plane requests that change cluster metadata.
+//
+// Lease epoch is the Smap version at which the node assumed its primary role
+// (note that election bumps the Smap version - see _becomePre). Every metasync
+// request carries the sender's epoch (apc.HdrPrimaryEpoch); receivers remember the
+// highest epoch they have seen and reject (409) metasync from a primary with a stale one.
+
+const leaseFactor = 3 // lease duration in keepalive intervals
+
+const dfltLeaseDur = 30 * time.Second // when not configured (unit tests)
+
+type primaryLease struct {
+	acks    sync.Map     // node ID => mono-time of the last acknowledgment
+	expires atomic.Int64 // mono-time
+	epoch   atomic.Int64
+	fenced  atomic.Bool // stepped down upon seeing a newer epoch
+	lost    atomic.Bool // (logging)
+}
+
+var errLeaseExpired = errors.New("primary lease expired")
+
+func leaseDur(config *cmn.Config) time.Duration {
+	d := max(config.Keepalive.Proxy.Interval.D(), config.Keepalive.Target.Interval.D())
+	if d == 0 {
+		return dfltLeaseDur
+	}
+	return leaseFactor * d
+}
+
+func (l *primaryLease) ack(sid string, now int64) { l.acks.Store(sid, now) }
+
+// lazily grant the lease upon becoming primary
+func (l *primaryLease) grant(smap *smapX, now int64, dur time.Duration) int64 {
+	if epoch := l.epoch.Load(); epoch != 0 {
+		return epoch
+	}
+	if l.epoch.CAS(0, smap.version()) {
+		l.expires.Store(now + dur.Nanoseconds())
+		l.lost.Store(false)
+	}
+	return l.epoch.Load()
+}
+
+// count fresh acknowledgments from the current voting membership (self included);
+// maintenance and decommissioning nodes do not vote
+func (l *primaryLease) quorum(smap *smapX, self string, now int64, dur time.Duration) (n, quorum int) {
+	var voters int
+	for _, nm := range []meta.NodeMap{smap.Tmap, smap.Pmap} {
+		for sid, si := range nm {
+			if si.InMaintOrDecomm() {
+				continue
+			}
+			voters++
+			if sid == self {
+				n++
+				continue
+			}
+			if v, ok := l.acks.Load(sid); ok && now-v.(int64) < dur.Nanoseconds() {
+				n++
+			}
+		}
+	}
+	return n, voters/2 + 1
+}
+
+// extend the lease if (and only if) acknowledged by the majority
+func (l *primaryLease) renew(smap *smapX, self string, now int64, dur time.Duration) (n, quorum int, ok bool) {
+	l.grant(smap, now, dur)
+	n, quorum = l.quorum(smap, self, now, dur)
+	if ok = n >= quorum && !l.fenced.Load(); ok {
+		l.expires.Store(now + dur.Nanoseconds())
+	}
+	return n, quorum, ok
+}
+
+func (l *primaryLease) valid(now int64) bool { return now < l.expires.Load() }
+
+// step down: no more renewals until the node stops being primary (and becomes one again)
+func (l *primaryLease) revoke() {
+	l.fenced.Store(true)
+	l.expires.Store(0)
+}
+
+func (l *primaryLease) reset() {
+	if l.epoch.Load() == 0 {
+		return
+	}
+	l.epoch.Store(0)
+	l.expires.Store(0)
+	l.fenced.Store(false)
+	l.lost.Store(false)
+	l.acks.Range(func(k, _ any) bool {
+		l.acks.Delete(k)
+		return true
+	})
+}
+
+//
+// proxy
+//
+
+// lease is enforced only once the cluster is up and running:
+// during startup the primary is still waiting for nodes to join
+func (p *proxy) checkLease(smap *smapX) error {
+	var (
+		now = mono.NanoTime()
+		dur = leaseDur(cmn.GCO.Get())
+	)
+	p.lease.grant(smap, now, dur)
+	if !p.ClusterStarted() && !p.lease.fenced.Load() {
+		p.lease.expires.Store(now + dur.Nanoseconds())
+		return nil
+	}
+	if p.lease.valid(now) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w (epoch %d, %s)", p, errLeaseExpired, p.lease.epoch.Load(), smap.StringEx())
+}
+
+// via palive, once per keepalive interval
+func (p *proxy) renewLease(smap *smapX, config *cmn.Config) {
+	n, quorum, ok := p.lease.renew(smap, p.SID(), mono.NanoTime(), leaseDur(config))
+	switch {
+	case ok && p.lease.lost.CAS(true, false):
+		nlog.Infof("%s: primary lease regained (epoch %d, acks %d/%d)", p, p.lease.epoch.Load(), n, quorum)
+	case !ok && !p.lease.valid(mono.NanoTime()) && p.lease.lost.CAS(false, true):
+		nlog.Errorf("%s: %v - stepping down (epoch %d, acks %d of the required %d, %s)",
+			p, errLeaseExpired, p.lease.epoch.Load(), n, quorum, smap.StringEx())
+	}
+}
+
+// a primary that lost its lease yields to a primary with a newer epoch
+func (p *proxy) yieldLease(epoch int64) bool {
+	return epoch > p.lease.epoch.Load() && !p.lease.valid(mono.NanoTime())
+}
+
+func (p *proxy) setEpochHdr(hdr http.Header) {
+	if epoch := p.lease.epoch.Load(); epoch > 0 {
+		hdr.Set(apc.HdrPrimaryEpoch, strconv.FormatInt(epoch, 10))
+	}
+}
+
+//
+// metasync receiver (proxy or target)
+//
+
+func epochFromHdr(r *http.Request) (epoch int64) {
+	epoch, _ = strconv.ParseInt(r.Header.Get(apc.HdrPrimaryEpoch), 10, 64)
+	return epoch
+}
+
+// returns non-nil error if the sender's epoch is older than the highest seen
+func (h *htrun) checkEpoch(r *http.Request, e *errMsync) error {
+	epoch := epochFromHdr(r)
+	if epoch == 0 {
+		return nil // (older sender)
+	}
+	for {
+		seen := h.msyncEpoch.Load()
+		if epoch < seen {
+			e.Epoch = seen
+			e.Cii.fill(h)
+			e.Message = fmt.Sprintf("%s: stale primary epoch %d from %s (have %d)", h, epoch,
+				r.Header.Get(apc.HdrCallerName), seen)
+			return errors.New(cos.MustMarshalToString(e))
+		}
+		if epoch == seen || h.msyncEpoch.CAS(seen, epoch) {
+			return nil
+		}
+	}
+}
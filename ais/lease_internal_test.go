@@ -0,0 +1,221 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// partition-simulation harness: nodes can talk to each other iff they are on the same side
+type splitNet struct {
+	nodes map[string]*proxy // NOTE: targets, too (on the receiving side only htrun matters)
+	side  map[string]int
+}
+
+func newSplitNet(pids, tids []string) (n *splitNet, smap *smapX) {
+	n = &splitNet{nodes: make(map[string]*proxy), side: make(map[string]int)}
+	smap = newSmap()
+	smap.UUID = cos.GenUUID()
+	smap.Version = 10
+	for _, id := range pids {
+		p := newLeaseNode(id, apc.Proxy)
+		n.nodes[id] = p
+		smap.addProxy(p.si)
+	}
+	for _, id := range tids {
+		t := newLeaseNode(id, apc.Target)
+		n.nodes[id] = t
+		smap.addTarget(t.si)
+	}
+	smap.Primary = smap.GetProxy(pids[0])
+	for _, p := range n.nodes {
+		p.owner.smap.put(smap)
+	}
+	return n, smap
+}
+
+func newLeaseNode(id, daeType string) *proxy {
+	config := cmn.GCO.Get()
+	p := &proxy{}
+	p.si = newSnode(id, daeType, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	p.owner.smap = newSmapOwner(config)
+	bo := newBMDOwnerPrx(config)
+	bo.put(newBucketMD())
+	p.owner.bmd = bo
+	p.owner.rmd = newRMDOwner()
+	p.owner.config = newConfigOwner(config)
+	eo := newEtlMDOwnerPrx(config)
+	eo.put(newEtlMD())
+	p.owner.etl = eo
+	p.metasyncer = newMetasyncer(p)
+	p.markClusterStarted()
+	return p
+}
+
+func (n *splitNet) partition(sides ...[]string) {
+	for i, ids := range sides {
+		for _, id := range ids {
+			n.side[id] = i
+		}
+	}
+}
+
+func (n *splitNet) reachable(a, b string) bool { return n.side[a] == n.side[b] }
+
+// one keepalive round as seen by the primary: acks from reachable nodes, renewal
+func (n *splitNet) kalive(p *proxy) {
+	var (
+		smap = p.owner.smap.get()
+		now  = mono.NanoTime()
+	)
+	for sid := range n.nodes {
+		if sid != p.SID() && smap.GetNode(sid) != nil && n.reachable(sid, p.SID()) {
+			p.lease.ack(sid, now)
+		}
+	}
+	p.renewLease(smap, cmn.GCO.Get())
+}
+
+// metasync from the primary to all reachable nodes; returns receivers' errors
+func (n *splitNet) msync(p *proxy) (errs map[string]error, err error) {
+	smap := p.owner.smap.get()
+	if err = p.checkLease(smap); err != nil {
+		return nil, err
+	}
+	errs = make(map[string]error)
+	for sid, node := range n.nodes {
+		if sid == p.SID() || !n.reachable(sid, p.SID()) {
+			continue
+		}
+		r := httptest.NewRequest(http.MethodPut, apc.URLPathMetasync.S, http.NoBody)
+		p.setEpochHdr(r.Header)
+		r.Header.Set(apc.HdrCallerName, p.si.Name())
+		if e := node.checkEpoch(r, &errMsync{}); e != nil {
+			errs[sid] = e
+		}
+	}
+	return errs, nil
+}
+
+func TestPrimaryLeaseSplitBrain(t *testing.T) {
+	const interval = 10 * time.Millisecond
+	var (
+		config = cmn.GCO.BeginUpdate()
+		pi, ti = config.Keepalive.Proxy.Interval, config.Keepalive.Target.Interval
+	)
+	config.Keepalive.Proxy.Interval = cos.Duration(interval)
+	config.Keepalive.Target.Interval = cos.Duration(interval)
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Keepalive.Proxy.Interval, config.Keepalive.Target.Interval = pi, ti
+		cmn.GCO.CommitUpdate(config)
+	}()
+	dur := leaseDur(cmn.GCO.Get())
+
+	n, smap := newSplitNet([]string{"p1", "p2", "p3"}, []string{"t1", "t2"})
+	p1, p2 := n.nodes["p1"], n.nodes["p2"]
+
+	// 1. healthy cluster
+	n.partition([]string{"p1", "p2", "p3", "t1", "t2"})
+	n.kalive(p1)
+	errs, err := n.msync(p1)
+	if err != nil || len(errs) != 0 {
+		t.Fatalf("healthy cluster: %v, %v", err, errs)
+	}
+	if epoch := p1.lease.epoch.Load(); epoch != smap.Version {
+		t.Fatalf("expected epoch %d, got %d", smap.Version, epoch)
+	}
+
+	// 2. partition: the primary ends up in the minority
+	n.partition([]string{"p1", "t1"}, []string{"p2", "p3", "t2"})
+	deadline := mono.NanoTime() + 2*dur.Nanoseconds()
+	for mono.NanoTime() < deadline {
+		n.kalive(p1)
+		time.Sleep(interval)
+	}
+	if _, err := n.msync(p1); !errors.Is(err, errLeaseExpired) {
+		t.Fatalf("minority primary: expected %v, got %v", errLeaseExpired, err)
+	}
+	if err := (&palive{p: p1})._pre(&smapModifier{}, nil); !errors.Is(err, errLeaseExpired) {
+		t.Fatalf("minority primary must not shrink the membership: %v", err)
+	}
+	if !p1.lease.lost.Load() {
+		t.Fatal("expected lease loss to be recorded")
+	}
+
+	// 3. the majority elects p2 (election bumps Smap version)
+	newSmap := smap.clone()
+	newSmap.Primary = newSmap.GetProxy(p2.SID())
+	newSmap.Version += 100
+	for _, sid := range []string{"p2", "p3", "t2"} {
+		n.nodes[sid].owner.smap.put(newSmap)
+	}
+	n.kalive(p2)
+	if errs, err := n.msync(p2); err != nil || len(errs) != 0 {
+		t.Fatalf("majority primary: %v, %v", err, errs)
+	}
+	if epoch := p2.lease.epoch.Load(); epoch != newSmap.Version {
+		t.Fatalf("expected epoch %d, got %d", newSmap.Version, epoch)
+	}
+
+	// 4. heal: nodes that have seen the newer epoch fence off the old primary
+	n.partition([]string{"p1", "p2", "p3", "t1", "t2"})
+	for sid, node := range n.nodes {
+		if sid == p1.SID() || sid == p2.SID() {
+			continue
+		}
+		r := httptest.NewRequest(http.MethodPut, apc.URLPathMetasync.S, http.NoBody)
+		r.Header.Set(apc.HdrPrimaryEpoch, strconv.FormatInt(p1.lease.epoch.Load(), 10))
+		err := node.checkEpoch(r, &errMsync{})
+		if sid == "t1" {
+			// (was on the minority side)
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", sid, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%s: expected stale epoch to be rejected", sid)
+		}
+		e := err2MsyncErr(err)
+		if e == nil || e.Epoch != newSmap.Version {
+			t.Fatalf("%s: expected epoch %d in %v", sid, newSmap.Version, err)
+		}
+		// the old primary steps down
+		if p1.metasyncer.remainPrimary(e, node.si, p1.owner.smap.get()) {
+			t.Fatalf("%s: old primary expected to step down", sid)
+		}
+	}
+
+	// even with the majority acking, fenced-off primary does not renew
+	n.kalive(p1)
+	if _, err := n.msync(p1); !errors.Is(err, errLeaseExpired) {
+		t.Fatalf("fenced primary: expected %v, got %v", errLeaseExpired, err)
+	}
+
+	// and yields to the new one
+	if !p1.yieldLease(p2.lease.epoch.Load()) {
+		t.Fatal("old primary expected to accept metasync from the new one")
+	}
+	if p2.yieldLease(p1.lease.epoch.Load()) {
+		t.Fatal("new primary must not yield to the old one")
+	}
+	errs, err = n.msync(p2)
+	if err != nil || len(errs) != 0 {
+		t.Fatalf("healed cluster: %v, %v", err, errs)
+	}
+}
@@ -132,6 +132,7 @@ type (
 	errMsync struct {
 		Message string      `json:"message"`
 		Cii     clusterInfo `json:"cii"`
+		Epoch   int64       `json:"epoch,omitempty"` // receiver's primary lease epoch (when rejecting a stale one)
 	}
 )
 
@@ -266,6 +267,9 @@ drain:
 		}
 	}
 	y.workCh <- revsReq{}
+	if smap := y.p.owner.smap.get(); !smap.isPrimary(y.p.si) {
+		y.p.lease.reset()
+	}
 	nlog.Infof("%s: becoming non-primary", y.p)
 }
 
@@ -332,7 +336,8 @@ func (y *metasyncer) do(pairs []revsPair, reqT int) (failedCnt int) {
 		to = core.Targets
 	}
 	args := allocBcArgs()
-	args.req = cmn.HreqArgs{Method: method, Path: urlPath, BodyR: body}
+	args.req = cmn.HreqArgs{Method: method, Path: urlPath, BodyR: body, Header: http.Header{}}
+	y.p.setEpochHdr(args.req.Header)
 	args.smap = smap
 	args.timeout = cmn.Rom.MaxKeepalive() // making exception for this critical op
 	args.to = to
@@ -442,7 +447,8 @@ func (y *metasyncer) syncDone(si *meta.Snode, pairs []revsPair) {
 
 func (y *metasyncer) handleRefused(method, urlPath string, body io.Reader, refused meta.NodeMap, pairs []revsPair, smap *smapX) (ok bool) {
 	args := allocBcArgs()
-	args.req = cmn.HreqArgs{Method: method, Path: urlPath, BodyR: body}
+	args.req = cmn.HreqArgs{Method: method, Path: urlPath, BodyR: body, Header: http.Header{}}
+	y.p.setEpochHdr(args.req.Header)
 	args.network = cmn.NetIntraControl
 	args.timeout = cmn.Rom.MaxKeepalive()
 	args.nodes = []meta.NodeMap{refused}
@@ -549,7 +555,8 @@ func (y *metasyncer) handlePending() (failedCnt int) {
 		body    = payload.marshal(y.p.gmm)
 		args    = allocBcArgs()
 	)
-	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: urlPath, BodyR: body}
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: urlPath, BodyR: body, Header: http.Header{}}
+	y.p.setEpochHdr(args.req.Header)
 	args.network = cmn.NetIntraControl
 	args.timeout = cmn.Rom.MaxKeepalive()
 	args.nodes = []meta.NodeMap{pending}
@@ -594,6 +601,13 @@ func (y *metasyncer) remainPrimary(e *errMsync, from *meta.Snode, smap *smapX) b
 		cos.ExitLogf("%s: split-brain uuid [%s %s] vs %v from %s", ciError(90), y.p.si, smap.StringEx(),
 			e.Cii, from)
 	}
+	if e.Epoch > y.p.lease.epoch.Load() {
+		nlog.Warningf("%s: fenced off by primary epoch %d (own %d) from %s [%v]", y.p, e.Epoch,
+			y.p.lease.epoch.Load(), from, e.Cii)
+		y.p.lease.revoke()
+		y.becomeNonPrimary()
+		return false
+	}
 	if e.Cii.Smap.Primary.ID == "" || e.Cii.Smap.Primary.ID == y.p.SID() {
 		return true
 	}
@@ -610,9 +624,14 @@ func (y *metasyncer) remainPrimary(e *errMsync, from *meta.Snode, smap *smapX) b
 	return true // TODO: iffy; may need to do more
 }
 
+// NOTE: primary that cannot renew its lease (see lease.go) refuses to distribute
 func (y *metasyncer) isPrimary() (err error) {
 	smap := y.p.owner.smap.get()
 	if smap.isPrimary(y.p.si) {
+		err = y.p.checkLease(smap)
+		if err != nil {
+			nlog.Errorln(err)
+		}
 		return
 	}
 	err = newErrNotPrimary(y.p.si, smap)
@@ -22,6 +22,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -39,6 +40,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -61,6 +63,8 @@ type (
 		rproxy     reverseProxy
 		notifs     notifs
 		lstca      lstca
+		jobq       jobq
+		tiering    tierSched
 		reg        struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -71,6 +75,7 @@ type (
 			mu  sync.RWMutex
 			in  atomic.Bool
 		}
+		lease             primaryLease
 		settingNewPrimary atomic.Bool // primary executing "set new primary" request (state)
 		readyToFastKalive atomic.Bool // primary can accept fast keepalives
 	}
@@ -189,6 +194,9 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	rlims.init()
+	p.jobq.init(p)
+	p.tiering.init(p)
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -642,6 +650,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.origURLBck = origURLBck[0]
 	}
 	bck, err := bckArgs.initAndTry()
+	tk := bckArgs.tk
 	freeBctx(bckArgs)
 
 	objName := apireq.items[1]
@@ -660,7 +669,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infoln("GET " + bck.Cname(objName) + " => " + tsi.String())
 	}
-	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData, netPub)
+	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, tk, cmn.NetIntraData, netPub)
 	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
 
 	// 4. stats
@@ -703,6 +712,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
 	bck, err := bckArgs.initAndTry()
+	tk := bckArgs.tk
 	freeBctx(bckArgs)
 	if err != nil {
 		return
@@ -742,7 +752,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		nlog.Infof("%s %s => %s%s", verb, bck.Cname(objName), tsi.StringEx(), s)
 	}
 
-	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub)
+	redirectURL := p.redirectURL(r, tsi, started, tk, cmn.NetIntraData, netPub)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 
 	// 4. stats
@@ -776,7 +786,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infoln("DELETE " + bck.Cname(objName) + " => " + tsi.StringEx())
 	}
-	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, nil /*tk*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 
 	p.statsT.Inc(stats.DeleteCount)
@@ -823,8 +833,8 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			return
 		}
 		keepMD := cos.IsParseBool(apireq.query.Get(apc.QparamKeepRemote))
-		// HDFS buckets will always keep metadata so they can re-register later
-		if bck.IsHDFS() || keepMD {
+		// HDFS (and POSIX) buckets will always keep metadata so they can re-register later
+		if bck.IsHDFS() || bck.IsPOSIX() || keepMD {
 			if err := p.destroyBucketData(msg, bck); err != nil {
 				p.writeErr(w, r, err)
 			}
@@ -851,7 +861,8 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			p.reverseRemAis(w, r, msg, bck.Bucket(), apireq.query)
 			return
 		}
-		if err := p.destroyBucket(msg, bck); err != nil {
+		destroyRemote := bck.IsCloud() && cos.IsParseBool(apireq.query.Get(apc.QparamDestroyRemote))
+		if err := p.destroyBucket(msg, bck, destroyRemote); err != nil {
 			if cmn.IsErrBckNotFound(err) {
 				nlog.Infof("%s: %s already %q-ed, nothing to do", p, bck, msg.Action)
 			} else {
@@ -889,7 +900,7 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	smap := p.owner.smap.get()
-	if smap.isPrimary(p.si) {
+	if smap.isPrimary(p.si) && !p.yieldLease(epochFromHdr(r)) {
 		const txt = "is primary, cannot be on the receiving side of metasync"
 		cii.fill(&p.htrun)
 		if xctn := voteInProgress(); xctn != nil {
@@ -900,6 +911,10 @@ func (p *proxy) metasyncHandler(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, errors.New(cos.MustMarshalToString(err)), http.StatusConflict)
 		return
 	}
+	if errE := p.checkEpoch(r, err); errE != nil {
+		p.writeErr(w, r, errE, http.StatusConflict)
+		return
+	}
 	payload := make(msPayload)
 	if errP := payload.unmarshal(r.Body, "metasync put"); errP != nil {
 		cmn.WriteErr(w, r, errP)
@@ -1182,7 +1197,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			return
 		}
 		nlog.Infof("%s bucket %s => %s", msg.Action, bckFrom, bckTo)
-		if xid, err = p.renameBucket(bckFrom, bckTo, msg); err != nil {
+		xid, err = p.jobq.try(msg, bckFrom, bckTo, func(uuid string) (string, error) {
+			return p.renameBucket(bckFrom, bckTo, msg, uuid)
+		})
+		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
@@ -1258,7 +1276,9 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			xid, err = lstcx.do()
 		} else {
 			nlog.Infoln("x-tcb:", bckFrom.String(), "=>", bckTo.String())
-			xid, err = p.tcb(bckFrom, bckTo, msg, tcbmsg.DryRun)
+			xid, err = p.jobq.try(msg, bckFrom, bckTo, func(uuid string) (string, error) {
+				return p.tcb(bckFrom, bckTo, msg, tcbmsg.DryRun, uuid)
+			})
 		}
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1285,10 +1305,6 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			eq = true
 			nlog.Warningf("multi-object operation %q within the same bucket %q", msg.Action, bck)
 		}
-		if bckTo.IsHTTP() {
-			p.writeErrf(w, r, "cannot %s to HTTP bucket %q", msg.Action, bckTo)
-			return
-		}
 		if !eq {
 			bckTo, errCode, err = p.initBckTo(w, r, query, bckTo)
 			if err != nil {
@@ -1304,12 +1320,59 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				nlog.Infof(warnDstNotExist, p, bckTo, bck)
 			}
 		}
+		if bckTo.IsHTTP() && (bckTo.Props == nil || !bckTo.Props.Extra.HTTP.Writable) {
+			p.writeErrf(w, r, "cannot %s to HTTP bucket %q (extra.http.writable not set)", msg.Action, bckTo)
+			return
+		}
 
 		xid, err = p.tcobjs(bck, bckTo, cmn.GCO.Get(), msg, tcomsg)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActBckDiff:
+		bdmsg := &apc.BckDiffMsg{}
+		if err := cos.MorphMarshal(msg.Value, bdmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckTo, err := newBckFromQuname(query, true /*required*/)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		bckToArgs := bctx{p: p, w: w, r: r, bck: bckTo, perms: apc.AceObjLIST | apc.AceGET, query: query}
+		if bckTo, err = bckToArgs.initAndTry(); err != nil {
+			return
+		}
+		if bck.Equal(bckTo, true, true) {
+			p.writeErrf(w, r, "cannot %s bucket %q with itself", msg.Action, bck)
+			return
+		}
+		bdmsg.Report = cos.Either(bdmsg.Report, apc.BckDiffReportBck)
+		report := meta.NewBck(bdmsg.Report, apc.AIS, cmn.NsGlobal)
+		if report.Equal(bck, false, false) || report.Equal(bckTo, false, false) {
+			p.writeErrf(w, r, "%s: report bucket %q must be different from the buckets being compared", msg.Action, report)
+			return
+		}
+		if _, present := p.owner.bmd.get().Get(report); !present {
+			if p.forwardCP(w, r, msg, bucket) { // to create
+				return
+			}
+			if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+				return
+			}
+			nlog.Infoln(msg.Action, "creating report bucket", report.String())
+			if err := p.createBucket(&apc.ActMsg{Action: apc.ActCreateBck}, report, nil); err != nil && !cmn.IsErrBucketAlreadyExists(err) {
+				p.writeErr(w, r, err, crerrStatus(err))
+				return
+			}
+		}
+		msg.Value = bdmsg
+		if xid, err = p.bckDiff(bck, bckTo, msg); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActAddRemoteBck:
 		if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
 			return
@@ -1330,14 +1393,21 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		}
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
+		if bck.IsRemote() && bck.Props.ListCache.Enabled {
+			if err := p.invalListCache(msg, bck); err != nil {
+				p.writeErr(w, r, err)
+			}
+		}
 		return
 	case apc.ActMakeNCopies:
-		if xid, err = p.makeNCopies(msg, bck); err != nil {
+		xid, err = p.jobq.try(msg, bck, nil, func(uuid string) (string, error) { return p.makeNCopies(msg, bck, uuid) })
+		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
 	case apc.ActECEncode:
-		if xid, err = p.ecEncode(bck, msg); err != nil {
+		xid, err = p.jobq.try(msg, bck, nil, func(uuid string) (string, error) { return p.ecEncode(bck, msg, uuid) })
+		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
@@ -1397,6 +1467,11 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			errors.New("property 'extra.hdfs.ref_directory' must be specified when creating HDFS bucket"))
 		return
 	}
+	if bck.IsPOSIX() && msg.Value == nil {
+		p.writeErr(w, r,
+			errors.New("property 'extra.posix.root_dir' must be specified when creating POSIX bucket"))
+		return
+	}
 
 	if bck.IsRemote() {
 		// (feature) add Cloud bucket to BMD, to further set its `Props.Extra`
@@ -1426,18 +1501,20 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 
 		// remote: check existence and get (cloud) props
 		rhdr, statusCode, err := p.headRemoteBck(bck.RemoteBck(), nil)
-		if err != nil {
-			if bck.IsCloud() {
-				statusCode = http.StatusNotImplemented
-				err = cmn.NewErrNotImpl("create", bck.Provider+"(cloud) bucket")
-			} else if !bck.IsRemoteAIS() {
+		switch {
+		case err == nil:
+			remoteHdr = rhdr
+			msg.Action = apc.ActAddRemoteBck // ditto
+		case bck.IsCloud() && (statusCode == http.StatusNotFound || cmn.IsErrRemoteBckNotFound(err)):
+			// cloud bucket does not exist - create it (targets will call backend.CreateBucket)
+			nlog.Infoln(p.String()+":", "creating", bck.Cname(""))
+		default:
+			if !bck.IsCloud() && !bck.IsRemoteAIS() {
 				err = cmn.NewErrUnsupp("create", bck.Provider+":// bucket")
 			}
 			p.writeErr(w, r, err, statusCode)
 			return
 		}
-		remoteHdr = rhdr
-		msg.Action = apc.ActAddRemoteBck // ditto
 	}
 	// props-to-update at creation time
 	if msg.Value != nil {
@@ -1447,7 +1524,7 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			return
 		}
 		// Make and validate new bucket props.
-		bck.Props = defaultBckProps(bckPropsArgs{bck: bck})
+		bck.Props = defaultBckProps(bckPropsArgs{bck: bck, hdr: remoteHdr})
 		nprops, err := p.makeNewBckProps(bck, &propsToUpdate, true /*creating*/)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1473,7 +1550,7 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 				}
 			}
 		}
-		// Send all props to the target (required for HDFS).
+		// Send all props to the target (required for HDFS and POSIX).
 		msg.Value = bck.Props
 	}
 	if err := p.createBucket(msg, bck, remoteHdr); err != nil {
@@ -1524,7 +1601,7 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	case lsmsg.Props == apc.GetPropsNameSize:
 		lsmsg.SetFlag(apc.LsNameSize)
 	}
-	if bck.IsHTTP() || lsmsg.IsFlagSet(apc.LsArchDir) {
+	if (bck.IsHTTP() && bck.Props.Extra.HTTP.Listing == "") || lsmsg.IsFlagSet(apc.LsArchDir) {
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
 
@@ -1660,7 +1737,13 @@ func (p *proxy) _lsofc(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX) (tsi *meta
 		}
 		return
 	}
-	if tsi, err = smap.HrwTargetTask(lsmsg.UUID); err == nil {
+	if bck.Props.ListCache.Enabled {
+		// the target that maintains the bucket's list cache (see xs.LsoCacheOwner)
+		tsi, err = xs.LsoCacheOwner(&smap.Smap, bck)
+	} else {
+		tsi, err = smap.HrwTargetTask(lsmsg.UUID)
+	}
+	if err == nil {
 		lsmsg.SID = tsi.ID()
 	}
 	return
@@ -1711,6 +1794,24 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
 		}
+		args := &apc.PromoteArgs{}
+		if err := cos.MorphMarshal(msg.Value, args); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if len(args.Sources) > 0 {
+			if err := validatePrmSources(args); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+			xid, err := p.promote(bck, msg, nil /*tsi*/, true /*remote*/)
+			if err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+			w.Write([]byte(xid))
+			return
+		}
 		// ActionMsg.Name is the source
 		if !filepath.IsAbs(msg.Name) {
 			if msg.Name == "" {
@@ -1720,11 +1821,6 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			}
 			return
 		}
-		args := &apc.PromoteArgs{}
-		if err := cos.MorphMarshal(msg.Value, args); err != nil {
-			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
-			return
-		}
 		var tsi *meta.Snode
 		if args.DaemonID != "" {
 			smap := p.owner.smap.get()
@@ -1734,7 +1830,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 				return
 			}
 		}
-		xid, err := p.promote(bck, msg, tsi)
+		xid, err := p.promote(bck, msg, tsi, false /*remote*/)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -1922,6 +2018,19 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Tiering.Enabled {
+		// destination ("cold") bucket must exist, ditto
+		var tierBck *meta.Bck
+		if tierBck, err = _tierDst(bck, nprops); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		args := bctx{p: p, w: w, r: r, bck: tierBck, msg: msg, dpq: apireq.dpq, query: apireq.query}
+		args.createAIS = false
+		if _, err = args.initAndTry(); err != nil {
+			return
+		}
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -1955,7 +2064,7 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
-	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, nil /*tk*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
 
@@ -1983,7 +2092,7 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
 
@@ -1992,7 +2101,7 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		bmd     = p.owner.bmd.get()
 		present bool
 	)
-	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() {
+	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() || qbck.IsPOSIX() {
 		bcks := bmd.Select(qbck)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
@@ -2046,7 +2155,9 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 	debug.AssertNoErr(err)
 }
 
-func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIntra string, netPubs ...string) (redirect string) {
+// tk: the token validated by the access check (if any) - see redirectUID
+func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, tk *tok.Token, netIntra string,
+	netPubs ...string) (redirect string) {
 	var (
 		nodeURL string
 		netPub  = cmn.NetPublic
@@ -2080,6 +2191,10 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 		apc.QparamProxyID:  []string{p.SID()},
 		apc.QparamUnixTime: []string{cos.UnixNano2S(ts.UnixNano())},
 	}
+	if uid, usig := p.redirectUID(tk, query.Get(apc.QparamUnixTime)); uid != "" {
+		query.Set(apc.QparamUserID, uid)
+		query.Set(apc.QparamUserSig, usig)
+	}
 	redirect += query.Encode()
 	return
 }
@@ -2266,7 +2381,7 @@ func (p *proxy) redirectObjAction(w http.ResponseWriter, r *http.Request, bck *m
 	}
 
 	// NOTE: Code 307 is the only way to http-redirect with the original JSON payload.
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 
 	p.statsT.Inc(stats.RenameCount)
@@ -2300,6 +2415,29 @@ func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Valu
 	return
 }
 
+// remove targets' persistent list caches (see xs.LsoCacheInval)
+func (p *proxy) invalListCache(msg *apc.ActMsg, bck *meta.Bck) (err error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPost,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil)),
+	}
+	args.smap = p.owner.smap.get()
+	args.timeout = apc.DefaultTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err = res.errorf("%s failed to invalidate list cache %s", res.si, bck.Cname(""))
+			break
+		}
+	}
+	freeBcastRes(results)
+	return err
+}
+
 func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := p.parseURL(w, r, apc.URLPathReverse.L, 1, false)
 	if err != nil {
@@ -2487,6 +2625,9 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			K8sPodName:     os.Getenv(env.AIS.K8sPod),
 			Status:         p._status(smap),
 		}
+		if smap.isPrimary(p.si) {
+			msg.Suspicion = p.keepalive.suspicion()
+		}
 		daeStats := p.statsT.GetStats()
 		msg.Tracker = daeStats.Tracker
 
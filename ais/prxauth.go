@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -31,7 +32,10 @@ type (
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
-		version       int64
+		// S3 access key => session token of the active S3 credentials
+		// (pushed by AuthN - see validatePresignedS3)
+		s3Tokens map[string]string
+		version  int64
 	}
 )
 
@@ -40,11 +44,11 @@ type (
 /////////////////
 
 func newAuthManager() *authManager {
-	return &authManager{tkList: make(tkList), revokedTokens: make(map[string]bool), version: 1}
+	return &authManager{tkList: make(tkList), revokedTokens: make(map[string]bool), s3Tokens: make(map[string]string), version: 1}
 }
 
-// Add tokens to list of invalid ones. After that it cleans up the list
-// from expired tokens
+// Add tokens to list of invalid ones, and S3 session tokens to the list of active ones.
+// After that it cleans up both lists from expired (and revoked) tokens.
 func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *tokenList) {
 	a.Lock()
 	switch {
@@ -57,19 +61,27 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 		a.Unlock()
 		return
 	}
+	var (
+		now    = time.Now()
+		secret = cmn.GCO.Get().Auth.Secret
+	)
 	// add new
 	for _, token := range newRevoked.Tokens {
 		a.revokedTokens[token] = true
 		delete(a.tkList, token)
 	}
+	for _, token := range newRevoked.S3 {
+		tk, err := tok.DecryptToken(token, secret)
+		if err != nil || tk.S3AccessKey == "" {
+			nlog.Errorln("invalid S3 session token:", err)
+			continue
+		}
+		a.s3Tokens[tk.S3AccessKey] = token
+	}
 	allRevoked = &tokenList{
 		Tokens:  make([]string, 0, len(a.revokedTokens)),
 		Version: a.version,
 	}
-	var (
-		now    = time.Now()
-		secret = cmn.GCO.Get().Auth.Secret
-	)
 	for token := range a.revokedTokens {
 		tk, err := tok.DecryptToken(token, secret)
 		debug.AssertNoErr(err)
@@ -79,8 +91,17 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 			allRevoked.Tokens = append(allRevoked.Tokens, token)
 		}
 	}
+	for accessKey, token := range a.s3Tokens {
+		tk, err := tok.DecryptToken(token, secret)
+		debug.AssertNoErr(err)
+		if a.revokedTokens[token] || tk.Expires.Before(now) {
+			delete(a.s3Tokens, accessKey)
+		} else {
+			allRevoked.S3 = append(allRevoked.S3, token)
+		}
+	}
 	a.Unlock()
-	if len(allRevoked.Tokens) == 0 {
+	if len(allRevoked.Tokens) == 0 && len(allRevoked.S3) == 0 {
 		allRevoked = nil
 	}
 	return
@@ -89,7 +110,7 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 	a.Lock()
 	l := len(a.revokedTokens)
-	if l == 0 {
+	if l == 0 && len(a.s3Tokens) == 0 {
 		a.Unlock()
 		return
 	}
@@ -97,6 +118,17 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 	for token := range a.revokedTokens {
 		allRevoked.Tokens = append(allRevoked.Tokens, token)
 	}
+	for _, token := range a.s3Tokens {
+		allRevoked.S3 = append(allRevoked.S3, token)
+	}
+	a.Unlock()
+	return
+}
+
+// Returns session token of the active S3 credentials, if any
+func (a *authManager) s3Token(accessKey string) (token string, ok bool) {
+	a.Lock()
+	token, ok = a.s3Tokens[accessKey]
 	a.Unlock()
 	return
 }
@@ -164,9 +196,11 @@ func (p *proxy) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	case http.MethodPost:
 		p.validateSecret(w, r)
 	case http.MethodDelete:
-		p.httpTokenDelete(w, r)
+		p.httpTokenUpdate(w, r, "revoke token")
+	case http.MethodPut:
+		p.httpTokenUpdate(w, r, "add S3 credentials")
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost, http.MethodPut)
 	}
 }
 
@@ -187,11 +221,13 @@ func (p *proxy) validateSecret(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (p *proxy) httpTokenDelete(w http.ResponseWriter, r *http.Request) {
+// DELETE: revoke tokens; PUT: add session tokens of new S3 credentials
+// (both pushed by AuthN; primary then metasyncs the resulting list)
+func (p *proxy) httpTokenUpdate(w http.ResponseWriter, r *http.Request, tag string) {
 	if _, err := p.parseURL(w, r, apc.URLPathTokens.L, 0, false); err != nil {
 		return
 	}
-	if p.forwardCP(w, r, nil, "revoke token") {
+	if p.forwardCP(w, r, nil, tag) {
 		return
 	}
 	tokenList := &tokenList{}
@@ -219,6 +255,55 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 	return tk, nil
 }
 
+// Validates S3 presigned request (see cmn.S3Presign): expiration and signature that, in turn, cover
+// the method, bucket, and object. The access key resolves to the user's session token that AuthN
+// pushes to gateways (see tok.IssueS3JWT) - the token itself never appears in the URL.
+// Finally, checks the user's permissions for the (signed) operation on the bucket.
+func (p *proxy) validatePresignedS3(r *http.Request, items []string) (int, error) {
+	var ace apc.AccessAttrs
+	switch r.Method {
+	case http.MethodGet:
+		ace = apc.AceGET
+	case http.MethodHead:
+		ace = apc.AceObjHEAD
+	case http.MethodPut:
+		ace = apc.AcePUT
+	default:
+		return http.StatusMethodNotAllowed, fmt.Errorf("presigned %s is not supported", r.Method)
+	}
+	if len(items) < 2 {
+		return http.StatusBadRequest, errors.New("presigned: expecting bucket and object names")
+	}
+	ps, err := cmn.ParseS3Presigned(r.URL.Query())
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if ps.SessionToken != "" {
+		return http.StatusForbidden, fmt.Errorf("presigned: %s is not supported", cmn.S3QparamSecurityToken)
+	}
+	token, ok := p.authn.s3Token(ps.AccessKey)
+	if !ok {
+		return http.StatusForbidden, fmt.Errorf("presigned: unknown (or revoked) access key %q", ps.AccessKey)
+	}
+	tk, err := p.authn.validateToken(token)
+	if err != nil {
+		return http.StatusForbidden, err
+	}
+	debug.Assert(tk.S3AccessKey == ps.AccessKey)
+	secret := cmn.GCO.Get().Auth.Secret
+	if err := ps.Verify(r, tok.S3SecretKey(ps.AccessKey, secret), time.Now()); err != nil {
+		return http.StatusForbidden, err
+	}
+	bck, err, errCode := meta.InitByNameOnly(items[0], p.owner.bmd)
+	if err != nil {
+		return errCode, err
+	}
+	if err := tk.CheckPermissions(p.owner.smap.get().UUID, bck.Bucket(), ace); err != nil {
+		return http.StatusForbidden, err
+	}
+	return 0, nil
+}
+
 // When AuthN is on, accessing a bucket requires two permissions:
 //   - access to the bucket is granted to a user
 //   - bucket ACL allows the required operation
@@ -247,13 +332,16 @@ func aceErrToCode(err error) (status int) {
 	return status
 }
 
-func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	var (
-		tk     *tok.Token
-		bucket *cmn.Bck
-	)
+func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) error {
+	_, err := p.accessTk(hdr, bck, ace)
+	return err
+}
+
+// same as above, and returns the validated token (nil if AuthN is disabled, or no token)
+func (p *proxy) accessTk(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (tk *tok.Token, err error) {
+	var bucket *cmn.Bck
 	if p.isIntraCall(hdr, false /*from primary*/) == nil {
-		return nil
+		return nil, nil
 	}
 	if cmn.Rom.AuthEnabled() { // config.Auth.Enabled
 		tk, err = p.validateToken(hdr)
@@ -262,19 +350,19 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 			if err == tok.ErrNoToken && bck != nil && bck.IsHTTP() {
 				err = nil
 			}
-			return err
+			return nil, err
 		}
 		uid := p.owner.smap.Get().UUID
 		if bck != nil {
 			bucket = bck.Bucket()
 		}
 		if err := tk.CheckPermissions(uid, bucket, ace); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if bck == nil {
 		// cluster ACL: create/list buckets, node management, etc.
-		return nil
+		return tk, nil
 	}
 
 	// bucket access conventions:
@@ -286,7 +374,7 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 		ace &^= (apc.AcePATCH | apc.AceBckSetACL)
 	}
 	if ace == 0 {
-		return nil
+		return tk, nil
 	}
-	return bck.Allow(ace)
+	return tk, bck.Allow(ace)
 }
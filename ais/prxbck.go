@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -38,6 +39,8 @@ type bctx struct {
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
 
+	tk *tok.Token // result: validated token (if any) - see accessAllowed
+
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
 	skipBackend    bool // initialize bucket via `bck.InitNoBackend`
@@ -144,6 +147,12 @@ func (bctx *bctx) init() (errCode int, err error) {
 		}
 		bctx.perms = dtor.Access
 	}
+	// HTTP buckets are writeable only when configured as such
+	if bck.IsHTTP() && bctx._perm(apc.AcePUT) && !bck.Props.Extra.HTTP.Writable {
+		errCode = http.StatusMethodNotAllowed
+		err = cmn.NewErrUnsupp("write to HTTP bucket", bck.Cname("")+" (extra.http.writable not set)")
+		return
+	}
 	errCode, err = bctx.accessAllowed(bck)
 	return
 }
@@ -162,29 +171,36 @@ func (bctx *bctx) accessSupported() error {
 		op = "rename/move remote bucket"
 		goto rerr
 	}
-	// accept rename (check!) HDFS buckets are fine across the board
-	if bctx.bck.IsHDFS() {
+	// accept rename (check!) HDFS and POSIX buckets are fine across the board
+	if bctx.bck.IsHDFS() || bctx.bck.IsPOSIX() {
 		return nil
 	}
-	// HTTP buckets are not writeable
-	if bctx.bck.IsHTTP() && bctx._perm(apc.AcePUT) {
-		op = "write to HTTP bucket"
-		goto rerr
-	}
-	// Cloud bucket: destroy op. not allowed, and not supported yet
+	// Cloud bucket: destroy op. requires explicit opt-in (apc.QparamDestroyRemote)
 	// (have no separate perm for eviction, that's why an extra check)
 	if rmb := bctx.bck.IsCloud() && bctx._perm(apc.AceDestroyBucket) && bctx.msg.Action == apc.ActDestroyBck; !rmb {
 		return nil
 	}
-	op = "destroy cloud bucket"
+	if cos.IsParseBool(bctx.query.Get(apc.QparamDestroyRemote)) {
+		return nil
+	}
+	op = "destroy cloud bucket (without explicit '" + apc.QparamDestroyRemote + "')"
 rerr:
 	return cmn.NewErrUnsupp(op, bctx.bck.Cname(""))
 }
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (errCode int, err error) {
-	err = bctx.p.access(bctx.r.Header, bck, bctx.perms)
-	errCode = aceErrToCode(err)
+	tk, err := bctx.p.accessTk(bctx.r.Header, bck, bctx.perms)
+	if errCode = aceErrToCode(err); err != nil {
+		return errCode, err
+	}
+	bctx.tk = tk
+	if bctx.perms&aceDataPath == 0 {
+		return 0, nil
+	}
+	if err = bctx.p.rateLimit(bctx.w, bctx.r, bck, tk); err != nil {
+		errCode = http.StatusTooManyRequests
+	}
 	return errCode, err
 }
 
@@ -260,9 +276,9 @@ func (bctx *bctx) _try() (bck *meta.Bck, errCode int, err error) {
 		return
 	}
 
-	// if HDFS bucket is not present in the BMD there is no point
-	// in checking if it exists remotely (in re: `ref_directory`)
-	if bctx.bck.IsHDFS() {
+	// if HDFS (POSIX) bucket is not present in the BMD there is no point
+	// in checking if it exists remotely (in re: `ref_directory` and `root_dir`)
+	if bctx.bck.IsHDFS() || bctx.bck.IsPOSIX() {
 		err = cmn.NewErrBckNotFound(bctx.bck.Bucket())
 		errCode = http.StatusNotFound
 		return
@@ -74,6 +74,11 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
+	case apc.WhatQueuedJobs:
+		if p.forwardCP(w, r, nil, what) {
+			return
+		}
+		p.writeJSON(w, r, p.jobq.list(), what)
 	case apc.WhatNodeStats:
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
@@ -1008,6 +1013,17 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactPause, apc.ActXactResume:
+		p.xpause(w, r, msg)
+	case apc.ActPrioritizeJob:
+		var priority int
+		if err := cos.MorphMarshal(msg.Value, &priority); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := p.jobq.prioritize(msg.Name, priority); err != nil {
+			p.writeErr(w, r, err)
+		}
 	case apc.ActSendOwnershipTbl:
 		p.sendOwnTbl(w, r, msg)
 	default:
@@ -1241,6 +1257,11 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	// (lso + tco) special
 	p.lstca.abort(&xargs)
 
+	// queued (not yet started) job(s)
+	if n := p.jobq.cancel(&xargs); n > 0 && xargs.ID != "" {
+		return
+	}
+
 	if xargs.Kind == apc.ActRebalance {
 		// disallow aborting rebalance during
 		// critical (meta.SnodeMaint => meta.SnodeMaintPostReb) and (meta.SnodeDecomm => removed) transitions
@@ -1275,6 +1296,44 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// pause or resume (see related: `xact.Descriptor.Pausable`)
+func (p *proxy) xpause(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		xargs = xact.ArgsMsg{}
+	)
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if xargs.Kind != "" {
+		kind, _ := xact.GetKindName(xargs.Kind) // display name => kind
+		if kind == "" {
+			p.writeErrf(w, r, cmn.FmtErrUnknown, p.si, "xaction kind", xargs.Kind)
+			return
+		}
+		if !xact.Table[kind].Pausable {
+			p.writeErr(w, r, cmn.NewErrUnsupp(msg.Action, xargs.String()))
+			return
+		}
+		xargs.Kind = kind
+	}
+
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			break
+		}
+	}
+	freeBcastRes(results)
+}
+
 func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	// note operational priority over config-disabled `errRebalanceDisabled`
 	if err := p.canRebalance(); err != nil && err != errRebalanceDisabled {
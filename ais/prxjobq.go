@@ -0,0 +1,234 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Job queue: jobs (copy-bucket, ec-encode, etc.) that cannot start right away due to
+// "limited coexistence" conflicts (e.g., with rebalance or resilver - see xreg.LimitedCoexistence)
+// get queued by the primary, provided feat.QueueLimitedCoexistence is set.
+// Queued jobs are periodically retried - in the order of their respective priorities -
+// and start automatically once the conflict clears.
+// The ID of a queued job is the ID of the xaction it is going to run as - clients
+// can wait on it right away (see ic.xstatusOne).
+// All the respective requests are executed by the primary (non-primary proxies forward them).
+// The queue is in-memory and does not survive primary restart (or change).
+
+const jobqIval = 10 * time.Second
+
+type (
+	qjob struct {
+		run func(xid string) (string, error) // start the job (under the given xaction ID)
+		xact.QueuedJob
+	}
+	jobq struct {
+		p    *proxy
+		jobs []*qjob // sorted by priority (descending), FIFO within the same priority
+		mu   sync.Mutex
+		busy atomic.Bool
+	}
+)
+
+func (q *jobq) init(p *proxy) {
+	q.p = p
+	hk.Reg("job-queue"+hk.NameSuffix, q.housekeep, jobqIval)
+}
+
+// start the job or, if the conflict in question is "limited coexistence", put it in the queue
+// and return the ID the job will eventually run under
+// (the caller is the primary - see forwardCP)
+func (q *jobq) try(msg *apc.ActMsg, bck, bckTo *meta.Bck, run func(string) (string, error)) (string, error) {
+	uuid := cos.GenUUID()
+	xid, err := run(uuid)
+	if err == nil || !cmn.IsErrLimitedCoexistence(err) || !cmn.Rom.Features().IsSet(feat.QueueLimitedCoexistence) {
+		return xid, err
+	}
+	if !q.p.owner.smap.get().isPrimary(q.p.si) {
+		return xid, err // (primary has changed in the meantime)
+	}
+	job := &qjob{run: run}
+	job.ID = uuid
+	job.Kind = msg.Action
+	job.Cause = err.Error()
+	job.Bck = bck.Clone()
+	if bckTo != nil {
+		job.DstBck = bckTo.Clone()
+	}
+	job.Queued = time.Now().UnixNano()
+
+	q.insert(job)
+
+	nlog.Infoln(q.p.String()+": queued", job.String(), "cause:", err)
+	return job.ID, nil
+}
+
+// status of a queued (not yet started) job, if any
+func (q *jobq) status(id string) *nl.Status {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, job := range q.jobs {
+		if job.ID == id {
+			return &nl.Status{Kind: job.Kind, UUID: job.ID}
+		}
+	}
+	return nil
+}
+
+func (q *jobq) list() []*xact.QueuedJob {
+	q.mu.Lock()
+	out := make([]*xact.QueuedJob, 0, len(q.jobs))
+	for _, job := range q.jobs {
+		clone := job.QueuedJob
+		out = append(out, &clone)
+	}
+	q.mu.Unlock()
+	return out
+}
+
+func (q *jobq) prioritize(id string, priority int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, job := range q.jobs {
+		if job.ID != id {
+			continue
+		}
+		job.Priority = priority
+		sort.SliceStable(q.jobs, func(i, j int) bool {
+			if q.jobs[i].Priority != q.jobs[j].Priority {
+				return q.jobs[i].Priority > q.jobs[j].Priority
+			}
+			return q.jobs[i].Queued < q.jobs[j].Queued
+		})
+		return nil
+	}
+	return cos.NewErrNotFound(q.p, "queued job "+id)
+}
+
+// remove queued job(s) by ID or, if ID is not specified, by kind and (optionally) bucket;
+// return the number of removed jobs
+func (q *jobq) cancel(xargs *xact.ArgsMsg) (n int) {
+	q.mu.Lock()
+	for i := 0; i < len(q.jobs); i++ {
+		job := q.jobs[i]
+		switch {
+		case xargs.ID != "":
+			if job.ID != xargs.ID {
+				continue
+			}
+		case xargs.Kind != "":
+			if job.Kind != xargs.Kind || (!xargs.Bck.IsEmpty() && !xargs.Bck.Equal(&job.Bck)) {
+				continue
+			}
+		default:
+			continue
+		}
+		q.jobs = append(q.jobs[:i], q.jobs[i+1:]...)
+		i--
+		n++
+		nlog.Infoln(q.p.String()+": canceled queued", job.String())
+	}
+	q.mu.Unlock()
+	return n
+}
+
+func (q *jobq) housekeep() time.Duration {
+	q.mu.Lock()
+	l := len(q.jobs)
+	q.mu.Unlock()
+	if l == 0 {
+		return jobqIval
+	}
+	if !q.p.owner.smap.get().isPrimary(q.p.si) {
+		q.mu.Lock()
+		nlog.Warningln(q.p.String(), "is not primary anymore - dropping", len(q.jobs), "queued job(s)")
+		q.jobs = q.jobs[:0]
+		q.mu.Unlock()
+		return jobqIval
+	}
+	// retrying entails a full transaction round-trip with each target - don't block housekeeper
+	if q.busy.CAS(false, true) {
+		go q.dispatch()
+	}
+	return jobqIval
+}
+
+func (q *jobq) dispatch() {
+	q.mu.Lock()
+	jobs := make([]*qjob, len(q.jobs))
+	copy(jobs, q.jobs)
+	q.mu.Unlock()
+
+	for _, job := range jobs {
+		if !q.dequeue(job) { // canceled in the meantime
+			continue
+		}
+		started := mono.NanoTime()
+		xid, err := job.run(job.ID)
+		switch {
+		case err == nil:
+			nlog.Infoln(q.p.String()+": started queued", job.String(), "xid:", xid, "in", mono.Since(started))
+		case cmn.IsErrLimitedCoexistence(err):
+			job.Cause = err.Error()
+			job.Attempts++
+			q.insert(job)
+		default:
+			nlog.Errorln(q.p.String()+": failed to start queued", job.String(), "err:", err)
+		}
+	}
+	q.busy.Store(false)
+}
+
+func (q *jobq) dequeue(job *qjob) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, j := range q.jobs {
+		if j == job {
+			q.jobs = append(q.jobs[:i], q.jobs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// insert (or put back) in priority order, FIFO within the same priority
+func (q *jobq) insert(job *qjob) {
+	q.mu.Lock()
+	i := sort.Search(len(q.jobs), func(i int) bool {
+		j := q.jobs[i]
+		if j.Priority != job.Priority {
+			return j.Priority < job.Priority
+		}
+		return j.Queued > job.Queued
+	})
+	q.jobs = append(q.jobs, nil)
+	copy(q.jobs[i+1:], q.jobs[i:])
+	q.jobs[i] = job
+	q.mu.Unlock()
+}
+
+func (job *qjob) String() string {
+	s := fmt.Sprintf("job[%s-%s %s", job.Kind, job.ID, job.Bck.Cname(""))
+	if !job.DstBck.IsEmpty() {
+		s += " => " + job.DstBck.Cname("")
+	}
+	return s + "]"
+}
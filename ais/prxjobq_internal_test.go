@@ -0,0 +1,97 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job queue", func() {
+	var (
+		q    *jobq
+		runs []string
+		errs map[string]error
+	)
+
+	newJob := func(id, kind string, queued int64) *qjob {
+		job := &qjob{run: func(xid string) (string, error) { runs = append(runs, xid); return xid, errs[xid] }}
+		job.ID, job.Kind, job.Queued = id, kind, queued
+		job.Bck = cmn.Bck{Name: "src", Provider: apc.AIS}
+		return job
+	}
+	ids := func() (out []string) {
+		for _, job := range q.list() {
+			out = append(out, job.ID)
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		si := &meta.Snode{DaeID: "primary", DaeType: apc.Proxy}
+		si.SetName()
+		q = &jobq{p: &proxy{htrun: htrun{si: si}}}
+		q.jobs = []*qjob{
+			newJob("a", apc.ActCopyBck, 1),
+			newJob("b", apc.ActECEncode, 2),
+			newJob("c", apc.ActMakeNCopies, 3),
+		}
+		runs = runs[:0]
+		errs = map[string]error{}
+	})
+
+	It("should reorder by priority, FIFO otherwise", func() {
+		Expect(q.prioritize("c", 10)).NotTo(HaveOccurred())
+		Expect(ids()).To(Equal([]string{"c", "a", "b"}))
+		Expect(q.prioritize("b", 10)).NotTo(HaveOccurred())
+		Expect(ids()).To(Equal([]string{"b", "c", "a"})) // same priority: FIFO
+		Expect(q.prioritize("c", 0)).NotTo(HaveOccurred())
+		Expect(ids()).To(Equal([]string{"b", "a", "c"}))
+		Expect(q.prioritize("x", 1)).To(HaveOccurred())
+	})
+
+	It("should insert in priority order, FIFO otherwise", func() {
+		Expect(q.prioritize("a", -1)).NotTo(HaveOccurred())
+		d := newJob("d", apc.ActCopyBck, 4)
+		d.Priority = -1
+		q.insert(d)
+		e := newJob("e", apc.ActECEncode, 5)
+		e.Priority = 2
+		q.insert(e)
+		q.insert(newJob("f", apc.ActMakeNCopies, 6))
+		Expect(ids()).To(Equal([]string{"e", "b", "c", "f", "a", "d"}))
+
+		Expect(q.status("d")).NotTo(BeNil())
+		Expect(q.status("d").UUID).To(Equal("d"))
+		Expect(q.status("x")).To(BeNil())
+	})
+
+	It("should cancel by ID and by kind", func() {
+		Expect(q.cancel(&xact.ArgsMsg{ID: "b"})).To(Equal(1))
+		Expect(ids()).To(Equal([]string{"a", "c"}))
+		Expect(q.cancel(&xact.ArgsMsg{Kind: apc.ActCopyBck, Bck: cmn.Bck{Name: "other", Provider: apc.AIS}})).To(Equal(0))
+		Expect(q.cancel(&xact.ArgsMsg{Kind: apc.ActCopyBck})).To(Equal(1))
+		Expect(ids()).To(Equal([]string{"c"}))
+	})
+
+	It("should start jobs in order and keep conflicting ones", func() {
+		Expect(q.prioritize("c", 5)).NotTo(HaveOccurred())
+		errs["c"] = cmn.NewErrLimitedCoexistence("t1", "rebalance[g1]", apc.ActMakeNCopies, "")
+		errs["b"] = errors.New("bucket not found")
+		q.busy.Store(true)
+		q.dispatch()
+
+		Expect(runs).To(Equal([]string{"c", "a", "b"})) // (started under their respective queued IDs)
+		Expect(ids()).To(Equal([]string{"c"}))
+		Expect(q.list()[0].Attempts).To(Equal(1))
+		Expect(q.busy.Load()).To(BeFalse())
+	})
+})
@@ -26,10 +26,13 @@ import (
 
 type nopHB struct{}
 
-func (*nopHB) HeardFrom(string, int64) {}
-func (*nopHB) TimedOut(string) bool    { return false }
-func (*nopHB) reg(string)              {}
-func (*nopHB) set(time.Duration) bool  { return false }
+func (*nopHB) HeardFrom(string, int64)            {}
+func (*nopHB) TimedOut(string) bool               { return false }
+func (*nopHB) Suspected(string, int) bool         { return false }
+func (*nopHB) Suspicion() map[string]float64      { return nil }
+func (*nopHB) name() string                       { return "" }
+func (*nopHB) reg(string)                         {}
+func (*nopHB) set(*cmn.KeepaliveTrackerConf) bool { return false }
 
 var _ hbTracker = (*nopHB)(nil)
 
@@ -72,7 +75,7 @@ var _ = Describe("Notifications xaction test", func() {
 			g.client.control = &http.Client{}
 
 			palive := newPalive(p, tracker, atomic.NewBool(true))
-			palive.keepalive.hb = &nopHB{}
+			palive.keepalive.setHB(&nopHB{})
 			p.keepalive = palive
 			return p
 		}
@@ -59,6 +59,13 @@ func (p *proxy) forwardCP(w http.ResponseWriter, r *http.Request, msg *apc.ActMs
 		return true
 	}
 	if smap.isPrimary(p.si) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return
+		}
+		if err := p.checkLease(smap); err != nil {
+			p.writeErr(w, r, err, http.StatusServiceUnavailable)
+			return true
+		}
 		return
 	}
 	// We must **not** send any request body when doing HEAD request.
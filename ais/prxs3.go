@@ -44,6 +44,12 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if cmn.IsS3Presigned(r.URL.Query()) {
+		if errCode, err := p.validatePresignedS3(r, apiItems); err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -221,7 +227,7 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 		return
 	}
 	started := time.Now()
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraData, netPub)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
@@ -455,7 +461,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		nlog.Infof("COPY: %s %s => %s/%v %s", r.Method, bckSrc.Cname(objName), bckDst.Cname(""), items, si)
 	}
 	started := time.Now()
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraControl)
 	p.s3Redirect(w, r, si, redirectURL, bckDst.Name)
 }
 
@@ -495,7 +501,7 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
 	started := time.Now()
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraData, netPub)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
@@ -538,7 +544,7 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
 	started := time.Now()
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraData, netPub)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
@@ -552,7 +558,7 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 			return
 		}
 		started := time.Now()
-		redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+		redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraControl)
 		p.s3Redirect(w, r, si, redirectURL, bck.Name)
 		return
 	}
@@ -656,7 +662,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
 	started := time.Now()
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, started, nil /*tk*/, cmn.NetIntraControl)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
@@ -0,0 +1,141 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Lifecycle-driven tiering (cmn.TieringConf): the primary periodically starts
+// apc.ActTier x-s - one per bucket with tiering enabled, every `tiering.interval`.
+// The schedule is in-memory: a new primary starts all eligible buckets right away.
+
+const tierIval = time.Minute
+
+type tierSched struct {
+	p    *proxy
+	last map[string]int64 // bucket uname => mono-time of the last (scheduled) run
+	mu   sync.Mutex
+	busy atomic.Bool
+}
+
+func (ts *tierSched) init(p *proxy) {
+	ts.p = p
+	ts.last = make(map[string]int64, 4)
+	hk.Reg("tiering"+hk.NameSuffix, ts.housekeep, tierIval)
+}
+
+func (ts *tierSched) housekeep() time.Duration {
+	p := ts.p
+	if !p.ClusterStarted() || !p.owner.smap.get().isPrimary(p.si) {
+		return tierIval
+	}
+	// starting x-s entails a round-trip with each target - don't block housekeeper
+	if ts.busy.CAS(false, true) {
+		go ts.dispatch()
+	}
+	return tierIval
+}
+
+func (ts *tierSched) dispatch() {
+	var (
+		p        = ts.p
+		bmd      = p.owner.bmd.get()
+		now      = time.Now().UnixNano()
+		provider = apc.AIS
+		seen     = make(map[string]struct{}, len(ts.last))
+	)
+	ts.mu.Lock()
+	bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
+		conf := &bck.Props.Tiering
+		if !conf.Enabled {
+			return false
+		}
+		uname := bck.MakeUname("")
+		seen[uname] = struct{}{}
+		if last, ok := ts.last[uname]; ok && now-last < conf.IntervalD().Nanoseconds() {
+			return false
+		}
+		ts.last[uname] = now
+		xid, err := p.startTier(bck)
+		if err != nil {
+			nlog.Errorln(p.String()+": failed to start", apc.ActTier, bck.Cname(""), "err:", err)
+		} else {
+			nlog.Infoln(p.String()+": started", apc.ActTier, bck.Cname(""), "xid:", xid)
+		}
+		return false
+	})
+	for uname := range ts.last {
+		if _, ok := seen[uname]; !ok {
+			delete(ts.last, uname) // deleted or no longer tiering
+		}
+	}
+	ts.mu.Unlock()
+	ts.busy.Store(false)
+}
+
+// tiering applies to ais buckets that have no backend and are not erasure coded
+// (stubs are local-only), and requires a separate destination
+func _tierDst(bck *meta.Bck, nprops *cmn.Bprops) (*meta.Bck, error) {
+	if !bck.IsAIS() || !nprops.BackendBck.IsEmpty() {
+		return nil, fmt.Errorf("%s: tiering requires ais bucket without remote backend", bck.Cname(""))
+	}
+	if nprops.EC.Enabled {
+		return nil, fmt.Errorf("%s: tiering and erasure coding are mutually exclusive", bck.Cname(""))
+	}
+	b, err := nprops.Tiering.DstBck()
+	if err != nil {
+		return nil, err
+	}
+	dst := meta.CloneBck(&b)
+	if dst.Equal(bck, false /*same ID*/, false /*same backend*/) {
+		return nil, fmt.Errorf("%s: tiering destination cannot be the bucket itself", bck.Cname(""))
+	}
+	return dst, nil
+}
+
+// start tiering x-s on all targets (compare with p.xstart)
+func (p *proxy) startTier(bck *meta.Bck) (string, error) {
+	var (
+		err   error
+		xargs = xact.ArgsMsg{ID: cos.GenUUID(), Kind: apc.ActTier, Bck: bck.Clone()}
+		args  = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathXactions.S,
+		Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStart, Value: xargs}),
+	}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+	}
+	freeBcastRes(results)
+	if err != nil {
+		return "", err
+	}
+	smap := p.owner.smap.get()
+	nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, nil)
+	p.ic.registerEqual(regIC{smap: smap, nl: nl})
+	return xargs.ID, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -204,9 +205,9 @@ func (p *proxy) createBucket(msg *apc.ActMsg, bck *meta.Bck, remoteHdr http.Head
 		cloudProps, present := bmd.Get(backend)
 		debug.Assert(present)
 		bprops.Versioning.Enabled = cloudProps.Versioning.Enabled // always takes precedence
-	case bck.IsRemote(): // can't create cloud buckets (NIE/NSY)
+	case bck.IsRemote(): // cloud bucket that does not exist yet will be created by the targets
 		if bck.IsCloud() {
-			return cmn.NewErrNotImpl("create", bck.Provider+"(cloud) bucket")
+			break
 		}
 		if bck.IsHTTP() {
 			return cmn.NewErrNotImpl("create", "bucket for HTTP provider")
@@ -288,7 +289,7 @@ func bmodRm(ctx *bmdModifier, clone *bucketMD) error {
 }
 
 // make-n-copies: { confirm existence -- begin -- update locally -- metasync -- commit }
-func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck) (xid string, err error) {
+func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck, uuid string) (xid string, err error) {
 	copies, err := _parseNCopies(msg.Value)
 	if err != nil {
 		return
@@ -304,7 +305,7 @@ func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck) (xid string, err err
 	// 2. begin
 	var (
 		waitmsync = true
-		c         = p.prepTxnClient(msg, bck, waitmsync)
+		c         = p.prepTxnClient(msg, bck, waitmsync, uuid)
 	)
 	if err = c.begin(bck); err != nil {
 		return
@@ -432,6 +433,9 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		action := apc.ActMakeNCopies
 		if ctx.needReEC {
 			action = apc.ActECEncode
+			if _reencode(bck.Props, ctx.setProps) {
+				action = apc.ActECReencode
+			}
 		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
 		nl.SetOwner(equalIC)
@@ -465,7 +469,7 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 }
 
 // rename-bucket: { confirm existence -- begin -- RebID -- metasync -- commit -- wait for rebalance and unlock }
-func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
+func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, uuid string) (xid string, err error) {
 	if err = p.canRebalance(); err != nil {
 		err = cmn.NewErrFailedTo(p, "rename", bckFrom, err)
 		return
@@ -484,7 +488,7 @@ func (p *proxy) renameBucket(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid str
 	// 2. begin
 	var (
 		waitmsync = true
-		c         = p.prepTxnClient(msg, bckFrom, waitmsync)
+		c         = p.prepTxnClient(msg, bckFrom, waitmsync, uuid)
 	)
 	_ = bckTo.AddUnameToQuery(c.req.Query, apc.QparamBckTo)
 	if err = c.begin(bckFrom); err != nil {
@@ -571,7 +575,7 @@ func bmodMv(ctx *bmdModifier, clone *bucketMD) error {
 
 // transform (or simply copy) bucket to another bucket
 // { confirm existence -- begin -- conditional metasync -- start waiting for operation done -- commit }
-func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (xid string, err error) {
+func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool, uuid string) (xid string, err error) {
 	// 1. confirm existence
 	bmd := p.owner.bmd.get()
 	if _, existsFrom := bmd.Get(bckFrom); !existsFrom {
@@ -584,7 +588,7 @@ func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (xid
 	// 2. begin
 	var (
 		waitmsync = !dryRun && !existsTo
-		c         = p.prepTxnClient(msg, bckFrom, waitmsync)
+		c         = p.prepTxnClient(msg, bckFrom, waitmsync, uuid)
 	)
 	_ = bckTo.AddUnameToQuery(c.req.Query, apc.QparamBckTo)
 	if err = c.begin(bckFrom); err != nil {
@@ -714,7 +718,7 @@ func parseECConf(value any) (*cmn.ECConfToSet, error) {
 }
 
 // ec-encode: { confirm existence -- begin -- update locally -- metasync -- commit }
-func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
+func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg, uuid string) (xid string, err error) {
 	nlp := newBckNLP(bck)
 	ecConf, err := parseECConf(msg.Value)
 	if err != nil {
@@ -760,7 +764,7 @@ func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error)
 	// 2. begin
 	var (
 		waitmsync = true
-		c         = p.prepTxnClient(msg, bck, waitmsync)
+		c         = p.prepTxnClient(msg, bck, waitmsync, uuid)
 	)
 	if err = c.begin(bck); err != nil {
 		return
@@ -832,6 +836,26 @@ func (p *proxy) createArchMultiObj(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (x
 	return strings.Join(all, xact.SepaID), nil
 }
 
+// bucket diff: { begin (all targets get ready to exchange remote listings) -- IC -- commit (run) }
+func (p *proxy) bckDiff(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg) (xid string, err error) {
+	c := p.prepTxnClient(msg, bckFrom, false /*waitmsync*/)
+	_ = bckTo.AddUnameToQuery(c.req.Query, apc.QparamBckTo)
+	if err = c.begin(bckFrom); err != nil {
+		return
+	}
+
+	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bckFrom.Bucket(), bckTo.Bucket())
+	nl.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+
+	xid, _, err = c.commit(bckFrom, c.cmtTout(false /*waitmsync*/))
+	debug.Assertf(xid == "" || xid == c.uuid, "committed %q vs generated %q", xid, c.uuid)
+	if err != nil {
+		c.bcastAbort(bckFrom, err) // cleanup txn
+	}
+	return xid, err
+}
+
 func (p *proxy) beginRmTarget(si *meta.Snode, msg *apc.ActMsg) error {
 	debug.Assert(si.IsTarget(), si.StringEx())
 	c := p.prepTxnClient(msg, nil, false /*waitmsync*/)
@@ -839,7 +863,8 @@ func (p *proxy) beginRmTarget(si *meta.Snode, msg *apc.ActMsg) error {
 }
 
 // destroy bucket: { begin -- commit }
-func (p *proxy) destroyBucket(msg *apc.ActMsg, bck *meta.Bck) error {
+// (cloud bucket: optionally, destroy the remote bucket as well - see apc.QparamDestroyRemote)
+func (p *proxy) destroyBucket(msg *apc.ActMsg, bck *meta.Bck, destroyRemote ...bool) error {
 	nlp := newBckNLP(bck)
 	nlp.Lock()
 	defer nlp.Unlock()
@@ -853,6 +878,10 @@ func (p *proxy) destroyBucket(msg *apc.ActMsg, bck *meta.Bck) error {
 		c         = p.prepTxnClient(actMsg, bck, waitmsync)
 		config    = cmn.GCO.Get()
 	)
+	if len(destroyRemote) > 0 && destroyRemote[0] {
+		debug.Assert(msg.Action == apc.ActDestroyBck && bck.IsCloud())
+		c.req.Query.Set(apc.QparamDestroyRemote, "true")
+	}
 	// NOTE: testing only: to avoid premature aborts when loopback devices get 100% utilized
 	// (under heavy writing)
 	if config.TestingEnv() {
@@ -910,7 +939,9 @@ func (p *proxy) destroyBucketData(msg *apc.ActMsg, bck *meta.Bck) error {
 // promote synchronously if the number of files (to promote) is less or equal
 const promoteNumSync = 16
 
-func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid string, err error) {
+// remote: promote from remote sources via promote agents (see ext/prmagent) -
+// always asynchronously, with each target pulling its (HRW) share
+func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode, remote bool) (xid string, err error) {
 	var (
 		totalN           int64
 		waitmsync        bool
@@ -935,7 +966,7 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid st
 		// confirm file share when, and only if, all targets see identical content
 		// (so that they go ahead and partition the work accordingly)
 		c.req.Query.Set(apc.QparamConfirmFshare, "true")
-	} else if totalN <= promoteNumSync {
+	} else if totalN <= promoteNumSync && !remote {
 		// targets to operate autonomously and synchronously
 		c.req.Query.Set(apc.QparamActNoXact, "true")
 		noXact = true
@@ -954,6 +985,21 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid st
 	return
 }
 
+func validatePrmSources(args *apc.PromoteArgs) error {
+	if args.SrcFQN != "" || args.DaemonID != "" {
+		return errors.New("promote: remote sources cannot be combined with (cluster-local) source or target ID")
+	}
+	for _, src := range args.Sources {
+		if _, err := url.ParseRequestURI(src.Agent); err != nil {
+			return fmt.Errorf("promote: invalid agent URL %q: %v", src.Agent, err)
+		}
+		if !filepath.IsAbs(src.Path) {
+			return fmt.Errorf("promote: source must be an absolute path (got %q at %s)", src.Path, src.Agent)
+		}
+	}
+	return nil
+}
+
 // begin phase customized to (specifically) detect file share
 func prmBegin(c *txnCln, bck *meta.Bck, singleT bool) (num int64, allAgree bool, err error) {
 	var cksumVal, totalN string
@@ -993,8 +1039,14 @@ func prmBegin(c *txnCln, bck *meta.Bck, singleT bool) (num int64, allAgree bool,
 // misc
 ///
 
-func (p *proxy) prepTxnClient(msg *apc.ActMsg, bck *meta.Bck, waitmsync bool) *txnCln {
-	c := &txnCln{p: p, uuid: cos.GenUUID(), smap: p.owner.smap.get()}
+// (optionally, the caller-assigned transaction ID - see jobq)
+func (p *proxy) prepTxnClient(msg *apc.ActMsg, bck *meta.Bck, waitmsync bool, uuid ...string) *txnCln {
+	c := &txnCln{p: p, smap: p.owner.smap.get()}
+	if len(uuid) > 0 && uuid[0] != "" {
+		c.uuid = uuid[0]
+	} else {
+		c.uuid = cos.GenUUID()
+	}
 	c.init(msg, bck, cmn.GCO.Get(), waitmsync)
 	return c
 }
@@ -1082,12 +1134,15 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet,
 	} else if bck.IsHDFS() {
 		nprops.Versioning.Enabled = false
 		// TODO: Check if the `RefDirectory` does not overlap with other buckets.
+	} else if bck.IsPOSIX() && nprops.Extra.POSIX.RootDir != bprops.Extra.POSIX.RootDir {
+		err = fmt.Errorf("%s: cannot modify existing POSIX bucket's root directory (%q => %q)",
+			p.si, bprops.Extra.POSIX.RootDir, nprops.Extra.POSIX.RootDir)
+		return
 	}
 	if bprops.EC.Enabled && nprops.EC.Enabled {
-		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
-		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
-			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
+		// changing data/parity slices triggers re-encoding (apc.ActECReencode)
+		if bprops.EC.ObjSizeLimit != nprops.EC.ObjSizeLimit && !propsToUpdate.Force {
+			err = fmt.Errorf("%s: changing EC object size limit requires re-encoding the entire bucket (use force)", p.si)
 			return
 		}
 	} else if nprops.EC.Enabled {
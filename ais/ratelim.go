@@ -0,0 +1,277 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// QoS: per-bucket (cmn.RateLimitConf) and per-user (cmn.UserLimitConf) rate limits.
+//
+// Both are cluster-wide and enforced by each node for its share of the limit:
+// - request rate: by proxies, via non-blocking token buckets (429 Too Many Requests, Retry-After);
+// - bandwidth:    by targets, via (blocking) token buckets that throttle GET and PUT payloads.
+// Intra-cluster requests are never limited, and neither are control-plane (bucket and cluster) operations.
+// The user ID that proxies pass on to targets is signed (see uidSig) and is otherwise ignored.
+
+type (
+	// token bucket that refills at the `rate` (tokens per second) and holds up to one second's worth
+	tokenBucket struct {
+		tokens float64
+		last   int64 // mono-time of the last refill
+		mu     sync.Mutex
+	}
+	rateLimiters struct {
+		m sync.Map // key => *tokenBucket
+	}
+
+	// this target's share of the bucket's or user's bandwidth
+	bwLimit struct {
+		tb   *tokenBucket
+		rate float64 // bytes per second
+	}
+	// throttled GET
+	bwWriter struct {
+		http.ResponseWriter
+		lims []bwLimit
+	}
+	// throttled PUT
+	bwReader struct {
+		io.ReadCloser
+		lims []bwLimit
+	}
+)
+
+const (
+	rlPrefixBck  = "b:"
+	rlPrefixUser = "u:"
+)
+
+const (
+	rlIdleTime = 10 * time.Minute // prune token buckets unused for that long
+	rlHkIval   = 5 * time.Minute
+
+	// object-level (data-path) operations - the only ones subject to rate limiting
+	aceDataPath = apc.AceGET | apc.AceObjHEAD | apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE |
+		apc.AceObjMOVE | apc.AceObjLIST
+)
+
+// proxy: request rate; target: bandwidth
+var rlims rateLimiters
+
+/////////////////
+// tokenBucket //
+/////////////////
+
+func (tb *tokenBucket) _refill(rate float64, now int64) {
+	burst := max(rate, 1)
+	if tb.last == 0 {
+		tb.tokens = burst
+	} else {
+		tb.tokens = min(tb.tokens+rate*float64(now-tb.last)/float64(time.Second), burst)
+	}
+	tb.last = now
+}
+
+// non-blocking: takes one token if available, otherwise returns time to wait
+func (tb *tokenBucket) allow(rate float64, now int64) (time.Duration, bool) {
+	tb.mu.Lock()
+	tb._refill(rate, now)
+	if tb.tokens >= 1 {
+		tb.tokens--
+		tb.mu.Unlock()
+		return 0, true
+	}
+	wait := time.Duration((1 - tb.tokens) / rate * float64(time.Second))
+	tb.mu.Unlock()
+	return wait, false
+}
+
+// always succeeds, possibly running into debt; returns time to wait to pay it off
+func (tb *tokenBucket) reserve(n, rate float64, now int64) (wait time.Duration) {
+	tb.mu.Lock()
+	tb._refill(rate, now)
+	tb.tokens -= n
+	if tb.tokens < 0 {
+		wait = time.Duration(-tb.tokens / rate * float64(time.Second))
+	}
+	tb.mu.Unlock()
+	return wait
+}
+
+//////////////////
+// rateLimiters //
+//////////////////
+
+func (rl *rateLimiters) init() {
+	hk.Reg("rate-limiters"+hk.NameSuffix, rl.housekeep, rlHkIval)
+}
+
+func (rl *rateLimiters) get(key string) *tokenBucket {
+	if v, ok := rl.m.Load(key); ok {
+		return v.(*tokenBucket)
+	}
+	v, _ := rl.m.LoadOrStore(key, &tokenBucket{})
+	return v.(*tokenBucket)
+}
+
+// remove idle token buckets (a bucket in use by a concurrent caller may get removed as well -
+// the only consequence of which is a refill)
+func (rl *rateLimiters) housekeep() time.Duration {
+	now := mono.NanoTime()
+	rl.m.Range(func(key, value any) bool {
+		tb := value.(*tokenBucket)
+		tb.mu.Lock()
+		idle := time.Duration(now - tb.last)
+		tb.mu.Unlock()
+		if idle > rlIdleTime {
+			rl.m.Delete(key)
+		}
+		return true
+	})
+	return rlHkIval
+}
+
+//
+// proxy: request rate
+//
+
+// returns cmn.ErrRateLimited when either the bucket's or the user's (AuthN) share is exhausted
+// (tk is the token already validated by the access check, if any)
+func (p *proxy) rateLimit(w http.ResponseWriter, r *http.Request, bck *meta.Bck, tk *tok.Token) error {
+	var (
+		config = cmn.GCO.Get()
+		ulimit = config.Auth.UserLimit.MaxOpsPerSec
+		blimit int
+	)
+	if bck.Props != nil {
+		blimit = bck.Props.RateLimit.MaxOpsPerSec
+	}
+	if blimit <= 0 && (ulimit <= 0 || !config.Auth.Enabled) {
+		return nil
+	}
+	if p.isIntraCall(r.Header, false /*from primary*/) == nil {
+		return nil
+	}
+	var (
+		now = mono.NanoTime()
+		nap = float64(max(p.owner.smap.get().CountActivePs(), 1))
+	)
+	if blimit > 0 {
+		tb := rlims.get(rlPrefixBck + bck.MakeUname(""))
+		if wait, ok := tb.allow(float64(blimit)/nap, now); !ok {
+			return _errRateLimited(w, bck.Cname(""), blimit, wait)
+		}
+	}
+	if ulimit > 0 && config.Auth.Enabled && tk != nil {
+		tb := rlims.get(rlPrefixUser + tk.UserID)
+		if wait, ok := tb.allow(float64(ulimit)/nap, now); !ok {
+			return _errRateLimited(w, "user "+tk.UserID, ulimit, wait)
+		}
+	}
+	return nil
+}
+
+func _errRateLimited(w http.ResponseWriter, what string, limit int, wait time.Duration) error {
+	secs := max(int64((wait+time.Second-1)/time.Second), 1)
+	w.Header().Set(cos.HdrRetryAfter, strconv.FormatInt(secs, 10))
+	return cmn.NewErrRateLimited(what, limit)
+}
+
+// user ID and its signature to pass on to the target (see apc.QparamUserID) to enforce per-user bandwidth;
+// tk is the token already validated by the access check (see bctx.accessAllowed)
+func (p *proxy) redirectUID(tk *tok.Token, ptime string) (uid, usig string) {
+	config := cmn.GCO.Get()
+	if !config.Auth.Enabled || config.Auth.UserLimit.MaxBandwidth <= 0 || tk == nil {
+		return "", ""
+	}
+	return tk.UserID, uidSig(tk.UserID, p.SID(), ptime, config.Auth.Secret)
+}
+
+// HMAC of the redirected user ID bound to the redirecting proxy and time,
+// keyed by the cluster-wide AuthN secret
+func uidSig(uid, pid, ptime, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(uid + "\x00" + pid + "\x00" + ptime))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+//
+// target: bandwidth
+//
+
+// returns this target's share of the bucket's and/or user's bandwidth limits (nil if none)
+func (t *target) bwLimits(bck *meta.Bck, dpq *dpq) (lims []bwLimit) {
+	var (
+		config = cmn.GCO.Get()
+		blimit int64
+		ulimit int64
+	)
+	if bck.Props != nil {
+		blimit = int64(bck.Props.RateLimit.MaxBandwidth)
+	}
+	uid := dpq.uid
+	if uid != "" && config.Auth.Enabled {
+		sig := uidSig(uid, dpq.pid, dpq.ptime, config.Auth.Secret)
+		if hmac.Equal([]byte(sig), []byte(dpq.usig)) {
+			ulimit = int64(config.Auth.UserLimit.MaxBandwidth)
+		}
+	}
+	if blimit <= 0 && ulimit <= 0 {
+		return nil
+	}
+	nat := float64(max(t.owner.smap.get().CountActiveTs(), 1))
+	if blimit > 0 {
+		lims = append(lims, bwLimit{rlims.get(rlPrefixBck + bck.MakeUname("")), float64(blimit) / nat})
+	}
+	if ulimit > 0 {
+		lims = append(lims, bwLimit{rlims.get(rlPrefixUser + uid), float64(ulimit) / nat})
+	}
+	return lims
+}
+
+func _throttle(lims []bwLimit, n int) {
+	var (
+		now  = mono.NanoTime()
+		wait time.Duration
+	)
+	for _, lim := range lims {
+		wait = max(wait, lim.tb.reserve(float64(n), lim.rate, now))
+	}
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+func (bw *bwWriter) Write(b []byte) (int, error) {
+	n, err := bw.ResponseWriter.Write(b)
+	if n > 0 {
+		_throttle(bw.lims, n)
+	}
+	return n, err
+}
+
+func (bw *bwReader) Read(b []byte) (int, error) {
+	n, err := bw.ReadCloser.Read(b)
+	if n > 0 {
+		_throttle(bw.lims, n)
+	}
+	return n, err
+}
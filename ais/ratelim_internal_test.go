@@ -0,0 +1,114 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+func TestTokenBucketAllow(t *testing.T) {
+	var (
+		tb   tokenBucket
+		rate = 10.0 // per second
+		now  = int64(time.Hour)
+	)
+	// full burst (one second's worth) right away
+	for i := 0; i < int(rate); i++ {
+		if _, ok := tb.allow(rate, now); !ok {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+	}
+	wait, ok := tb.allow(rate, now)
+	if ok {
+		t.Fatal("expected to be rate-limited")
+	}
+	if wait <= 0 || wait > time.Second/time.Duration(rate) {
+		t.Fatalf("unexpected wait %v", wait)
+	}
+	// refill
+	now += int64(wait)
+	if _, ok := tb.allow(rate, now); !ok {
+		t.Fatal("expected to be allowed after waiting")
+	}
+	// burst is capped
+	now += int64(time.Minute)
+	for i := 0; i < int(rate); i++ {
+		if _, ok := tb.allow(rate, now); !ok {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+	}
+	if _, ok := tb.allow(rate, now); ok {
+		t.Fatal("expected burst to be capped at one second's worth")
+	}
+
+	// rate below 1/s still allows one request
+	var tb2 tokenBucket
+	if _, ok := tb2.allow(0.5, now); !ok {
+		t.Fatal("expected to be allowed")
+	}
+	if wait, ok := tb2.allow(0.5, now); ok || wait != 2*time.Second {
+		t.Fatalf("expected to wait 2s, got %v (%t)", wait, ok)
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	var (
+		tb   tokenBucket
+		rate = float64(1024 * 1024) // bytes per second
+		now  = int64(time.Hour)
+	)
+	if wait := tb.reserve(rate/2, rate, now); wait != 0 {
+		t.Fatalf("expected no wait, got %v", wait)
+	}
+	// running into debt: 1.5s worth requested with 0.5s worth left
+	wait := tb.reserve(rate*1.5, rate, now)
+	if wait != time.Second {
+		t.Fatalf("expected to wait 1s, got %v", wait)
+	}
+	// paying it off
+	now += int64(wait)
+	if wait := tb.reserve(rate/4, rate, now); wait != time.Second/4 {
+		t.Fatalf("expected to wait 250ms, got %v", wait)
+	}
+}
+
+func TestRateLimitersHousekeep(t *testing.T) {
+	var rl rateLimiters
+	idle, busy := rl.get("u:idle"), rl.get("u:busy")
+	now := mono.NanoTime()
+	idle.allow(1, now-int64(2*rlIdleTime))
+	busy.allow(1, now)
+
+	rl.housekeep()
+	if v, ok := rl.m.Load("u:idle"); ok && v.(*tokenBucket) == idle {
+		t.Fatal("expected idle token bucket to be pruned")
+	}
+	if v, ok := rl.m.Load("u:busy"); !ok || v.(*tokenBucket) != busy {
+		t.Fatal("expected token bucket in use to remain")
+	}
+}
+
+func TestUserSig(t *testing.T) {
+	const secret = "secret"
+	sig := uidSig("alice", "p1", "1700000000", secret)
+	for _, tc := range []struct {
+		uid, pid, ptime, secret string
+	}{
+		{"bob", "p1", "1700000000", secret},
+		{"alice", "p2", "1700000000", secret},
+		{"alice", "p1", "1700000001", secret},
+		{"alice", "p1", "1700000000", "guess"},
+	} {
+		if uidSig(tc.uid, tc.pid, tc.ptime, tc.secret) == sig {
+			t.Errorf("expected different signature for %+v", tc)
+		}
+	}
+	if uidSig("alice", "p1", "1700000000", secret) != sig {
+		t.Error("expected the same signature")
+	}
+}
@@ -71,6 +71,7 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		coldgets     coldGets
 	}
 )
 
@@ -105,6 +106,7 @@ func (t *target) initBackends() {
 	aisBackend := backend.NewAIS(t)
 	t.backend[apc.AIS] = aisBackend                  // always present
 	t.backend[apc.HTTP] = backend.NewHTTP(t, config) // ditto
+	t.backend[apc.POSIX] = backend.NewPOSIX(t)       // ditto
 
 	if aisConf := config.Backend.Get(apc.AIS); aisConf != nil {
 		if err := aisBackend.Apply(aisConf, "init", &config.ClusterConfig); err != nil {
@@ -141,7 +143,7 @@ func (t *target) _initBuiltin() error {
 			add, err = backend.NewAzure(t)
 		case apc.HDFS:
 			add, err = backend.NewHDFS(t)
-		case apc.AIS, apc.HTTP:
+		case apc.AIS, apc.HTTP, apc.POSIX:
 			continue
 		default:
 			return fmt.Errorf(cmn.FmtErrUnknown, t, "backend provider", provider)
@@ -319,15 +321,23 @@ func (t *target) Run() error {
 		nlog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object type, workfile type, list-objects cache, and blob downloads
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.LsoCacheType, &fs.LsoCacheContentResolver{})
+	fs.CSM.Reg(fs.BlobDlType, &fs.BlobDlContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
 		t.regstate.prevbmd.Store(true)
 	}
 	t.owner.etl.init()
+	rlims.init()
+
+	// warm restart: reload LOM cache snapshots (NOTE: requires BMD)
+	if config.Memsys.LcacheSnapTime != 0 {
+		go core.LoadLcache()
+	}
 
 	smap, reliable := t.loadSmap()
 	if !reliable {
@@ -700,6 +710,9 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.t = t
 		goi.lom = lom
 		goi.w = w
+		if lims := t.bwLimits(bck, dpq); lims != nil {
+			goi.w = &bwWriter{ResponseWriter: w, lims: lims}
+		}
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.archive = archiveQuery{
@@ -709,11 +722,18 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.isGFN = cos.IsParseBool(dpq.isGFN)                 // query.Get(apc.QparamIsGFNRequest)
 		goi.latestVer = goi.lom.ValidateWarmGet(dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 		goi.isS3 = dpq.isS3 != ""
+		goi.req = r
+		goi.coldRedir = dpq.coldRedir != ""
 	}
 	if bck.IsHTTP() {
 		originalURL := dpq.origURL // query.Get(apc.QparamOrigURL)
 		goi.ctx = context.WithValue(goi.ctx, cos.CtxOriginalURL, originalURL)
 	}
+	if bck.Props != nil && bck.Props.RateLimit.HighPriority {
+		mi := lom.Mountpath()
+		mi.HiPriBegin()
+		defer mi.HiPriEnd()
+	}
 	if errCode, err := goi.getObject(); err != nil {
 		t.statsT.IncErr(stats.GetCount)
 		if err != errSendingResp {
@@ -771,6 +791,12 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	}
 
+	if !t2tput {
+		if lims := t.bwLimits(apireq.bck, apireq.dpq); lims != nil {
+			r.Body = &bwReader{ReadCloser: r.Body, lims: lims}
+		}
+	}
+
 	// load (maybe)
 	skipVC := cmn.Rom.Features().IsSet(feat.SkipVC) || cos.IsParseBool(apireq.dpq.skipVC)
 	if !skipVC {
@@ -1005,6 +1031,9 @@ func (t *target) objHead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 	op := cmn.ObjectProps{Name: lom.ObjName, Bck: *lom.Bucket(), Present: exists}
 	if exists {
 		op.ObjAttrs = *lom.ObjAttrs()
+		if size, ok := lom.TierSize(); ok {
+			op.ObjAttrs.Size = size // stub
+		}
 		op.Location = lom.Location()
 		op.Mirror.Copies = lom.NumCopies()
 		if lom.HasCopies() {
@@ -1264,8 +1293,14 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
 	code, err, isback = t.delobj(lom, evict)
+	tierDst, isStub := lom.TierDst() // (still in memory)
 	lom.Unlock(true)
 
+	// the stub's gone, and so must be the cold copy
+	if err == nil && isStub {
+		t.delTiered(&tierDst, lom.ObjName)
+	}
+
 	// special corner-case retry (quote):
 	// - googleapi: "Error 503: We encountered an internal error. Please try again."
 	// - aws-error[InternalError: We encountered an internal error. Please try again.]
@@ -1278,6 +1313,9 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	}
 	if err == nil {
 		t.statsT.Inc(stats.DeleteCount)
+		if !evict && lom.Bck().IsRemote() {
+			t.lsoCacheDel(lom)
+		}
 	} else {
 		t.statsT.IncErr(stats.DeleteCount) // TODO: count GET/PUT/DELETE remote errors separately..
 	}
@@ -1417,20 +1455,10 @@ func _blobdl(lom *core.LOM, args *apc.BlobMsg) (xid string, _ error) {
 		return xid, nil
 	}
 
-	// open workfile
-	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "blob-dl")
-	lmfh, err := lom.CreateFile(wfqn)
-	if err != nil {
-		return xid, err
-	}
-
-	// new
+	// new (x-blob-download opens - or resumes - its own workfile)
 	xid = cos.GenUUID()
-	rns := xs.RenewBlobDl(xid, lom, oa, wfqn, lmfh, args)
+	rns := xs.RenewBlobDl(xid, lom, oa, args)
 	if rns.Err != nil || rns.IsRunning() { // cmn.IsErrXactUsePrev(rns.Err): single blob-downloader per blob
-		if errRemove := cos.RemoveFile(wfqn); errRemove != nil {
-			nlog.Errorln("nested err", errRemove)
-		}
 		return xid, rns.Err
 	}
 
@@ -416,6 +416,65 @@ func TestOverwriteLomCache(t *testing.T) {
 	}
 }
 
+func TestBucketTiering(t *testing.T) {
+	var (
+		m = ioContext{
+			t:             t,
+			num:           100,
+			fileSize:      cos.KiB,
+			fixedSize:     true,
+			prefix:        trand.String(6) + "-",
+			getErrIsFatal: true,
+		}
+		coldBck    = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		baseParams = tools.BaseAPIParams()
+		msg        = &apc.LsoMsg{Props: apc.GetPropsSize}
+	)
+	m.init(true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, coldBck, nil, true /*cleanup*/)
+
+	m.puts()
+
+	tlog.Logf("Enable tiering %s => %s\n", m.bck, coldBck)
+	age := cos.Duration(time.Second)
+	_, err := api.SetBucketProps(baseParams, m.bck, &cmn.BpropsToSet{
+		Tiering: &cmn.TieringConfToSet{
+			Dst:     apc.String(coldBck.Cname("")),
+			Age:     &age,
+			Enabled: apc.Bool(true),
+		},
+	})
+	tassert.CheckFatal(t, err)
+	time.Sleep(2 * time.Second) // (age)
+
+	xid, err := api.StartXaction(baseParams, &xact.ArgsMsg{Kind: apc.ActTier, Bck: m.bck}, "")
+	tassert.CheckFatal(t, err)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActTier, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	lst, err := api.ListObjects(baseParams, coldBck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == m.num, "expected %d objects in %s, got %d", m.num, coldBck, len(lst.Entries))
+	lst, err = api.ListObjects(baseParams, m.bck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == m.num, "expected %d stubs in %s, got %d", m.num, m.bck, len(lst.Entries))
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, en.Size == 0, "expected %s to be a stub, got size %d", en.Name, en.Size)
+	}
+
+	tlog.Logf("GET (and promote back) %d objects\n", m.num)
+	m.gets(nil, true /*validate*/)
+	m.ensureNoGetErrors()
+
+	lst, err = api.ListObjects(baseParams, m.bck, msg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, en.Size == int64(m.fileSize), "expected %s to be promoted back, got size %d", en.Name, en.Size)
+	}
+}
+
 func overwriteLomCache(mdwrite apc.WritePolicy, t *testing.T) {
 	var (
 		m = ioContext{
@@ -2231,6 +2231,61 @@ func TestECBucketEncode(t *testing.T) {
 	//
 }
 
+// Changes the number of parity slices of an erasure coded bucket and checks
+// that all objects get re-encoded (and remain readable)
+func TestECReencode(t *testing.T) {
+	var (
+		bck = cmn.Bck{
+			Name:     testBucketName + "-ec-reencode",
+			Provider: apc.AIS,
+		}
+		proxyURL   = tools.RandomProxyURL()
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	o := ecOptions{
+		minTargets: 4,
+		objCount:   20,
+		dataCnt:    1,
+		parityCnt:  1,
+		pattern:    "obj-reenc-%04d",
+		silent:     testing.Short(),
+	}.init(t, proxyURL)
+	initMountpaths(t, proxyURL)
+
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+	objSize := int64(ecMinBigSize)
+	for i := 0; i < o.objCount; i++ {
+		objPath := ecTestDir + fmt.Sprintf(o.pattern, i)
+		putRandomFile(t, baseParams, bck, objPath, int(objSize))
+	}
+	reqArgs := xact.ArgsMsg{Kind: apc.ActECPut, Bck: bck}
+	api.WaitForXactionIdle(baseParams, &reqArgs)
+
+	o.parityCnt = 2
+	setBucketECProps(t, baseParams, bck, defaultECBckProps(o))
+
+	tlog.Logf("Wait for %s %s\n", apc.ActECReencode, bck)
+	xargs := xact.ArgsMsg{Kind: apc.ActECReencode, Bck: bck, Timeout: tools.RebalanceTimeout}
+	_, err := api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+	api.WaitForXactionIdle(baseParams, &reqArgs)
+
+	for i := 0; i < o.objCount; i++ {
+		objPath := ecTestDir + fmt.Sprintf(o.pattern, i)
+		foundParts, _ := ecGetAllSlices(t, bck, objPath)
+		var slices int
+		for fqn := range foundParts {
+			ct, err := core.NewCTFromFQN(fqn, nil)
+			tassert.CheckFatal(t, err)
+			if ct.ContentType() == fs.ECSliceType {
+				slices++
+			}
+		}
+		tassert.Errorf(t, slices == o.sliceTotal(), "%s: expected %d slices, found %d", objPath, o.sliceTotal(), slices)
+	}
+	objectsExist(t, baseParams, bck, o.pattern, o.objCount)
+}
+
 // Creates two buckets (with EC enabled and disabled), fill them with data,
 // and then runs two parallel rebalances
 func TestECAndRegularRebalance(t *testing.T) {
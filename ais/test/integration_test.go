@@ -5,11 +5,14 @@
 package integration_test
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -18,8 +21,10 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/cryptorand"
 	"github.com/NVIDIA/aistore/tools/docker"
 	"github.com/NVIDIA/aistore/tools/readers"
 	"github.com/NVIDIA/aistore/tools/tassert"
@@ -1320,6 +1325,49 @@ func TestAtimeColdGet(t *testing.T) {
 	}
 }
 
+// concurrent cold GETs of the same object get coalesced (see ais/tgtcoalesce.go)
+func TestColdGetCoalescing(t *testing.T) {
+	const numGets = 32
+	var (
+		bck        = cliBck
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		objName    = t.Name()
+		content    = make([]byte, 16*cos.MiB+17)
+		wg         sync.WaitGroup
+		errCh      = make(chan error, numGets)
+	)
+	tools.CheckSkip(t, &tools.SkipTestArgs{RemoteBck: true, Bck: bck})
+	_, _ = cryptorand.Read(content)
+	api.DeleteObject(baseParams, bck, objName)
+	defer api.DeleteObject(baseParams, bck, objName)
+
+	tools.PutObjectInRemoteBucketWithoutCachingLocally(t, bck, objName, readers.NewBytes(content))
+
+	for i := 0; i < numGets; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var (
+				buf  bytes.Buffer
+				args = api.GetArgs{Writer: &buf}
+			)
+			if _, err := api.GetObject(tools.BaseAPIParams(tools.RandomProxyURL(t)), bck, objName, &args); err != nil {
+				errCh <- err
+				return
+			}
+			if !bytes.Equal(buf.Bytes(), content) {
+				errCh <- fmt.Errorf("content mismatch: got %d bytes, expected %d", buf.Len(), len(content))
+			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		tassert.CheckError(t, err)
+	}
+}
+
 func TestAtimePrefetch(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -1644,6 +1692,77 @@ func TestGetFromMirroredWithLostOneMountpath(t *testing.T) {
 	m.ensureNumMountpaths(target, mpList)
 }
 
+// label one mountpath per target and restrict the bucket's placement to it
+func TestMountpathStorageClass(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
+	const label = "nvme"
+	var (
+		m = ioContext{
+			t:               t,
+			num:             500,
+			numGetsEachFile: 1,
+		}
+		baseParams = tools.BaseAPIParams()
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, m.proxyURL, m.bck, nil, true /*cleanup*/)
+	m.puts()
+
+	for _, target := range m.smap.Tmap {
+		mpList, err := api.GetMountpaths(baseParams, target)
+		tassert.CheckFatal(t, err)
+		ensureNoDisabledMountpaths(t, target, mpList)
+		if len(mpList.Available) < 2 {
+			t.Skipf("%s requires at least 2 mountpaths per target", t.Name())
+		}
+		mpath := mpList.Available[0]
+		tlog.Logf("Re-attach mountpath %s on target %s with label %q\n", mpath, target.ID(), label)
+		err = api.DetachMountpath(baseParams, target, mpath, false /*dont-resil*/)
+		tassert.CheckFatal(t, err)
+		tools.WaitForResilvering(t, baseParams, target)
+		err = api.AttachLabeledMountpath(baseParams, target, mpath, label, false /*force*/)
+		tassert.CheckFatal(t, err)
+
+		si := target
+		t.Cleanup(func() {
+			tlog.Logf("Re-attach mountpath %s on target %s without label\n", mpath, si.ID())
+			err := api.DetachMountpath(baseParams, si, mpath, false /*dont-resil*/)
+			tassert.CheckError(t, err)
+			tools.WaitForResilvering(t, baseParams, si)
+			err = api.AttachMountpath(baseParams, si, mpath, false /*force*/)
+			tassert.CheckError(t, err)
+			tools.WaitForResilvering(t, baseParams, si)
+		})
+
+		mpList, err = api.GetMountpaths(baseParams, target)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mpList.Labels[mpath] == label, "%s: expecting %s labeled %q, got %v",
+			target.StringEx(), mpath, label, mpList.Labels)
+	}
+	tools.WaitForResilvering(t, baseParams, nil)
+
+	// non-existing label
+	_, err := api.SetBucketProps(baseParams, m.bck, &cmn.BpropsToSet{StorageClass: apc.String("hdd")})
+	tassert.Fatalf(t, err != nil, "expecting error: no mountpaths labeled %q", "hdd")
+
+	tlog.Logf("Set %s storage_class %q\n", m.bck, label)
+	_, err = api.SetBucketProps(baseParams, m.bck, &cmn.BpropsToSet{StorageClass: apc.String(label)})
+	tassert.CheckFatal(t, err)
+	tools.WaitForResilvering(t, baseParams, nil)
+
+	lst, err := api.ListObjects(baseParams, m.bck, &apc.LsoMsg{Props: apc.GetPropsLocation}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == m.num, "expecting %d objects, got %d", m.num, len(lst.Entries))
+	for _, en := range lst.Entries {
+		_, mpname := core.ParseObjLoc(en.Location)
+		tassert.Fatalf(t, strings.Contains(mpname, "label="+label), "%s: expecting mountpath labeled %q, got %s",
+			en.Name, label, mpname)
+	}
+
+	m.gets(nil, false)
+	m.ensureNoGetErrors()
+}
+
 func TestGetFromMirroredWithLostMountpathAllExceptOne(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 	m := ioContext{
@@ -1077,6 +1077,50 @@ func executeTwoGETsForChecksumValidation(proxyURL string, bck cmn.Bck, objName s
 	}
 }
 
+func TestGetObjectParallel(t *testing.T) {
+	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
+		var (
+			m = ioContext{
+				t:         t,
+				bck:       bck.Clone(),
+				num:       3,
+				fileSize:  cos.MiB + 1234, // not a multiple of chunk size
+				fixedSize: true,
+			}
+			baseParams = tools.BaseAPIParams(tools.RandomProxyURL(t))
+		)
+		m.init(true /*cleanup*/)
+		m.puts()
+		if m.bck.IsRemote() {
+			defer m.del()
+		}
+		for _, objName := range m.objNames {
+			expected := bytes.NewBuffer(nil)
+			_, err := api.GetObject(baseParams, m.bck, objName, &api.GetArgs{Writer: expected})
+			tassert.CheckFatal(t, err)
+
+			// in order (io.Writer)
+			w := bytes.NewBuffer(nil)
+			args := &api.PgetArgs{Writer: w, ChunkSize: 64 * cos.KiB, NumWorkers: 5, ValidateCksum: true}
+			oah, err := api.GetObjectParallel(baseParams, m.bck, objName, args)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, oah.Size() == int64(m.fileSize), "expected size %d, got %d", m.fileSize, oah.Size())
+			tassert.Errorf(t, bytes.Equal(w.Bytes(), expected.Bytes()), "%s: content mismatch (writer)", objName)
+
+			// out of order (io.WriterAt)
+			file, err := os.CreateTemp(t.TempDir(), "pget")
+			tassert.CheckFatal(t, err)
+			args = &api.PgetArgs{WriterAt: file, ChunkSize: 100 * cos.KiB, NumWorkers: 3}
+			_, err = api.GetObjectParallel(baseParams, m.bck, objName, args)
+			file.Close()
+			tassert.CheckFatal(t, err)
+			b, err := os.ReadFile(file.Name())
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, bytes.Equal(b, expected.Bytes()), "%s: content mismatch (writer-at)", objName)
+		}
+	})
+}
+
 // TODO: validate range checksums
 func TestRangeRead(t *testing.T) {
 	initMountpaths(t, tools.RandomProxyURL(t)) // to run findObjOnDisk() and validate range
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	iofs "io/fs"
 	"math/rand"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -17,11 +18,15 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/prmagent"
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/tassert"
 	"github.com/NVIDIA/aistore/tools/tlog"
+	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/NVIDIA/aistore/xact"
 )
 
@@ -312,6 +317,59 @@ func (test *prmTests) wait(t *testing.T, xid, tempdir string, target *meta.Snode
 	return 0, 0, 0
 }
 
+// promote from a "remote" machine running promote agent (here, in-process and local)
+func TestPromoteAgent(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{Local: true})
+	const num = 100
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		test       = prmTests{num: num}
+		from       = 10000
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	tempdir := t.TempDir()
+	tassert.CheckFatal(t, cos.CreateDir(filepath.Join(tempdir, subdir)))
+	test.generate(t, from, from+num-1, tempdir, subdir)
+
+	secret := os.Getenv(env.AIS.PrmSecret) // (targets must have the same)
+	if secret == "" {
+		t.Skipf("%s is not set", env.AIS.PrmSecret)
+	}
+	agent, err := prmagent.New([]string{tempdir}, secret)
+	tassert.CheckFatal(t, err)
+	srv := httptest.NewServer(agent)
+	defer srv.Close()
+
+	for _, deleteSrc := range []bool{false, true} {
+		args := apc.PromoteArgs{
+			ObjName:      "agent/",
+			Recursive:    true,
+			OverwriteDst: deleteSrc,
+			DeleteSrc:    deleteSrc,
+			Sources:      []apc.PromoteSrc{{Agent: srv.URL, Path: tempdir}},
+		}
+		xid, err := api.Promote(baseParams, bck, &args)
+		tassert.CheckFatal(t, err)
+		xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActPromote, Timeout: tools.RebalanceTimeout}
+		_, err = api.WaitForXactionIC(baseParams, &xargs)
+		tassert.CheckFatal(t, err)
+
+		list, err := api.ListObjects(baseParams, bck, &apc.LsoMsg{Prefix: "agent/"}, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(list.Entries) == 2*num, "expected %d promoted objects, got %d", 2*num, len(list.Entries))
+
+		cnt, cntsub := countFiles(t, tempdir)
+		if deleteSrc {
+			tassert.Errorf(t, cnt == 0 && cntsub == 0, "delete-src: expected all sources removed, got (%d, %d)", cnt, cntsub)
+		} else {
+			tassert.Errorf(t, cnt == num && cntsub == num, "expected sources intact, got (%d, %d)", cnt, cntsub)
+		}
+	}
+}
+
 func countFiles(t *testing.T, dir string) (n, nsubdir int) {
 	f := func(path string, de iofs.DirEntry, err error) error {
 		if err == nil && de.Type().IsRegular() {
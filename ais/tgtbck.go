@@ -175,7 +175,7 @@ func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Q
 		code   int
 	)
 	if qbck.Provider != "" {
-		if qbck.IsAIS() || qbck.IsHTTP() { // built-in providers
+		if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsPOSIX() { // built-in providers
 			bcks = bmd.Select(qbck)
 		} else {
 			bcks, code, err = t.blist(qbck, config, bmd)
@@ -191,7 +191,7 @@ func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Q
 		for provider := range apc.Providers {
 			var buckets cmn.Bcks
 			qbck.Provider = provider
-			if qbck.IsAIS() || qbck.IsHTTP() {
+			if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsPOSIX() {
 				buckets = bmd.Select(qbck)
 			} else {
 				buckets, code, err = t.blist(qbck, config, bmd)
@@ -382,8 +382,8 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	switch msg.Action {
 	case apc.ActEvictRemoteBck:
 		keepMD := cos.IsParseBool(apireq.query.Get(apc.QparamKeepRemote))
-		// HDFS buckets will always keep metadata so they can re-register later
-		if apireq.bck.IsHDFS() || keepMD {
+		// HDFS (and POSIX) buckets will always keep metadata so they can re-register later
+		if apireq.bck.IsHDFS() || apireq.bck.IsPOSIX() || keepMD {
 			nlp := newBckNLP(apireq.bck)
 			nlp.Lock()
 			defer nlp.Unlock()
@@ -436,7 +436,7 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActPrefetchObjects {
+	if msg.Action != apc.ActPrefetchObjects && msg.Action != apc.ActInvalListCache {
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -450,6 +450,10 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 
+	if msg.Action == apc.ActInvalListCache {
+		t.lsoCacheAct(w, r, msg, apireq.bck)
+		return
+	}
 	prfMsg := &apc.PrefetchMsg{}
 	if err := cos.MorphMarshal(msg.Value, prfMsg); err != nil {
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
@@ -0,0 +1,288 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Cold GET coalescing: one remote read per object per target.
+//
+// Within a target, the first GET that finds the (remote) object missing becomes the
+// leader: it reads the object from the remote backend and stores it locally, as usual.
+// Concurrent GETs of the same object do not wait for the leader to finish - instead,
+// they join the in-progress download and stream the object from the (partially written)
+// local file as the data arrives. The very last byte is withheld until the leader
+// is done, so that a failed download results in a (detectably) short response.
+//
+// Across targets, a target that is not the object's HRW owner (e.g., when the redirecting
+// proxy has a different cluster map) redirects cold GET to the one that is - once.
+// Otherwise, there's no cross-target coordination: if cluster maps disagree for longer
+// than that, the same object may be read from remote by more than one target.
+
+type (
+	coldGet struct {
+		fqn     string       // file being written
+		attrs   cmn.ObjAttrs // remote object's attributes (as of GetObjReader)
+		size    int64
+		written int64
+		err     error
+		errCode int
+		mu      sync.Mutex
+		cond    sync.Cond
+		started bool // streaming
+		done    bool
+	}
+	coldGets struct {
+		m sync.Map // uname => *coldGet
+	}
+
+	// wraps remote reader to publish write progress: when the reader is called
+	// the data returned by the previous call has been already written
+	coldTee struct {
+		r       io.ReadCloser
+		cg      *coldGet
+		pending int64
+	}
+)
+
+//////////////
+// coldGets //
+//////////////
+
+// returns in-progress cold GET or registers a new one (in which case the caller is the leader)
+func (cgs *coldGets) join(uname string) (cg *coldGet, leader bool) {
+	if v, ok := cgs.m.Load(uname); ok {
+		return v.(*coldGet), false
+	}
+	cg = &coldGet{}
+	cg.cond.L = &cg.mu
+	v, loaded := cgs.m.LoadOrStore(uname, cg)
+	return v.(*coldGet), !loaded
+}
+
+func (cgs *coldGets) fini(uname string, cg *coldGet, errCode int, err error) {
+	cg.finish(errCode, err)
+	cgs.m.CompareAndDelete(uname, cg)
+}
+
+/////////////
+// coldGet //
+/////////////
+
+func (cg *coldGet) tee(r io.ReadCloser, fqn string, size int64, attrs *cmn.ObjAttrs) io.ReadCloser {
+	if size < 0 {
+		return r // (waiters will have to wait)
+	}
+	cg.fqn, cg.size = fqn, size
+	cg.attrs.CopyFrom(attrs, attrs.Cksum == nil)
+	return &coldTee{r: r, cg: cg}
+}
+
+func (cg *coldGet) publish(written int64) {
+	cg.mu.Lock()
+	cg.started = true
+	cg.written = written
+	cg.mu.Unlock()
+	cg.cond.Broadcast()
+}
+
+func (cg *coldGet) finish(errCode int, err error) {
+	cg.mu.Lock()
+	if cg.done {
+		cg.mu.Unlock()
+		return
+	}
+	cg.done = true
+	cg.err, cg.errCode = err, errCode
+	if err == nil && cg.started {
+		cg.written = cg.size
+	}
+	cg.mu.Unlock()
+	cg.cond.Broadcast()
+}
+
+// wait for the leader to start streaming (or finish)
+func (cg *coldGet) wait() (started bool, errCode int, err error) {
+	cg.mu.Lock()
+	for !cg.started && !cg.done {
+		cg.cond.Wait()
+	}
+	started, errCode, err = cg.started, cg.errCode, cg.err
+	cg.mu.Unlock()
+	return started, errCode, err
+}
+
+// wait for more data at `off`; withhold the last byte until done
+func (cg *coldGet) waitFor(off, end int64) (avail int64, err error) {
+	cg.mu.Lock()
+	for !cg.done && min(cg.written, end-1) <= off {
+		cg.cond.Wait()
+	}
+	avail, err = min(cg.written, end), cg.err
+	if !cg.done {
+		avail = min(avail, end-1)
+	}
+	cg.mu.Unlock()
+	return avail, err
+}
+
+// wait for the leader to finish
+func (cg *coldGet) waitDone() (errCode int, err error) {
+	cg.mu.Lock()
+	for !cg.done {
+		cg.cond.Wait()
+	}
+	errCode, err = cg.errCode, cg.err
+	cg.mu.Unlock()
+	return errCode, err
+}
+
+func (cg *coldGet) stream(w io.Writer, fh *os.File, off, end int64, buf []byte) (written int64, err error) {
+	for off < end {
+		var avail int64
+		if avail, err = cg.waitFor(off, end); err != nil {
+			return written, err
+		}
+		n, erc := io.CopyBuffer(w, io.NewSectionReader(fh, off, avail-off), buf)
+		written += n
+		off += n
+		if erc != nil {
+			return written, erc
+		}
+	}
+	return written, nil
+}
+
+//////////////
+// coldTee //
+//////////////
+
+func (tee *coldTee) Read(b []byte) (n int, err error) {
+	tee.cg.publish(tee.pending)
+	n, err = tee.r.Read(b)
+	tee.pending += int64(n)
+	return n, err
+}
+
+func (tee *coldTee) Close() error { return tee.r.Close() }
+
+///////////
+// getOI //
+///////////
+
+// joining in-progress cold GET requires streaming the entire object or a range of thereof
+func (goi *getOI) canJoin() bool {
+	if goi.archive.filename != "" {
+		return false
+	}
+	ckconf := goi.lom.CksumConf()
+	return goi.ranges.Range == "" || ckconf.Type == cos.ChecksumNone || !ckconf.EnableReadRange
+}
+
+// (leader) done writing - wake up the waiters
+func (goi *getOI) finiCold(errCode int, err error) {
+	if goi.cg == nil {
+		return
+	}
+	goi.t.coldgets.fini(goi.lom.Uname(), goi.cg, errCode, err)
+	goi.cg = nil
+}
+
+// (not locked)
+func (goi *getOI) coldJoin(cg *coldGet) (int, error) {
+	started, errCode, err := cg.wait()
+	if err != nil {
+		return errCode, err
+	}
+	if !started {
+		return goi.retryGet() // e.g., object of unknown size
+	}
+	fh, err := os.Open(cg.fqn)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return http.StatusInternalServerError, err
+		}
+		// workfile already renamed (the leader may not be done yet)
+		if errCode, err := cg.waitDone(); err != nil {
+			return errCode, err
+		}
+		return goi.retryGet()
+	}
+
+	var (
+		off, end = int64(0), cg.size
+		hdr      = goi.w.Header()
+	)
+	if goi.ranges.Range != "" {
+		hrng, code, erp := goi.parseRange(hdr, cg.size)
+		if erp != nil {
+			cos.Close(fh)
+			return code, erp
+		}
+		off, end = hrng.Start, hrng.Start+hrng.Length
+	}
+	goi.lom.CopyAttrs(&cg.attrs, cg.attrs.Cksum == nil)
+	cmn.ToHeader(goi.lom.ObjAttrs(), hdr)
+	if goi.isS3 {
+		s3.SetEtag(hdr, goi.lom)
+	}
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(end-off, 10))
+	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+
+	buf, slab := goi.t.gmm.AllocSize(min(end-off, 64*cos.KiB))
+	written, err := cg.stream(goi.w, fh, off, end, buf)
+	slab.Free(buf)
+	cos.Close(fh)
+	if err != nil {
+		if written == 0 && err == cg.err {
+			return cg.errCode, err // leader failed (and nothing's sent yet)
+		}
+		nlog.Errorln(cmn.NewErrFailedTo(goi.t, "GET (coalesced)", goi.lom.Cname(), err))
+		return 0, errSendingResp
+	}
+	goi.t.statsT.Inc(stats.GetColdCoalescedCount)
+	goi.stats(written)
+	return 0, nil
+}
+
+// fall back to regular GET
+func (goi *getOI) retryGet() (int, error) {
+	goi.lom.Lock(false)
+	goi.unlocked = false
+	return goi.get()
+}
+
+// redirect cold GET to the object's HRW owner (if any other than self)
+func (goi *getOI) coldRedirect() bool {
+	if goi.req == nil || goi.isGFN || goi.coldRedir {
+		return false
+	}
+	smap := goi.t.owner.smap.get()
+	tsi, local, err := goi.lom.HrwTarget(&smap.Smap)
+	if err != nil || local {
+		return false
+	}
+	r := goi.req
+	q := r.URL.Query()
+	q.Set(apc.QparamColdRedir, goi.t.SID())
+	redirectURL := tsi.URL(cmn.NetPublic) + r.URL.Path + "?" + q.Encode()
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infof("%s: cold GET %s => %s", goi.t, goi.lom.Cname(), tsi.StringEx())
+	}
+	http.Redirect(goi.w, r, redirectURL, http.StatusTemporaryRedirect)
+	return true
+}
@@ -0,0 +1,172 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/cryptorand"
+)
+
+// remote reader that delivers the data in small chunks, slowly
+type slowReader struct {
+	r     io.Reader
+	chunk int
+	err   error // to fail at EOF
+}
+
+func (sr *slowReader) Read(b []byte) (int, error) {
+	time.Sleep(time.Millisecond)
+	n, err := sr.r.Read(b[:min(len(b), sr.chunk)])
+	if err == io.EOF && sr.err != nil {
+		err = sr.err
+	}
+	return n, err
+}
+
+func (*slowReader) Close() error { return nil }
+
+func _coldLeader(t *testing.T, cgs *coldGets, cg *coldGet, fqn string, data []byte, errRemote error) {
+	fh, err := os.Create(fqn)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	r := cg.tee(&slowReader{r: bytes.NewReader(data), chunk: 1000, err: errRemote}, fqn,
+		int64(len(data)), &cmn.ObjAttrs{Ver: "1"})
+	_, err = io.CopyBuffer(fh, r, make([]byte, 4096))
+	fh.Close()
+	cgs.fini("uname", cg, 0, err)
+}
+
+func _coldJoiner(cg *coldGet) (out []byte, streamed bool, err error) {
+	started, _, err := cg.wait()
+	if err != nil || !started {
+		return nil, false, err
+	}
+	fh, err := os.Open(cg.fqn)
+	if err != nil {
+		return nil, false, err
+	}
+	defer fh.Close()
+	cg.mu.Lock()
+	done := cg.done
+	cg.mu.Unlock()
+	var w bytes.Buffer
+	_, err = cg.stream(&w, fh, 0, cg.size, make([]byte, 512))
+	return w.Bytes(), !done, err
+}
+
+func TestColdGetCoalescing(t *testing.T) {
+	const numJoiners = 8
+	var (
+		cgs  coldGets
+		data = make([]byte, 200*cos.KiB+13)
+		fqn  = filepath.Join(t.TempDir(), "obj")
+		wg   sync.WaitGroup
+	)
+	_, _ = cryptorand.Read(data)
+
+	cg, leader := cgs.join("uname")
+	if !leader {
+		t.Fatal("expected to lead")
+	}
+	outs := make([][]byte, numJoiners)
+	streamed := make([]bool, numJoiners)
+	for i := 0; i < numJoiners; i++ {
+		cgj, leader := cgs.join("uname")
+		if leader || cgj != cg {
+			t.Fatal("expected to join in-progress cold GET")
+		}
+		wg.Add(1)
+		go func(i int) {
+			var err error
+			outs[i], streamed[i], err = _coldJoiner(cgj)
+			if err != nil {
+				t.Error(err)
+			}
+			wg.Done()
+		}(i)
+	}
+	_coldLeader(t, &cgs, cg, fqn, data, nil)
+	wg.Wait()
+
+	for i := 0; i < numJoiners; i++ {
+		if !bytes.Equal(outs[i], data) {
+			t.Fatalf("joiner %d: content mismatch (%d vs %d)", i, len(outs[i]), len(data))
+		}
+		if !streamed[i] {
+			t.Errorf("joiner %d: expected to stream from in-progress download", i)
+		}
+	}
+	if _, leader := cgs.join("uname"); !leader {
+		t.Fatal("expected completed cold GET to be unregistered")
+	}
+}
+
+func TestColdGetCoalescingFailure(t *testing.T) {
+	var (
+		cgs     coldGets
+		data    = make([]byte, 64*cos.KiB)
+		fqn     = filepath.Join(t.TempDir(), "obj")
+		errFail = errors.New("remote failure")
+		out     []byte
+		err     error
+		wg      sync.WaitGroup
+	)
+	cg, _ := cgs.join("uname")
+	cgj, _ := cgs.join("uname")
+	wg.Add(1)
+	go func() {
+		out, _, err = _coldJoiner(cgj)
+		wg.Done()
+	}()
+	_coldLeader(t, &cgs, cg, fqn, data, errFail)
+	wg.Wait()
+
+	if !errors.Is(err, errFail) {
+		t.Fatalf("expected %v, got %v", errFail, err)
+	}
+	// the last byte must be withheld
+	if len(out) >= len(data) {
+		t.Fatalf("expected short read, got %d bytes", len(out))
+	}
+}
+
+// joiner that finds the workfile already renamed must wait for the leader to finish
+func TestColdGetWaitDone(t *testing.T) {
+	var (
+		cgs     coldGets
+		errFail = errors.New("remote failure")
+	)
+	for _, errLeader := range []error{nil, errFail} {
+		cg, _ := cgs.join("uname")
+		cgj, _ := cgs.join("uname")
+		ch := make(chan error, 1)
+		go func() {
+			_, err := cgj.waitDone()
+			ch <- err
+		}()
+		select {
+		case <-ch:
+			t.Fatal("expected to wait for the leader")
+		case <-time.After(100 * time.Millisecond):
+		}
+		cgs.fini("uname", cg, http.StatusBadGateway, errLeader)
+		if err := <-ch; err != errLeader {
+			t.Fatalf("expected %v, got %v", errLeader, err)
+		}
+	}
+}
@@ -293,7 +293,9 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
 		t.writeJSON(w, r, tsysinfo, httpdaeWhat)
 	case apc.WhatMountpaths:
-		t.writeJSON(w, r, fs.MountpathsToLists(), httpdaeWhat)
+		mpl := fs.MountpathsToLists()
+		mpl.Health = t.fshc.Health()
+		t.writeJSON(w, r, mpl, httpdaeWhat)
 	case apc.WhatNodeStatsAndStatus:
 		var rebSnap *core.Snap
 		if entry := xreg.GetLatest(xreg.Flt{Kind: apc.ActRebalance}); entry != nil {
@@ -459,6 +461,8 @@ func (t *target) handleMountpathReq(w http.ResponseWriter, r *http.Request) {
 		t.disableMpath(w, r, mpath)
 	case apc.ActMountpathDetach:
 		t.detachMpath(w, r, mpath)
+	case apc.ActMountpathReadOnly:
+		t.readOnlyMpath(w, r, mpath)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -495,8 +499,12 @@ func (t *target) enableMpath(w http.ResponseWriter, r *http.Request, mpath strin
 }
 
 func (t *target) attachMpath(w http.ResponseWriter, r *http.Request, mpath string) {
-	force := cos.IsParseBool(r.URL.Query().Get(apc.QparamForce))
-	addedMi, err := t.fsprg.attachMpath(mpath, force)
+	var (
+		query = r.URL.Query()
+		force = cos.IsParseBool(query.Get(apc.QparamForce))
+		label = query.Get(apc.QparamMpathLabel)
+	)
+	addedMi, err := t.fsprg.attachMpath(mpath, label, force)
 	if err != nil {
 		t.writeErr(w, r, err)
 		return
@@ -533,6 +541,21 @@ func (t *target) disableMpath(w http.ResponseWriter, r *http.Request, mpath stri
 	}
 }
 
+func (t *target) readOnlyMpath(w http.ResponseWriter, r *http.Request, mpath string) {
+	rmi, err := t.fsprg.readOnlyMpath(mpath)
+	if err != nil {
+		if cmn.IsErrMountpathNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	if rmi == nil {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func (t *target) detachMpath(w http.ResponseWriter, r *http.Request, mpath string) {
 	dontResilver := cos.IsParseBool(r.URL.Query().Get(apc.QparamDontResilver))
 	if _, err := t.fsprg.detachMpath(mpath, dontResilver); err != nil {
@@ -675,9 +698,14 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		xreg.DoAbort(flt, errors.New("apply-bmd"))
 		// NOTE: apc.ActMakeNCopies takes care of itself
 	}
+	if f.obck.Props.Quota != nbck.Props.Quota {
+		// usage is tracked only while quota is set - recompute upon next access
+		core.QuotaForget(nbck)
+	}
 	if f.obck.Props.EC.Enabled && !nbck.Props.EC.Enabled {
-		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
-		xreg.DoAbort(flt, errors.New("apply-bmd"))
+		errV := errors.New("apply-bmd")
+		xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}, errV)
+		xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: nbck}, errV)
 	}
 	return true // break
 }
@@ -690,6 +718,7 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 		go func(bcks ...*meta.Bck) {
 			for _, b := range bcks {
 				core.UncacheBck(b)
+				core.QuotaForget(b)
 			}
 		}(rmbcks...)
 	}
@@ -889,6 +918,10 @@ func (t *target) metasyncPut(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErr405(w, r, http.MethodPut)
 		return
 	}
+	if errE := t.checkEpoch(r, err); errE != nil {
+		t.writeErr(w, r, errE, http.StatusConflict)
+		return
+	}
 	payload := make(msPayload)
 	if errP := payload.unmarshal(r.Body, "metasync put"); errP != nil {
 		cmn.WriteErr(w, r, errP)
@@ -991,6 +1024,10 @@ func (t *target) attachDetachRemAis(newConfig *globalConfig, msg *aisMsg) (err e
 
 // POST /v1/metasync
 func (t *target) metasyncPost(w http.ResponseWriter, r *http.Request) {
+	if err := t.checkEpoch(r, &errMsync{}); err != nil {
+		t.writeErr(w, r, err, http.StatusConflict)
+		return
+	}
 	payload := make(msPayload)
 	if err := payload.unmarshal(r.Body, "metasync post"); err != nil {
 		cmn.WriteErr(w, r, err)
@@ -1153,6 +1190,38 @@ func (t *target) headt2t(lom *core.LOM, tsi *meta.Snode, smap *smapX) (ok bool)
 	return
 }
 
+// same as above, with object's attributes (size, version, checksum, custom metadata)
+func (t *target) headAttrsT2T(lom *core.LOM, tsi *meta.Snode, smap *smapX) (oa *cmn.ObjAttrs, errCode int, err error) {
+	q := lom.Bck().NewQuery()
+	q.Set(apc.QparamSilent, "true")
+	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodHead,
+			Header: http.Header{
+				apc.HdrCallerID:   []string{t.SID()},
+				apc.HdrCallerName: []string{t.callerName()},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+			Query: q,
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	if res.err == nil {
+		oa = &cmn.ObjAttrs{}
+		oa.Cksum = oa.FromHeader(res.header)
+	} else {
+		errCode, err = res.status, res.err
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	return
+}
+
 // headObjBcast broadcasts to all targets to find out if anyone has the specified object.
 // NOTE: 1) apc.QparamCheckExistsAny to make an extra effort, 2) `ignoreMaintenance`
 func (t *target) headObjBcast(lom *core.LOM, smap *smapX) *meta.Snode {
@@ -29,8 +29,10 @@ func (goi *getOI) coldSeek(res *core.GetReaderResult) error {
 		t, lom = goi.t, goi.lom
 		fqn    = lom.FQN
 		revert string
+		prev   int64
 	)
 	if goi.verchanged {
+		prev = lom.SizeBytes(true)
 		revert = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileColdget)
 		if err := os.Rename(lom.FQN, revert); err != nil {
 			nlog.Errorln("failed to rename prev. version - proceeding anyway", lom.FQN, "=>", revert)
@@ -43,6 +45,9 @@ func (goi *getOI) coldSeek(res *core.GetReaderResult) error {
 		goi._cleanup(revert, nil, nil, nil, err, "(fcreate)")
 		return err
 	}
+	if goi.cg != nil {
+		res.R = goi.cg.tee(res.R, fqn, res.Size, lom.ObjAttrs())
+	}
 
 	// read remote, write local
 	var (
@@ -83,6 +88,12 @@ func (goi *getOI) coldSeek(res *core.GetReaderResult) error {
 		goi._cleanup(revert, lmfh, buf, slab, err, "(persist)")
 		return err
 	}
+	goi.finiCold(0, nil) // wake up coalesced GETs
+	if revert != "" {
+		lom.QuotaAdd(written-prev, 0)
+	} else {
+		lom.QuotaAdd(written, 1)
+	}
 	// with remaining stats via goi.stats()
 	goi.t.statsT.AddMany(
 		cos.NamedVal64{Name: stats.GetColdCount, Value: 1},
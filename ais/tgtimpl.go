@@ -122,6 +122,10 @@ func (t *target) HeadObjT2T(lom *core.LOM, si *meta.Snode) bool {
 	return t.headt2t(lom, si, t.owner.smap.get())
 }
 
+func (t *target) HeadObjAttrsT2T(lom *core.LOM, si *meta.Snode) (*cmn.ObjAttrs, int, error) {
+	return t.headAttrsT2T(lom, si, t.owner.smap.get())
+}
+
 // CopyObject:
 // - either creates a full replica of the source object (the `lom` argument)
 // - or transforms the object
@@ -353,3 +357,16 @@ func (t *target) DisableMpath(mpath, reason string) (err error) {
 	_, err = t.fsprg.disableMpath(mpath, true /*dont-resilver*/) // NOTE: not resilvering upon FSCH calling
 	return
 }
+
+// unlike DisableMpath (above), slow mountpath remains readable: marking it read-only
+// (new writes go elsewhere) while throttled resilver gradually drains it
+func (t *target) SlowMpath(mpath, reason string, readOnly bool) {
+	nlog.Errorf("%s: slow mountpath %s: %s", t, mpath, reason)
+	t.statsT.IncErr(stats.ErrSlowMpathCount)
+	if !readOnly {
+		return
+	}
+	if _, err := t.fsprg.readOnlyMpath(mpath); err != nil {
+		nlog.Errorf("%s: failed to mark slow mountpath %s read-only: %v", t, mpath, err)
+	}
+}
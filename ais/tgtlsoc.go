@@ -0,0 +1,142 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// AIS-side PUT and DELETE of remote objects => incremental updates of the
+// remote bucket's list cache (xs.LsoCacheUpdate) maintained by the bucket's
+// owner target (xs.LsoCacheOwner). Updates are batched and sent (or applied
+// locally) once every lsoqDelay.
+
+const lsoqDelay = time.Second
+
+type (
+	lsoqEntry struct {
+		bck meta.Bck
+		upd xs.LsoCacheUpd
+	}
+	lsoQueue struct {
+		pending map[uint64]*lsoqEntry // bucket ID => batched updates
+		mtx     sync.Mutex
+	}
+)
+
+var lsoq lsoQueue
+
+func (t *target) lsoCachePut(lom *core.LOM) {
+	bck := lom.Bck()
+	if bck.Props == nil || !bck.Props.ListCache.Enabled {
+		return
+	}
+	e := &cmn.LsoEntry{Name: lom.ObjName, Size: lom.SizeBytes(), Version: lom.Version()}
+	if etag, ok := lom.GetCustomKey(cmn.ETag); ok {
+		e.Checksum = etag
+	}
+	if md := lom.GetCustomMD(); len(md) > 0 {
+		e.Custom = cmn.CustomMD2S(md)
+	}
+	t.lsoqAdd(bck, e, "")
+}
+
+func (t *target) lsoCacheDel(lom *core.LOM) {
+	bck := lom.Bck()
+	if bck.Props == nil || !bck.Props.ListCache.Enabled {
+		return
+	}
+	t.lsoqAdd(bck, nil, lom.ObjName)
+}
+
+func (t *target) lsoqAdd(bck *meta.Bck, put *cmn.LsoEntry, del string) {
+	lsoq.mtx.Lock()
+	if lsoq.pending == nil {
+		lsoq.pending = make(map[uint64]*lsoqEntry, 4)
+		go t.lsoqFlush()
+	}
+	qe, ok := lsoq.pending[bck.Props.BID]
+	if !ok {
+		qe = &lsoqEntry{bck: *bck}
+		lsoq.pending[bck.Props.BID] = qe
+	}
+	qe.upd.Add(put, del)
+	lsoq.mtx.Unlock()
+}
+
+func (t *target) lsoqFlush() {
+	time.Sleep(lsoqDelay)
+
+	lsoq.mtx.Lock()
+	pending := lsoq.pending
+	lsoq.pending = nil
+	lsoq.mtx.Unlock()
+
+	smap := t.owner.smap.get()
+	for _, qe := range pending {
+		tsi, err := xs.LsoCacheOwner(&smap.Smap, &qe.bck)
+		if err != nil {
+			nlog.Warningln(t.String(), "list cache", qe.bck.Cname(""), "err:", err)
+			continue
+		}
+		if tsi.ID() == t.SID() {
+			xs.LsoCacheUpdate(&qe.bck, &qe.upd)
+			continue
+		}
+		if err := t.lsoqSend(tsi, qe, smap); err != nil {
+			nlog.Warningln(t.String(), "failed to update list cache", qe.bck.Cname(""), "at", tsi.StringEx(), "err:", err)
+		}
+	}
+}
+
+func (t *target) lsoqSend(tsi *meta.Snode, qe *lsoqEntry, smap *smapX) (err error) {
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Header: http.Header{
+				apc.HdrCallerID:   []string{t.SID()},
+				apc.HdrCallerName: []string{t.callerName()},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathBuckets.Join(qe.bck.Name),
+			Query: qe.bck.NewQuery(),
+			Body:  cos.MustMarshal(t.newAmsgActVal(apc.ActInvalListCache, &qe.upd)),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	err = res.err
+	freeCargs(cargs)
+	freeCR(res)
+	return
+}
+
+// POST /v1/buckets/bucket-name (apc.ActInvalListCache):
+// - no value: invalidate (via api.ListObjectsInvalidateCache)
+// - xs.LsoCacheUpd: incremental update (from another target - see above)
+func (t *target) lsoCacheAct(w http.ResponseWriter, r *http.Request, msg *aisMsg, bck *meta.Bck) {
+	if msg.Value == nil {
+		xs.LsoCacheInval(bck)
+		return
+	}
+	upd := &xs.LsoCacheUpd{}
+	if err := cos.MorphMarshal(msg.Value, upd); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	xs.LsoCacheUpdate(bck, upd)
+}
@@ -54,6 +54,7 @@ type (
 		cksumToUse *cos.Cksum    // if available (not `none`), can be validated and will be stored
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
+		tierDst    *cmn.Bck      // overwriting tiered-out object: its cold bucket (see delTiered)
 		workFQN    string        // temp fqn to be renamed
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
@@ -68,6 +69,7 @@ type (
 
 	getOI struct {
 		w          http.ResponseWriter
+		req        *http.Request   // (to redirect cold GET)
 		ctx        context.Context // context used when getting object from remote backend (access creds)
 		t          *target         // this
 		lom        *core.LOM       // obj
@@ -83,6 +85,8 @@ type (
 		cold       bool            // true if executed backend.Get
 		latestVer  bool            // QparamLatestVer || 'versioning.*_warm_get'
 		isS3       bool            // calling via /s3 API
+		coldRedir  bool            // cold GET redirected by another target (QparamColdRedir)
+		cg         *coldGet        // (leader) in-progress cold GET (see tgtcoalesce.go)
 	}
 
 	// textbook append: (packed) handle and control structure (see also `putA2I` arch below)
@@ -164,6 +168,11 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 }
 
 func (poi *putOI) putObject() (errCode int, err error) {
+	var (
+		buf  []byte
+		slab *memsys.Slab
+		lmfh *os.File
+	)
 	poi.ltime = mono.NanoTime()
 	// PUT is a no-op if the checksums do match
 	if !poi.skipVC && !poi.coldGET && !poi.cksumToUse.IsEmpty() {
@@ -175,11 +184,19 @@ func (poi *putOI) putObject() (errCode int, err error) {
 			return 0, nil
 		}
 	}
+	// bucket quota (not enforcing when rebalancing and cold-GETting)
+	if poi.owt <= cmn.OwtCopy {
+		if err = core.QuotaCheck(poi.lom.Bck(), poi.size); err != nil {
+			cos.DrainReader(poi.r)
+			errCode = http.StatusInsufficientStorage
+			goto rerr
+		}
+	}
 
-	buf, slab, lmfh, erw := poi.write()
-	poi._cleanup(buf, slab, lmfh, erw)
-	if erw != nil {
-		err, errCode = erw, http.StatusInternalServerError
+	buf, slab, lmfh, err = poi.write()
+	poi._cleanup(buf, slab, lmfh, err)
+	if err != nil {
+		errCode = http.StatusInternalServerError
 		goto rerr
 	}
 
@@ -242,7 +259,14 @@ func (poi *putOI) loghdr() string {
 }
 
 func (poi *putOI) finalize() (errCode int, err error) {
-	if errCode, err = poi.fini(); err != nil {
+	errCode, err = poi.fini()
+	if poi.tierDst != nil {
+		if err == nil {
+			poi.t.delTiered(poi.tierDst, poi.lom.ObjName)
+		}
+		poi.tierDst = nil
+	}
+	if err != nil {
 		if err1 := cos.Stat(poi.workFQN); err1 == nil || !os.IsNotExist(err1) {
 			if err1 == nil {
 				err1 = err
@@ -331,6 +355,11 @@ func (poi *putOI) fini() (errCode int, err error) {
 		}
 	}
 
+	// overwriting tiered-out object (other than promoting it back - see tierBack)
+	if poi.owt < cmn.OwtRebalance && bck.Props.Tiering.Dst != "" {
+		poi.tierDst = poi.stubDst()
+	}
+
 	// done
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
 		return
@@ -347,6 +376,19 @@ func (poi *putOI) fini() (errCode int, err error) {
 	return
 }
 
+// under wlock: if the object being overwritten is a stub, returns its cold bucket
+func (poi *putOI) stubDst() *cmn.Bck {
+	prev := core.AllocLOM(poi.lom.ObjName)
+	defer core.FreeLOM(prev)
+	if prev.InitBck(poi.lom.Bucket()) != nil || prev.Load(false /*cache it*/, true /*locked*/) != nil {
+		return nil
+	}
+	if dst, ok := prev.TierDst(); ok {
+		return &dst
+	}
+	return nil
+}
+
 // via backend.PutObj()
 func (poi *putOI) putRemote() (errCode int, err error) {
 	var (
@@ -363,8 +405,11 @@ func (poi *putOI) putRemote() (errCode int, err error) {
 		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
 	}
 	errCode, err = backend.PutObj(lmfh, lom)
-	if err == nil && !lom.Bck().IsRemoteAIS() {
-		lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
+	if err == nil {
+		if !lom.Bck().IsRemoteAIS() {
+			lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
+		}
+		poi.t.lsoCachePut(lom)
 	}
 	return
 }
@@ -500,6 +545,7 @@ func (goi *getOI) getObject() (errCode int, err error) {
 	debug.Assert(!goi.unlocked)
 	goi.lom.Lock(false)
 	errCode, err = goi.get()
+	goi.finiCold(errCode, err) // (if not yet)
 	if !goi.unlocked {
 		goi.lom.Unlock(false)
 	}
@@ -527,6 +573,16 @@ do:
 		}
 	}
 
+	if !cold {
+		if dst, ok := goi.lom.TierDst(); ok { // stub - promote back from the cold bucket
+			if errCode, err = goi.tierBack(&dst); err != nil {
+				goi.unlocked = true
+				return errCode, err
+			}
+			goto fin
+		}
+	}
+
 	if cold {
 		if goi.lom.Bck().IsAIS() { // ais bucket with no backend - try lookup and restore
 			goi.lom.Unlock(false)
@@ -593,10 +649,27 @@ do:
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
 
+		// one cold GET per object (per target): redirect to the object's owner or join in-progress (see tgtcoalesce.go)
+		if goi.cg == nil {
+			if goi.coldRedirect() {
+				return 0, nil
+			}
+			cg, leader := goi.t.coldgets.join(goi.lom.Uname())
+			switch {
+			case leader:
+				goi.cg = cg
+			case goi.canJoin():
+				goi.lom.Unlock(false)
+				goi.unlocked = true
+				return goi.coldJoin(cg)
+			}
+		}
+
 		if loaded, err = goi._coldLock(); err != nil {
 			return 0, err
 		}
 		if loaded {
+			goi.finiCold(0, nil)
 			goto fin
 		}
 
@@ -632,6 +705,7 @@ do:
 			goi.unlocked = true
 			return errCode, err
 		}
+		goi.finiCold(0, nil)
 		// with remaining stats via goi.stats()
 		goi.t.statsT.AddMany(
 			cos.NamedVal64{Name: stats.GetColdCount, Value: 1},
@@ -701,6 +775,9 @@ func (goi *getOI) _coldPut(res *core.GetReaderResult) (int, error) {
 		poi.size = res.Size
 		poi.workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileColdget)
 		poi.atime = goi.atime
+		if goi.cg != nil {
+			poi.r = goi.cg.tee(res.R, poi.workFQN, res.Size, lom.ObjAttrs())
+		}
 		poi.owt = cmn.OwtGet
 		poi.cksumToUse = res.ExpCksum // expected checksum (to validate if the bucket's `validate_cold_get == true`)
 		poi.coldGET = true
@@ -946,6 +1023,135 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	return false
 }
 
+// promote tiered-out object back from its cold bucket (see cmn.TieringConf)
+// and delete the cold copy (that nothing would otherwise refer to);
+// expecting rlock; returns rlocked upon success and unlocked otherwise
+func (goi *getOI) tierBack(dst *cmn.Bck) (int, error) {
+	var (
+		t, lom = goi.t, goi.lom
+		smap   = t.owner.smap.get()
+		ver    = lom.Version()
+	)
+	for lom.UpgradeLock() {
+		// upgraded (and possibly promoted back) by another goroutine
+		if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+			lom.Unlock(false)
+			return 0, err
+		}
+		if _, ok := lom.TierDst(); !ok {
+			return 0, nil
+		}
+	}
+	tsi, err := smap.HrwName2T(dst.MakeUname(lom.ObjName))
+	if err != nil {
+		lom.Unlock(true)
+		return 0, err
+	}
+
+	// intra-cluster GET from the cold bucket
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(dst.Name, lom.ObjName)
+		reqArgs.Query = dst.NewQuery()
+	}
+	config := cmn.GCO.Get()
+	req, _, cancel, err := reqArgs.ReqWithTimeout(config.Timeout.SendFile.D())
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		lom.Unlock(true)
+		return 0, err
+	}
+	defer cancel()
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed by `poi.putObject`
+	if err != nil {
+		lom.Unlock(true)
+		return 0, cmn.NewErrFailedTo(t, "tier-back", dst.Cname(lom.ObjName), err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		lom.Unlock(true)
+		err = fmt.Errorf("%s: failed to GET %s from %s (tiered-out %s)", t, dst.Cname(lom.ObjName), tsi, lom.Cname())
+		return resp.StatusCode, err
+	}
+
+	// replace the stub
+	lom.SetCustomMD(nil)
+	cksumToUse := lom.ObjAttrs().FromHeader(resp.Header)
+	poi := allocPOI()
+	{
+		poi.t = t
+		poi.lom = lom
+		poi.config = config
+		poi.r = resp.Body
+		poi.size = lom.SizeBytes()
+		poi.owt = cmn.OwtGet // (w-locked)
+		poi.workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileRemote)
+		poi.atime = goi.atime
+		poi.cksumToUse = cksumToUse
+	}
+	errCode, err := poi.putObject()
+	freePOI(poi)
+	if err == nil && ver != "" {
+		lom.SetVersion(ver) // keep the original version
+		err = lom.Persist()
+	}
+	if err == nil {
+		err = lom.Load(true /*cache it*/, true /*locked*/)
+	}
+	if err != nil {
+		lom.Unlock(true)
+		nlog.Errorln(t.String()+": tier-back", lom.Cname(), "<=", dst.Cname(""), err)
+		return errCode, err
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String()+": tier-back", lom.Cname(), "<=", dst.Cname(""))
+	}
+	lom.DowngradeLock()
+	go t.delTiered(dst, lom.ObjName) // (not holding up the GET)
+	return 0, nil
+}
+
+// delete the cold copy of a tiered-out object (stub) that's been deleted, overwritten, or promoted back
+// (best effort - the cold copy may have been removed in the meantime)
+func (t *target) delTiered(dst *cmn.Bck, objName string) {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwName2T(dst.MakeUname(objName))
+	if err != nil {
+		nlog.Errorln(t.String()+": failed to delete tiered-out", dst.Cname(objName)+":", err)
+		return
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodDelete,
+			Header: http.Header{
+				apc.HdrCallerID:   []string{t.SID()},
+				apc.HdrCallerName: []string{t.callerName()},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathObjects.Join(dst.Name, objName),
+			Query: dst.NewQuery(),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	if res.err != nil && res.status != http.StatusNotFound {
+		nlog.Errorln(t.String()+": failed to delete tiered-out", dst.Cname(objName)+":", res.err)
+	} else if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String()+": deleted tiered-out", dst.Cname(objName))
+	}
+	freeCargs(cargs)
+	freeCR(res)
+}
+
 func (goi *getOI) finalize() (errCode int, err error) {
 	var (
 		lmfh *os.File
@@ -1090,7 +1296,11 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 			return errSendingResp
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
-		goi.lom.Recache()
+		if goi.lom.Bprops().LRU.Policy == cmn.LRUPolicyLFU {
+			goi.lom.IncAccessCnt() // (and recache)
+		} else {
+			goi.lom.Recache()
+		}
 	}
 	//
 	// stats
@@ -1187,6 +1397,10 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, errCode int, err error) {
 		fh      *os.File
 		workFQN = a.hdl.workFQN
 	)
+	if err = core.QuotaCheck(a.lom.Bck(), a.size); err != nil {
+		errCode = http.StatusInsufficientStorage
+		return
+	}
 	if workFQN == "" {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
@@ -1425,6 +1639,9 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 			return 0, err
 		}
 	}
+	if err := core.QuotaCheck(dst.Bck(), lom.SizeBytes()); err != nil {
+		return 0, err
+	}
 	dst2, err := lom.Copy2FQN(dst.FQN, coi.Buf)
 	if err == nil {
 		size = lom.SizeBytes()
@@ -1603,6 +1820,7 @@ func (a *putA2I) do() (int, error) {
 		if err = os.Rename(a.lom.FQN, workFQN); err != nil {
 			return http.StatusInternalServerError, err
 		}
+		a.lom.QuotaAdd(-a.lom.SizeBytes(true), -1) // (to be accounted for upon renaming back)
 		fh, tarFormat, err = archive.OpenTarSeekEnd(a.lom.Cname(), workFQN)
 		if err != nil {
 			if errV := a.lom.RenameFrom(workFQN); errV != nil {
@@ -329,6 +329,9 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	}
 	errCode, errF := poi.finalize()
 	freePOI(poi)
+	if remote {
+		t.lsoCachePut(lom)
+	}
 
 	// .6 cleanup parts - unconditionally
 	exists := s3.CleanupUpload(uploadID, lom.FQN, false /*aborted*/)
@@ -28,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	"github.com/NVIDIA/aistore/xact/xs"
@@ -146,6 +147,8 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 		xid, err = t.ecEncode(c)
 	case apc.ActArchive:
 		xid, err = t.createArchMultiObj(c)
+	case apc.ActBckDiff:
+		xid, err = t.bckDiff(c)
 	case apc.ActStartMaintenance, apc.ActDecommissionNode, apc.ActShutdownNode:
 		err = t.beginRm(c)
 	case apc.ActDestroyBck, apc.ActEvictRemoteBck:
@@ -181,30 +184,57 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 func (t *target) createBucket(c *txnSrv) error {
 	switch c.phase {
 	case apc.ActBegin:
+		if t.createRemote(c) && c.msg.Value != nil {
+			// (bucket props - in particular, region - to create it with)
+			if err := cos.MorphMarshal(c.msg.Value, &c.bck.Props); err != nil {
+				return fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
+			}
+		}
 		txn := newTxnCreateBucket(c)
 		if err := t.transactions.begin(txn); err != nil {
 			return err
 		}
-		if c.msg.Action == apc.ActCreateBck && c.bck.IsRemote() {
-			if c.msg.Value != nil {
-				if err := cos.MorphMarshal(c.msg.Value, &c.bck.Props); err != nil {
-					return fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
-				}
-			}
-			if _, err := t.Backend(c.bck).CreateBucket(c.bck); err != nil {
-				return err
-			}
-		}
 	case apc.ActAbort:
 		t.transactions.find(c.uuid, apc.ActAbort)
 	case apc.ActCommit:
-		t._commitCreateDestroy(c)
+		if !t.createRemote(c) {
+			t._commitCreateDestroy(c)
+			return nil
+		}
+		txn, err := t.transactions.find(c.uuid, "")
+		if err != nil {
+			return err
+		}
+		bck := txn.(*txnCreateBucket).bck
+		if err := t._commitCreateDestroy(c); err != nil {
+			return err
+		}
+		// the bucket is now in BMD - create the cloud one (once, by the txn owner);
+		// on failure, the caller (proxy) undoes the BMD part
+		if _, err := t.Backend(&bck).CreateBucket(&bck); err != nil {
+			return cmn.NewErrFailedTo(t, "create remote", bck.Cname(""), err)
+		}
 	default:
 		debug.Assert(false)
 	}
 	return nil
 }
 
+// cloud bucket gets created by a single (designated) target (compare w/ destroyRemote)
+func (t *target) createRemote(c *txnSrv) bool {
+	if c.msg.Action != apc.ActCreateBck || !c.bck.IsCloud() {
+		return false
+	}
+	return t.txnOwner(c)
+}
+
+// returns true if this target is the one (and only) to execute a given
+// cluster-wide backend operation on behalf of the transaction
+func (t *target) txnOwner(c *txnSrv) bool {
+	tsi, err := t.owner.smap.get().HrwTargetTask(c.uuid)
+	return err == nil && tsi.ID() == t.SID()
+}
+
 func (t *target) _commitCreateDestroy(c *txnSrv) (err error) {
 	txn, err := t.transactions.find(c.uuid, "")
 	if err != nil {
@@ -344,6 +374,15 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 		if err = t.transactions.wait(txn, c.timeout.netw, c.timeout.host); err != nil {
 			return "", cmn.NewErrFailedTo(t, "commit", txn, err)
 		}
+		if bprops.StorageClass != nprops.StorageClass {
+			// placement changes: resilver to (re)locate objects, copies, and slices
+			if cmn.GCO.Get().Resilver.Enabled {
+				go t.runResilver(res.Args{}, nil /*wg*/)
+			} else {
+				nlog.Warningf("%s: %s storage_class %q => %q: resilvering disabled", t, c.bck,
+					bprops.StorageClass, nprops.StorageClass)
+			}
+		}
 		if _reMirror(bprops, nprops) {
 			n := int(nprops.Mirror.Copies)
 			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-setprops", n)
@@ -358,9 +397,17 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			xid = xctn.ID()
 		}
 		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec {
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
-			xreg.DoAbort(flt, errors.New("re-ec"))
-			rns := xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
+			var (
+				rns  xreg.RenewRes
+				errV = errors.New("re-ec")
+			)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}, errV)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: c.bck}, errV)
+			if _reencode(bprops, nprops) {
+				rns = xreg.RenewECReencode(c.bck, c.uuid)
+			} else {
+				rns = xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
+			}
 			if rns.Err != nil {
 				return "", rns.Err
 			}
@@ -392,8 +439,18 @@ func (t *target) validateNprops(bck *meta.Bck, msg *aisMsg) (nprops *cmn.Bprops,
 		return
 	}
 	err = cs.Err()
+	if nprops.StorageClass != "" && nprops.StorageClass != bck.Props.StorageClass {
+		if fs.NumAvailLabel(nprops.StorageClass) == 0 {
+			err = fmt.Errorf("%s: cannot set %s storage_class %q: no mountpaths labeled %q",
+				t, bck, nprops.StorageClass, nprops.StorageClass)
+			return
+		}
+	}
 	if nprops.Mirror.Enabled {
 		mpathCount := fs.NumAvail()
+		if nprops.StorageClass != "" {
+			mpathCount = fs.NumAvailLabel(nprops.StorageClass)
+		}
 		if int(nprops.Mirror.Copies) > mpathCount {
 			err = fmt.Errorf(fmtErrInsuffMpaths1, t, mpathCount, bck, nprops.Mirror.Copies)
 			return
@@ -865,6 +922,57 @@ func (t *target) createArchMultiObj(c *txnSrv) (string /*xaction uuid*/, error)
 	return xid, nil
 }
 
+//
+// bckDiff: renew x-bck-diff (that also registers to receive remote listings) in the begin phase
+// and run it upon commit (see p.bckDiff)
+//
+
+func (t *target) bckDiff(c *txnSrv) (string, error) {
+	switch c.phase {
+	case apc.ActBegin:
+		if c.bckTo == nil {
+			return "", fmt.Errorf("missing %q query parameter", apc.QparamBckTo)
+		}
+		bdmsg := &apc.BckDiffMsg{}
+		if err := cos.MorphMarshal(c.msg.Value, bdmsg); err != nil {
+			return "", fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
+		}
+		report := meta.NewBck(bdmsg.Report, apc.AIS, cmn.NsGlobal)
+		for _, bck := range []*meta.Bck{c.bck, c.bckTo, report} {
+			if err := bck.Init(t.owner.bmd); err != nil {
+				return "", err
+			}
+		}
+		args := &xreg.BckDiffArgs{BckFrom: c.bck, BckTo: c.bckTo, Report: report, Msg: bdmsg}
+		rns := xreg.RenewBckDiff(c.uuid, args)
+		if rns.Err != nil {
+			nlog.Errorf("%s: %q %+v %v", t, c.uuid, bdmsg, rns.Err)
+			return "", rns.Err
+		}
+		xbdiff := rns.Entry.Get().(*xs.XactBckDiff)
+		txn := newTxnBckDiff(c, xbdiff)
+		if err := t.transactions.begin(txn); err != nil {
+			xbdiff.TxnAbort(err)
+			return "", err
+		}
+	case apc.ActAbort:
+		t.transactions.find(c.uuid, apc.ActAbort)
+	case apc.ActCommit:
+		txn, err := t.transactions.find(c.uuid, "")
+		if err != nil {
+			return "", err
+		}
+		xbdiff := txn.(*txnBckDiff).xbdiff
+		t.transactions.find(c.uuid, apc.ActCommit)
+		c.addNotif(xbdiff) // notify upon completion
+		xact.GoRunW(xbdiff)
+		return xbdiff.ID(), nil
+	default:
+		debug.Assert(false)
+	}
+	return "", nil
+}
+
 //
 // begin (maintenance -- decommission -- shutdown) via p.beginRmTarget
 //
@@ -891,6 +999,13 @@ func (t *target) destroyBucket(c *txnSrv) error {
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck, "")
 		}
+		if t.destroyRemote(c) {
+			// (bucket props - in particular, region, endpoint, etc. - for when it's gone from BMD)
+			if err := c.bck.Init(t.owner.bmd); err != nil {
+				nlp.Unlock()
+				return err
+			}
+		}
 		txn := newTxnBckBase(c.bck)
 		txn.fillFromCtx(c)
 		if err := t.transactions.begin(txn, nlp); err != nil {
@@ -899,13 +1014,35 @@ func (t *target) destroyBucket(c *txnSrv) error {
 	case apc.ActAbort:
 		t.transactions.find(c.uuid, apc.ActAbort)
 	case apc.ActCommit:
-		t._commitCreateDestroy(c)
+		if !t.destroyRemote(c) {
+			return t._commitCreateDestroy(c)
+		}
+		txn, err := t.transactions.find(c.uuid, "")
+		if err != nil {
+			return err
+		}
+		bck := txn.(*txnBckBase).bck
+		if err := t._commitCreateDestroy(c); err != nil {
+			return err
+		}
+		// the bucket is no longer in BMD - destroy the remote one (once, by the txn owner)
+		if _, err := t.Backend(&bck).DestroyBucket(&bck); err != nil {
+			return cmn.NewErrFailedTo(t, "destroy remote", bck.Cname(""), err)
+		}
 	default:
 		debug.Assert(false)
 	}
 	return nil
 }
 
+// explicit opt-in to destroy cloud bucket (see also: proxy.destroyBucket)
+func (t *target) destroyRemote(c *txnSrv) bool {
+	if c.msg.Action != apc.ActDestroyBck || !c.bck.IsCloud() || !cos.IsParseBool(c.query.Get(apc.QparamDestroyRemote)) {
+		return false
+	}
+	return t.txnOwner(c)
+}
+
 func (t *target) promote(c *txnSrv, hdr http.Header) (string, error) {
 	switch c.phase {
 	case apc.ActBegin:
@@ -924,6 +1061,9 @@ func (t *target) promote(c *txnSrv, hdr http.Header) (string, error) {
 		if strings.Contains(prmMsg.ObjName, "../") || strings.Contains(prmMsg.ObjName, "~/") {
 			return "", fmt.Errorf("invalid object name or prefix %q", prmMsg.ObjName)
 		}
+		if len(prmMsg.Sources) > 0 {
+			return "", t.prmBeginRemote(c, prmMsg, hdr)
+		}
 		srcFQN := c.msg.Name
 		finfo, err := os.Stat(srcFQN)
 		if err != nil {
@@ -999,6 +1139,29 @@ func (t *target) promote(c *txnSrv, hdr http.Header) (string, error) {
 	return "", nil
 }
 
+// remote sources (promote agents) are, by definition, equally accessible by all targets -
+// report identical names-hash for the proxy to confirm "file share" and run xaction
+func (t *target) prmBeginRemote(c *txnSrv, prmMsg *apc.PromoteArgs, hdr http.Header) error {
+	if err := xs.PrmStatRemote(prmMsg); err != nil {
+		return err
+	}
+	// NOTE: not listing (each target lists its own share in the commit phase) - counting sources
+	totalN := len(prmMsg.Sources)
+	txn := newTxnPromote(c, prmMsg, nil /*fqns*/, "" /*dirFQN*/, totalN)
+	if err := t.transactions.begin(txn); err != nil {
+		return err
+	}
+	cksum := cos.NewCksumHash(cos.ChecksumXXHash)
+	for _, src := range prmMsg.Sources {
+		cksum.H.Write([]byte(src.Agent))
+		cksum.H.Write([]byte(src.Path))
+	}
+	cksum.Finalize()
+	hdr.Set(apc.HdrPromoteNamesHash, cksum.Value())
+	hdr.Set(apc.HdrPromoteNamesNum, strconv.Itoa(totalN))
+	return nil
+}
+
 // scan and, optionally, auto-detect file-share
 func prmScan(dirFQN string, prmMsg *apc.PromoteArgs) (fqns []string, totalN int, cksumVal string, err error) {
 	var (
@@ -144,6 +144,12 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 		}
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(flt, err)
+	case apc.ActXactPause, apc.ActXactResume:
+		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
+		err := xreg.DoPause(flt, msg.Action == apc.ActXactPause)
+		if err != nil && !cmn.IsErrXactNotFound(err) { // (may have already finished here)
+			t.writeErr(w, r, err)
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -231,6 +237,22 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActTier:
+		rns := xreg.RenewTier(args.ID, bck)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xact.GoRunW(rns.Entry.Get())
+	case apc.ActECReencode:
+		// e.g., to finish re-encoding previously aborted (or failed) upon EC config change
+		if !bck.Props.EC.Enabled {
+			return xid, fmt.Errorf("%s: EC is disabled", bck)
+		}
+		rns := xreg.RenewECReencode(bck, args.ID)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xact.GoRunW(rns.Entry.Get())
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
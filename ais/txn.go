@@ -134,6 +134,10 @@ type (
 		totalN int
 		fshare bool
 	}
+	txnBckDiff struct {
+		xbdiff *xs.XactBckDiff
+		txnBckBase
+	}
 )
 
 // interface guard
@@ -147,6 +151,7 @@ var (
 	_ txn = (*txnTCObjs)(nil)
 	_ txn = (*txnECEncode)(nil)
 	_ txn = (*txnPromote)(nil)
+	_ txn = (*txnBckDiff)(nil)
 )
 
 //////////////////
@@ -631,6 +636,27 @@ func (txn *txnArchMultiObj) String() string {
 	return txn.txnBckBase.String()
 }
 
+////////////////
+// txnBckDiff //
+////////////////
+
+func newTxnBckDiff(c *txnSrv, xbdiff *xs.XactBckDiff) (txn *txnBckDiff) {
+	txn = &txnBckDiff{xbdiff: xbdiff}
+	txn.init(c.bck)
+	txn.fillFromCtx(c)
+	return
+}
+
+func (txn *txnBckDiff) abort(err error) {
+	txn.unlock()
+	txn.xbdiff.TxnAbort(err)
+}
+
+func (txn *txnBckDiff) String() string {
+	txn.xctn = txn.xbdiff
+	return txn.txnBckBase.String()
+}
+
 ////////////////
 // txnPromote //
 ////////////////
@@ -21,14 +21,17 @@ const (
 
 	ActSummaryBck = "summary-bck"
 
-	ActECEncode  = "ec-encode" // erasure code a bucket
-	ActECGet     = "ec-get"    // read erasure coded objects
-	ActECPut     = "ec-put"    // erasure code objects
-	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode   = "ec-encode"   // erasure code a bucket
+	ActECReencode = "ec-reencode" // re-encode erasure coded bucket upon EC config change
+	ActECGet      = "ec-get"      // read erasure coded objects
+	ActECPut      = "ec-put"      // erasure code objects
+	ActECRespond  = "ec-resp"     // respond to other targets' EC requests
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
 
+	ActBckDiff = "diff-bck" // compare two buckets (see BckDiffMsg)
+
 	ActETLInline = "etl-inline"
 
 	ActDsort    = "dsort"
@@ -49,6 +52,8 @@ const (
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
 
+	ActTier = "tier" // lifecycle-driven tiering to a "cold" bucket (see cmn.TieringConf)
+
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
 	ActList           = "list"
@@ -109,14 +114,20 @@ const (
 
 const (
 	// Actions on mountpaths (/v1/daemon/mountpaths)
-	ActMountpathAttach  = "attach-mp"
-	ActMountpathEnable  = "enable-mp"
-	ActMountpathDetach  = "detach-mp"
-	ActMountpathDisable = "disable-mp"
+	ActMountpathAttach   = "attach-mp"
+	ActMountpathEnable   = "enable-mp"
+	ActMountpathDetach   = "detach-mp"
+	ActMountpathDisable  = "disable-mp"
+	ActMountpathReadOnly = "readonly-mp"
 
 	// Actions on xactions
-	ActXactStop  = Stop
-	ActXactStart = Start
+	ActXactStop   = Stop
+	ActXactStart  = Start
+	ActXactPause  = "pause"
+	ActXactResume = "resume"
+
+	// job queue (primary only; see feat.QueueLimitedCoexistence)
+	ActPrioritizeJob = "prioritize-job"
 
 	// auxiliary
 	ActTransient = "transient" // transient - in-memory only
@@ -164,11 +175,27 @@ type (
 //   - WaitingDD - waiting for resilvering completion to be detached or disabled (moved to `Disabled`)
 //   - Disabled  - list of disabled mountpaths, the mountpaths that generated
 //     IO errors followed by (FSHC) health check, etc.
+//
+// and, when slow-disk detection is enabled, recent per-mountpath latency history.
 type (
 	MountpathList struct {
-		Available []string `json:"available"`
-		WaitingDD []string `json:"waiting_dd"`
-		Disabled  []string `json:"disabled"`
+		Health    map[string]*MpathHealth `json:"health,omitempty"`
+		Available []string                `json:"available"`
+		ReadOnly  []string                `json:"read_only,omitempty"` // (subset of available)
+		Labels    map[string]string       `json:"labels,omitempty"`    // mountpath => label (see fs.Mountpath.Label)
+		WaitingDD []string                `json:"waiting_dd"`
+		Disabled  []string                `json:"disabled"`
+	}
+	MpathHealth struct {
+		History   []MpathSample `json:"history"`              // oldest first
+		SlowSince int64         `json:"slow_since,omitempty"` // when flagged as slow (Unix nano)
+		Outliers  int           `json:"outliers"`             // consecutive outlier samples
+	}
+	MpathSample struct {
+		Time  int64   `json:"time"`  // Unix nano
+		Util  int64   `json:"util"`  // disk utilization (%)
+		Lat   int64   `json:"lat"`   // IO latency (microseconds)
+		Ratio float64 `json:"ratio"` // latency relative to the median of the peers (zero if unknown)
 	}
 )
 
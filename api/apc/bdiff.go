@@ -0,0 +1,37 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// default (ais://) bucket to store bucket-diff reports (see BckDiffMsg.Report)
+const BckDiffReportBck = "bck-diff-reports"
+
+type (
+	// compare source and destination buckets (apc.ActBckDiff)
+	BckDiffMsg struct {
+		Prefix string `json:"prefix"` // compare only the objects (names) that have this prefix
+		Report string `json:"report"` // name of the ais:// bucket to store the report (default: BckDiffReportBck)
+	}
+
+	// per-target x-bck-diff statistics (core.Snap.Ext)
+	BckDiffStats struct {
+		Report     string `json:"report"`            // report object name (empty if nothing to report)
+		Matched    int64  `json:"matched,string"`    // same name, size, and checksum
+		Unverified int64  `json:"unverified,string"` // matched by size only: no comparable checksums (subset of the above)
+		Missing    int64  `json:"missing,string"`    // in source but not in destination
+		Extra      int64  `json:"extra,string"`      // in destination but not in source
+		Mismatched int64  `json:"mismatched,string"` // different size or checksum
+	}
+)
+
+func (st *BckDiffStats) Add(other *BckDiffStats) {
+	st.Matched += other.Matched
+	st.Unverified += other.Unverified
+	st.Missing += other.Missing
+	st.Extra += other.Extra
+	st.Mismatched += other.Mismatched
+}
+
+// same (and the only) condition that'd warrant storing the report
+func (st *BckDiffStats) Differ() bool { return st.Missing+st.Extra+st.Mismatched > 0 }
@@ -31,6 +31,12 @@ type (
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
+		Quota struct {
+			MaxSize    int64 `json:"quota_max_size,string"`    // (see cmn.QuotaConf)
+			MaxObjects int64 `json:"quota_max_objects,string"` // ditto
+			Size       int64 `json:"quota_used_size,string"`   // usage, as tracked for the purposes of enforcing quotas
+			Objects    int64 `json:"quota_used_objects,string"`
+		}
 		UsedPct      uint64 `json:"used_pct"`
 		IsBckPresent bool   `json:"is_present"` // in BMD
 	}
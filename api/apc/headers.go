@@ -80,6 +80,7 @@ const (
 	HdrCallerName      = HeaderPrefix + "caller-name"
 	HdrCallerIsPrimary = HeaderPrefix + "caller-is-primary"
 	HdrCallerSmapVer   = HeaderPrefix + "caller-smap-ver"
+	HdrPrimaryEpoch    = HeaderPrefix + "primary-epoch" // metasync: primary lease epoch (split-brain fencing)
 
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
@@ -17,4 +17,13 @@ type PromoteArgs struct {
 	// and _not_ to try to auto-detect if it is;
 	// (auto-detection takes time, etc.)
 	SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+	// promote from remote (non-cluster) machines running promote agents (see ext/prmagent);
+	// when specified, `SrcFQN` and `DaemonID` must be empty
+	Sources []PromoteSrc `json:"srcs,omitempty"`
+}
+
+// remote promote source: file or directory on the machine running promote agent
+type PromoteSrc struct {
+	Agent string `json:"agent"` // promote agent URL, e.g. "http://gpu-node-01:51081"
+	Path  string `json:"path"`  // absolute (agent-local) pathname
 }
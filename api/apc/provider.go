@@ -16,8 +16,9 @@ const (
 	GCP   = "gcp"
 	HDFS  = "hdfs"
 	HTTP  = "ht"
+	POSIX = "posix"
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://, posix://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -35,7 +36,7 @@ const (
 	AISScheme     = "ais"
 )
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP, POSIX)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
@@ -44,7 +45,7 @@ func IsCloudProvider(p string) bool {
 }
 
 func IsRemoteProvider(p string) bool {
-	return IsCloudProvider(p) || p == HDFS || p == HTTP
+	return IsCloudProvider(p) || p == HDFS || p == HTTP || p == POSIX
 }
 
 func ToScheme(p string) string {
@@ -92,6 +93,8 @@ func DisplayProvider(p string) string {
 		return "HDFS"
 	case HTTP:
 		return "HTTP(S)"
+	case POSIX:
+		return "POSIX"
 	default:
 		return p
 	}
@@ -49,6 +49,9 @@ const (
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md"
 
+	// When destroying cloud bucket, delete the bucket itself (must be empty) - not only its in-cluster content
+	QparamDestroyRemote = "destroy_remote"
+
 	// (api.GetBucketInfo)
 	QparamBsummRemote = "bsumm_remote"
 
@@ -143,13 +146,17 @@ const (
 	QparamPrepare          = "prp" // true: request belongs to the "prepare" phase of the primary proxy election
 	QparamNonElectable     = "nel" // true: proxy is non-electable for the primary role
 	QparamUnixTime         = "utm" // Unix time since 01/01/70 UTC (nanoseconds)
+	QparamUserID           = "uid" // AuthN user (when redirecting; see auth.user_limit)
+	QparamUserSig          = "usg" // signed QparamUserID (so that the latter cannot be spoofed)
 	QparamIsGFNRequest     = "gfn" // true if the request is a Get-From-Neighbor
+	QparamColdRedir        = "cgr" // ID of the target that redirected cold GET to the object's owner (to redirect only once)
 	QparamRebStatus        = "rbs" // true: get detailed rebalancing status
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return cluster info and status
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
+	QparamMpathLabel   = "mplbl"  // mountpath label to attach with (see also: bucket storage class)
 
 	// dsort
 	QparamTotalCompressedSize       = "tcs"
@@ -196,6 +203,7 @@ const (
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatQueuedJobs      = "queued_jobs" // jobs waiting for "limited coexistence" conflicts to clear (primary)
 	// internal
 	WhatSnode    = "snode"
 	WhatICBundle = "ic_bundle"
@@ -32,6 +32,7 @@ const (
 	Users     = "users"    // AuthN
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
+	S3Creds   = "s3creds"  // AuthN: /v1/users/<user-id>/s3creds[/<access-key>]
 	IC        = "ic"       // information center
 
 	// l3 ---
@@ -81,6 +81,51 @@ func LoginUser(bp api.BaseParams, userID, pass, clusterID string, expire *time.D
 	return token, nil
 }
 
+// Issue new S3 credentials (access key and secret key) for a user.
+// Same as LoginUser, the credentials are valid for the specified cluster and
+// expire in `expire` time (or per AuthN configuration, if `nil`).
+// See also: api.PresignS3
+func IssueS3Cred(bp api.BaseParams, userID, pass, clusterID string, expire *time.Duration) (cred *S3Cred, err error) {
+	bp.Method = http.MethodPost
+	rec := LoginMsg{Password: pass, ExpiresIn: expire, ClusterID: clusterID}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Creds)
+		reqParams.Body = cos.MustMarshal(rec)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&cred); err != nil {
+		return nil, err
+	}
+	return cred, nil
+}
+
+// List user's S3 credentials (access keys only)
+func GetS3Creds(bp api.BaseParams, userID string) (creds []*S3Cred, err error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Creds)
+	}
+	_, err = reqParams.DoReqAny(&creds)
+	return creds, err
+}
+
+func RevokeS3Cred(bp api.BaseParams, userID, accessKey string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Creds, accessKey)
+	}
+	return reqParams.DoRequest()
+}
+
 func RegisterCluster(bp api.BaseParams, cluSpec CluACL) error {
 	msg := cos.MustMarshal(cluSpec)
 	bp.Method = http.MethodPost
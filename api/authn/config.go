@@ -51,9 +51,11 @@ type (
 		Secret       *string `json:"secret"`
 		ExpirePeriod *string `json:"expiration_time"`
 	}
-	// TokenList is a list of tokens pushed by authn
+	// TokenList is a list of tokens pushed by authn:
+	// revoked tokens and session tokens of the (active) S3 credentials
 	TokenList struct {
 		Tokens  []string `json:"tokens"`
+		S3      []string `json:"s3,omitempty"` // see tok.IssueS3JWT
 		Version int64    `json:"version,string"`
 	}
 )
@@ -42,6 +42,16 @@ type (
 		ExpiresIn *time.Duration `json:"expires_in"`
 		ClusterID string         `json:"cluster_id"`
 	}
+	// S3 credentials to sign S3 API requests, including presigned URLs;
+	// the secret key is returned only once, upon creation; the session token
+	// (that carries user's permissions) is never returned - AuthN pushes it to AIS gateways
+	S3Cred struct {
+		AccessKey    string    `json:"access_key"`
+		SecretKey    string    `json:"secret_key,omitempty"`
+		SessionToken string    `json:"session_token,omitempty"`
+		UserID       string    `json:"user"`
+		Expires      time.Time `json:"expires"`
+	}
 	RegisteredClusters struct {
 		M map[string]*CluACL `json:"clusters,omitempty"`
 	}
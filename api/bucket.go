@@ -158,6 +158,24 @@ func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 	return err
 }
 
+// DestroyCloudBucket removes a Cloud (s3://, gs://, az://) bucket from the cluster
+// and then destroys the bucket itself in the Cloud.
+// The latter must be empty - see also: EvictRemoteBucket, DeleteList/DeleteRange.
+func DestroyCloudBucket(bp BaseParams, bck cmn.Bck) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActDestroyBck})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(url.Values{apc.QparamDestroyRemote: []string{"true"}})
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // CopyBucket copies existing `bckFrom` bucket to the destination `bckTo` thus,
 // effectively, creating a copy of the `bckFrom`.
 //   - AIS will create `bckTo` on the fly but only if the destination bucket does not
@@ -201,6 +219,32 @@ func CopyBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltP
 	return
 }
 
+// DiffBuckets starts x-bck-diff to compare bckFrom and bckTo by object names, sizes, and checksums.
+// Returns xaction ID if successful, an error otherwise.
+// The results include:
+// - per-target apc.BckDiffStats (see api.QueryXactionSnaps, core.Snap.Ext);
+// - report objects, one per target (if any differences), stored in the msg.Report bucket
+// (apc.BckDiffReportBck by default).
+func DiffBuckets(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.BckDiffMsg) (xid string, err error) {
+	if err = bckTo.Validate(); err != nil {
+		return
+	}
+	q := bckFrom.NewQuery()
+	_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bckFrom.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActBckDiff, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // RenameBucket renames bckFrom as bckTo.
 // Returns xaction ID if successful, an error otherwise.
 func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (xid string, err error) {
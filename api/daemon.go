@@ -45,6 +45,12 @@ func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err
 
 // TODO: rewrite tests that come here with `force`
 func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, force bool) error {
+	return AttachLabeledMountpath(bp, node, mountpath, "" /*label*/, force)
+}
+
+// same as above, with a label (e.g. "nvme", "hdd") that bucket storage class can refer to
+// (see cmn.Bprops.StorageClass)
+func AttachLabeledMountpath(bp BaseParams, node *meta.Snode, mountpath, label string, force bool) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
 	{
@@ -57,6 +63,9 @@ func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, force bo
 			cos.HdrContentType: []string{cos.ContentJSON},
 		}
 		reqParams.Query = url.Values{apc.QparamForce: []string{strconv.FormatBool(force)}}
+		if label != "" {
+			reqParams.Query.Set(apc.QparamMpathLabel, label)
+		}
 	}
 	err := reqParams.DoRequest()
 	FreeRp(reqParams)
@@ -125,6 +134,26 @@ func DisableMountpath(bp BaseParams, node *meta.Snode, mountpath string, dontRes
 	return err
 }
 
+// ReadOnlyMountpath marks target's mountpath read-only: the mountpath keeps serving reads
+// while new writes get placed elsewhere and (throttled) resilvering gradually drains it.
+// To revert, call EnableMountpath.
+func ReadOnlyMountpath(bp BaseParams, node *meta.Snode, mountpath string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.Join(apc.Mountpaths)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMountpathReadOnly, Value: mountpath})
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:      []string{node.ID()},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // GetDaemonConfig returns the configuration of a specific daemon in a cluster.
 // (compare with `api.GetClusterConfig`)
 func GetDaemonConfig(bp BaseParams, node *meta.Snode) (config *cmn.Config, err error) {
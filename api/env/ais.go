@@ -20,6 +20,8 @@ var (
 		// tests, CI
 		NumTarget string
 		NumProxy  string
+		// promote agent
+		PrmSecret string
 		// K8s
 		K8sPod       string
 		K8sNode      string
@@ -45,6 +47,9 @@ var (
 		NumTarget: "NUM_TARGET",
 		NumProxy:  "NUM_PROXY",
 
+		// shared secret: promote agent (cmd/aisprm) and AIS targets
+		PrmSecret: "AIS_PRM_SECRET",
+
 		// via ais-k8s repo
 		// see also:
 		// * https://github.com/NVIDIA/ais-k8s/blob/master/operator/pkg/resources/cmn/env.go
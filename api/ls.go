@@ -244,7 +244,8 @@ func ListObjectsPage(bp BaseParams, bck cmn.Bck, lsmsg *apc.LsoMsg) (*cmn.LsoRes
 	return page, nil
 }
 
-// TODO: obsolete this function after introducing mechanism to detect remote bucket changes.
+// Invalidate cached listings of a remote bucket, including targets' persistent list cache
+// (see bucket property `list_cache` and docs/bucket.md).
 func ListObjectsInvalidateCache(bp BaseParams, bck cmn.Bck) error {
 	var (
 		path = apc.URLPathBuckets.Join(bck.Name)
@@ -81,6 +81,9 @@ type (
 
 		Size uint64 // optional
 
+		// optional; custom metadata to store along with the object (compare with SetObjectCustomProps)
+		CustomMD cos.StrKVs
+
 		// Skip loading existing object's metadata in order to
 		// compare its Checksum and update its existing Version (if exists);
 		// can be used to reduce PUT latency when:
@@ -270,6 +273,9 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	for k, v := range args.CustomMD {
+		req.Header.Add(apc.HdrObjCustomMD, k+"="+v)
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
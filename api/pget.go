@@ -0,0 +1,270 @@
+// Package api provides Go based AIStore API/SDK over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Parallel GET: split a given object into ranges and read those ranges concurrently,
+// with each range individually retried upon failure.
+// The destination is either:
+// - `io.WriterAt` - ranges are written at their respective offsets as soon as they arrive;
+// - `io.Writer`   - ranges are reassembled and written in order.
+// In both cases, the number of in-flight (fetched but not yet written) ranges
+// is limited to 2x number of workers (bounded memory).
+
+const (
+	PgetDfltChunkSize  = 8 * cos.MiB
+	PgetDfltNumWorkers = 4
+)
+
+type (
+	PgetArgs struct {
+		// destination: one of the two is required (`WriterAt`, if specified, takes precedence);
+		// WriterAt must support concurrent calls (e.g., *os.File)
+		WriterAt io.WriterAt
+		Writer   io.Writer
+
+		// optional; same as GetArgs.Query (note that range read excludes ETL and archived files)
+		Query url.Values
+
+		// range size; `PgetDfltChunkSize` when zero
+		ChunkSize int64
+
+		// number of concurrent range readers; `PgetDfltNumWorkers` when zero
+		NumWorkers int
+
+		// compute the whole-object checksum and compare it with the one stored in the cluster;
+		// returns `cmn.ErrInvalidCksum` when the two differ
+		ValidateCksum bool
+	}
+
+	pget struct {
+		args    *PgetArgs
+		bp      BaseParams
+		bck     cmn.Bck
+		objName string
+		props   *cmn.ObjectProps
+		cksum   *cos.CksumHash
+		slots   chan []byte // free buffers
+		work    chan *pgetChunk
+		done    chan *pgetChunk
+		stop    chan struct{}
+		wg      sync.WaitGroup
+		nchunks int
+	}
+	pgetChunk struct {
+		err error
+		buf []byte
+		off int64
+		len int64
+		idx int
+	}
+)
+
+// GetObjectParallel reads the object using multiple concurrent range reads;
+// small objects (or a single worker) resolve to a regular (single-stream) GET.
+func GetObjectParallel(bp BaseParams, bck cmn.Bck, objName string, args *PgetArgs) (oah ObjAttrs, err error) {
+	if args.WriterAt == nil && args.Writer == nil {
+		return oah, fmt.Errorf("parallel GET %s: destination writer is required", bck.Cname(objName))
+	}
+	pg := &pget{args: args, bp: bp, bck: bck, objName: objName}
+	if args.ChunkSize <= 0 {
+		args.ChunkSize = PgetDfltChunkSize
+	}
+	if args.NumWorkers <= 0 {
+		args.NumWorkers = PgetDfltNumWorkers
+	}
+	if pg.props, err = HeadObject(bp, bck, objName, apc.FltExists, false /*silent*/); err != nil {
+		return oah, err
+	}
+	oah.wrespHeader = make(http.Header, 4)
+	cmn.ToHeader(&pg.props.ObjAttrs, oah.wrespHeader)
+	oah.n = pg.props.Size
+
+	size := pg.props.Size
+	if size <= args.ChunkSize || args.NumWorkers == 1 {
+		return pg.single()
+	}
+	if args.ValidateCksum {
+		cksum := pg.props.Cksum
+		if cksum.IsEmpty() {
+			return oah, fmt.Errorf("%s is not checksummed, cannot validate", bck.Cname(objName))
+		}
+		pg.cksum = cos.NewCksumHash(cksum.Ty())
+	}
+	if err = pg.run(); err != nil {
+		return oah, err
+	}
+	if pg.cksum != nil {
+		pg.cksum.Finalize()
+		if !pg.cksum.Equal(pg.props.Cksum) {
+			return oah, cmn.NewErrInvalidCksum(pg.props.Cksum.Val(), pg.cksum.Val())
+		}
+	}
+	return oah, nil
+}
+
+func (pg *pget) single() (ObjAttrs, error) {
+	w := pg.args.Writer
+	if pg.args.WriterAt != nil {
+		w = io.NewOffsetWriter(pg.args.WriterAt, 0)
+	}
+	getArgs := &GetArgs{Writer: w, Query: pg.args.Query}
+	if pg.args.ValidateCksum {
+		return GetObjectWithValidation(pg.bp, pg.bck, pg.objName, getArgs)
+	}
+	return GetObject(pg.bp, pg.bck, pg.objName, getArgs)
+}
+
+func (pg *pget) run() error {
+	var (
+		size    = pg.props.Size
+		window  = 2 * pg.args.NumWorkers
+		pending = make(map[int]*pgetChunk, window)
+		next    int
+		err     error
+	)
+	pg.nchunks = int((size + pg.args.ChunkSize - 1) / pg.args.ChunkSize)
+	pg.slots = make(chan []byte, window)
+	for i := 0; i < window; i++ {
+		pg.slots <- nil // allocated on demand
+	}
+	pg.work = make(chan *pgetChunk, pg.args.NumWorkers)
+	pg.done = make(chan *pgetChunk, window)
+	pg.stop = make(chan struct{})
+
+	pg.wg.Add(1 + pg.args.NumWorkers)
+	go pg.dispatch()
+	for i := 0; i < pg.args.NumWorkers; i++ {
+		go pg.worker()
+	}
+
+	// sequence: hash and/or write in order
+	for next < pg.nchunks && err == nil {
+		c := <-pg.done
+		if c.err != nil {
+			err = c.err
+			break
+		}
+		if pg.args.WriterAt != nil && pg.cksum == nil {
+			pg.slots <- c.buf
+			next++ // (count only)
+			continue
+		}
+		pending[c.idx] = c
+		for c, ok := pending[next]; ok; c, ok = pending[next] {
+			delete(pending, next)
+			if pg.cksum != nil {
+				pg.cksum.H.Write(c.buf)
+			}
+			if pg.args.WriterAt == nil {
+				if _, err = pg.args.Writer.Write(c.buf); err != nil {
+					break
+				}
+			}
+			pg.slots <- c.buf
+			next++
+		}
+	}
+	close(pg.stop)
+	pg.wg.Wait()
+	return err
+}
+
+func (pg *pget) dispatch() {
+	defer func() {
+		close(pg.work)
+		pg.wg.Done()
+	}()
+	for idx := 0; idx < pg.nchunks; idx++ {
+		var buf []byte
+		select {
+		case buf = <-pg.slots:
+		case <-pg.stop:
+			return
+		}
+		off := int64(idx) * pg.args.ChunkSize
+		c := &pgetChunk{idx: idx, off: off, len: min(pg.args.ChunkSize, pg.props.Size-off), buf: buf}
+		select {
+		case pg.work <- c:
+		case <-pg.stop:
+			return
+		}
+	}
+}
+
+func (pg *pget) worker() {
+	defer pg.wg.Done()
+	for c := range pg.work {
+		c.err = pg.fetch(c)
+		select {
+		case pg.done <- c:
+		case <-pg.stop:
+			return
+		}
+	}
+}
+
+// read a single range; retry upon (retriable) failure
+func (pg *pget) fetch(c *pgetChunk) (err error) {
+	var (
+		sleep = httpRetrySleep
+		hdr   = http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(c.off, c.len)}}
+	)
+	if c.buf == nil {
+		c.buf = make([]byte, 0, pg.args.ChunkSize)
+	}
+	for i := 0; ; i++ {
+		var (
+			oah ObjAttrs
+			buf = bytes.NewBuffer(c.buf[:0])
+		)
+		oah, err = GetObject(pg.bp, pg.bck, pg.objName, &GetArgs{Writer: buf, Query: pg.args.Query, Header: hdr})
+		if err == nil {
+			// the object must not change while we read it
+			if ver := oah.RespHeader().Get(apc.HdrObjVersion); ver != pg.props.Ver {
+				return fmt.Errorf("parallel GET %s: object changed (version %q => %q)",
+					pg.bck.Cname(pg.objName), pg.props.Ver, ver)
+			}
+			if n := int64(buf.Len()); n != c.len {
+				err = fmt.Errorf("parallel GET %s: range [%d, %d): read %d bytes",
+					pg.bck.Cname(pg.objName), c.off, c.off+c.len, n)
+			} else {
+				c.buf = buf.Bytes()
+				if pg.args.WriterAt != nil {
+					_, err = pg.args.WriterAt.WriteAt(c.buf, c.off)
+					return err
+				}
+				return nil
+			}
+		}
+		if i >= httpMaxRetries || !pgetRetriable(err) {
+			return err
+		}
+		time.Sleep(sleep)
+		sleep += sleep / 2
+	}
+}
+
+// any error other than (non-throttling) 4xx
+func pgetRetriable(err error) bool {
+	herr, ok := err.(*cmn.ErrHTTP)
+	if !ok {
+		return true
+	}
+	return herr.Status >= http.StatusInternalServerError || herr.Status == http.StatusTooManyRequests
+}
@@ -8,11 +8,28 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 )
 
+// PresignS3 returns time-limited URL to GET, HEAD, or PUT a given object via AIS S3 API;
+// the URL can be used by anyone (e.g., curl) without any further authentication.
+// For the credentials, see authn.IssueS3Cred.
+func PresignS3(bp BaseParams, bck cmn.Bck, objName, method string, creds *cmn.S3Creds, expires time.Duration) (string, error) {
+	u, err := url.Parse(bp.URL)
+	if err != nil {
+		return "", err
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + apc.URLPathS3.Join(bck.Name, objName)
+	if err := cmn.S3Presign(method, u, creds, "" /*default region*/, expires, time.Now()); err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
 // s3/<bucket-name>/<object-name>
 func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs) (int64, error) {
 	var (
@@ -60,6 +60,69 @@ func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	return
 }
 
+// Pause xaction(s) that support pausing and checkpointing their progress
+// (see `xact.Descriptor.Pausable`)
+func PauseXaction(bp BaseParams, args *xact.ArgsMsg) error {
+	return _pauseResume(bp, args, apc.ActXactPause)
+}
+
+// Resume previously paused xaction(s)
+func ResumeXaction(bp BaseParams, args *xact.ArgsMsg) error {
+	return _pauseResume(bp, args, apc.ActXactResume)
+}
+
+func _pauseResume(bp BaseParams, args *xact.ArgsMsg, action string) (err error) {
+	msg := apc.ActMsg{Action: action, Value: args}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = args.Bck.NewQuery()
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
+//
+// job queue (see feat.QueueLimitedCoexistence)
+//
+
+// GetQueuedJobs returns jobs that are waiting for "limited coexistence" conflicts to clear,
+// in the order they are going to be (re)tried
+func GetQueuedJobs(bp BaseParams) (out []*xact.QueuedJob, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatQueuedJobs}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return
+}
+
+// PrioritizeJob changes the priority of a queued job (higher-priority jobs start first);
+// to cancel a queued job, use `AbortXaction` with the job's ID
+func PrioritizeJob(bp BaseParams, jobID string, priority int) (err error) {
+	msg := apc.ActMsg{Action: apc.ActPrioritizeJob, Name: jobID, Value: priority}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
 //
 // querying and waiting
 //
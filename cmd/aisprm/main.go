@@ -0,0 +1,87 @@
+// Package main for the `aisprm` executable: promote agent that streams local files
+// (e.g., GPU nodes' local NVMe) into AIS cluster.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/prmagent"
+)
+
+var flags struct {
+	roots    string
+	secret   string
+	addr     string
+	certFile string
+	keyFile  string
+	logDir   string
+	help     bool
+}
+
+const helpMsg = `Build:
+	go install main.go
+
+Examples:
+	aisprm -h                                              - show usage
+	aisprm -root=/nvme/data -secret=...                    - serve /nvme/data at the default port
+	AIS_PRM_SECRET=... aisprm -root=/nvme/data             - same as above
+	aisprm -root=/nvme/data,/nvme/ckpt -addr=:9999         - serve two directories at port 9999
+	aisprm -root=/nvme/data -cert=agent.crt -key=agent.key - HTTPS
+
+Once running, promote (e.g.) recursively /nvme/data/imagenet:
+	ais object promote /nvme/data/imagenet ais://nnn -r --agent http://gpu-node-01:51081
+`
+
+func main() {
+	newFlag := flag.NewFlagSet(os.Args[0], flag.ExitOnError) // discard flags of imported packages
+	newFlag.StringVar(&flags.roots, "root", "", "comma-separated list of (absolute) directories to serve (required)")
+	newFlag.StringVar(&flags.secret, "secret", "", "shared secret (default: $"+env.AIS.PrmSecret+"); AIS targets must have the same")
+	newFlag.StringVar(&flags.addr, "addr", ":"+strconv.Itoa(prmagent.DefaultPort), "listening address")
+	newFlag.StringVar(&flags.certFile, "cert", "", "TLS certificate (HTTPS)")
+	newFlag.StringVar(&flags.keyFile, "key", "", "TLS private key (HTTPS)")
+	newFlag.StringVar(&flags.logDir, "log_dir", os.TempDir(), "log directory")
+	newFlag.BoolVar(&flags.help, "h", false, "print usage and exit")
+	nlog.InitFlags(newFlag)
+	newFlag.Parse(os.Args[1:])
+	if flags.help || flags.roots == "" {
+		newFlag.Usage()
+		fmt.Print(helpMsg)
+		os.Exit(0)
+	}
+	nlog.SetLogDirRole(flags.logDir, "prm")
+
+	var roots []string
+	for _, root := range strings.Split(flags.roots, ",") {
+		if root = strings.TrimSpace(root); root != "" {
+			roots = append(roots, cos.ExpandPath(root))
+		}
+	}
+	if flags.secret == "" {
+		flags.secret = os.Getenv(env.AIS.PrmSecret)
+	}
+	agent, err := prmagent.New(roots, flags.secret)
+	if err != nil {
+		cos.ExitLog(err)
+	}
+	srv := &http.Server{Addr: flags.addr, Handler: agent, ReadHeaderTimeout: 10 * time.Second}
+	nlog.Infof("promote agent: serving %v at %s", roots, flags.addr)
+	if flags.certFile != "" {
+		err = srv.ListenAndServeTLS(flags.certFile, flags.keyFile)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	nlog.Flush(nlog.ActExit)
+	cos.ExitLog(err)
+}
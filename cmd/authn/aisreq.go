@@ -79,21 +79,42 @@ func (m *mgr) broadcast(method, path string, body []byte, tag string) {
 	wg.Wait()
 }
 
-// Send valid and non-expired revoked token list to a cluster.
+// Push the session token of new S3 credentials to all clusters
+// (gateways resolve S3 access keys - see proxy's validatePresignedS3).
+func (m *mgr) broadcastS3(token string) {
+	body := cos.MustMarshal(authn.TokenList{S3: []string{token}})
+	m.broadcast(http.MethodPut, apc.Tokens, body, "broadcast-s3-cred")
+}
+
+// Send valid and non-expired revoked token list to a cluster,
+// and the session tokens of all active S3 credentials.
 func (m *mgr) syncTokenList(clu *authn.CluACL) {
-	const tag = "sync-tokens"
 	tokenList, err := m.generateRevokedTokenList()
 	if err != nil {
 		nlog.Errorf("failed to sync token list with %q(%q): %v", clu.ID, clu.Alias, err)
 		return
 	}
-	if len(tokenList) == 0 {
+	if len(tokenList) > 0 {
+		body := cos.MustMarshal(authn.TokenList{Tokens: tokenList})
+		m.send(clu, http.MethodDelete, body, "sync-tokens")
+	}
+	s3Tokens, err := m.s3Tokens()
+	if err != nil {
+		nlog.Errorf("failed to sync S3 credentials with %q(%q): %v", clu.ID, clu.Alias, err)
 		return
 	}
-	body := cos.MustMarshal(authn.TokenList{Tokens: tokenList})
+	if len(s3Tokens) > 0 {
+		body := cos.MustMarshal(authn.TokenList{S3: s3Tokens})
+		m.send(clu, http.MethodPut, body, "sync-s3-creds")
+	}
+}
+
+// send to a given cluster (via the first responding URL)
+func (m *mgr) send(clu *authn.CluACL, method string, body []byte, tag string) {
+	var err error
 	for _, u := range clu.URLs {
-		if err = m.call(http.MethodDelete, u, apc.Tokens, body, tag); err == nil {
-			break
+		if err = m.call(method, u, apc.Tokens, body, tag); err == nil {
+			return
 		}
 		err = fmt.Errorf("failed to %s with %s: %v", tag, clu, err)
 	}
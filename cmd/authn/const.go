@@ -17,6 +17,7 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	s3credsCollection  = "s3cred"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
@@ -156,6 +156,10 @@ func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	if err = validateAdminPerms(w, r); err != nil {
 		return
 	}
+	if len(apiItems) > 1 {
+		h.s3CredDel(w, r, apiItems)
+		return
+	}
 	if err := h.mgr.delUser(apiItems[0]); err != nil {
 		nlog.Errorf("Failed to delete user: %v\n", err)
 		cmn.WriteErrMsg(w, r, "Failed to delete user: "+err.Error())
@@ -167,9 +171,12 @@ func (h *hserv) httpUserPost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if len(apiItems) == 0 {
+	switch {
+	case len(apiItems) == 0:
 		h.userAdd(w, r)
-	} else {
+	case len(apiItems) > 1 && apiItems[1] == apc.S3Creds:
+		h.s3CredIssue(w, r, apiItems[0])
+	default:
 		h.userLogin(w, r)
 	}
 }
@@ -225,6 +232,10 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(items) == 2 && items[1] == apc.S3Creds {
+		h.s3CredGet(w, r, items[0])
+		return
+	}
 	if len(items) > 1 {
 		cmn.WriteErrMsg(w, r, "invalid request")
 		return
@@ -320,6 +331,52 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 	writeBytes(w, []byte(repl), "auth")
 }
 
+// POST /v1/users/<user-id>/s3creds (same as login, requires user's password)
+func (h *hserv) s3CredIssue(w http.ResponseWriter, r *http.Request, userID string) {
+	msg := &authn.LoginMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.Password == "" {
+		cmn.WriteErrMsg(w, r, "Not authorized", http.StatusUnauthorized)
+		return
+	}
+	cred, err := h.mgr.issueS3Cred(userID, msg.Password, msg)
+	if err != nil {
+		nlog.Errorf("Failed to issue S3 credentials for user %q: %v\n", userID, err)
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Issue S3 access key %s for user %q", cred.AccessKey, userID)
+	}
+	writeJSON(w, cred, "issue S3 credentials")
+}
+
+// GET /v1/users/<user-id>/s3creds (admin only)
+func (h *hserv) s3CredGet(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateAdminPerms(w, r); err != nil {
+		return
+	}
+	creds, err := h.mgr.s3CredList(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	writeJSON(w, creds, "list S3 credentials")
+}
+
+// DELETE /v1/users/<user-id>/s3creds/<access-key> (admin only)
+func (h *hserv) s3CredDel(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) != 3 || items[1] != apc.S3Creds {
+		cmn.WriteErrMsg(w, r, "invalid request")
+		return
+	}
+	if err := h.mgr.revokeS3Cred(items[0], items[2]); err != nil {
+		cmn.WriteErr(w, r, err, http.StatusNotFound)
+	}
+}
+
 func writeJSON(w http.ResponseWriter, val any, tag string) {
 	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
 	var err error
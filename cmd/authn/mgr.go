@@ -5,10 +5,12 @@
 package main
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -74,12 +76,24 @@ func (m *mgr) addUser(info *authn.User) error {
 	return m.db.Set(usersCollection, info.ID, info)
 }
 
-// Deletes an existing user
+// Deletes an existing user (and revokes all user's S3 credentials)
 func (m *mgr) delUser(userID string) error {
 	if userID == adminUserID {
 		return fmt.Errorf("cannot remove built-in %q account", adminUserID)
 	}
-	return m.db.Delete(usersCollection, userID)
+	if err := m.db.Delete(usersCollection, userID); err != nil {
+		return err
+	}
+	creds, err := m.s3CredList(userID)
+	if err != nil {
+		return err
+	}
+	for _, cred := range creds {
+		if err := m.revokeS3Cred(userID, cred.AccessKey); err != nil {
+			nlog.Errorln(err)
+		}
+	}
+	return nil
 }
 
 // Updates an existing user. The function invalidates user tokens after
@@ -367,28 +381,51 @@ func (m *mgr) delCluster(cluID string) error {
 // If a new token was generated then it sends the proxy a new valid token list
 func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error) {
 	var (
-		err     error
-		expires time.Time
-		token   string
-		uInfo   = &authn.User{}
-		cid     string
+		err   error
+		token string
 	)
-
-	err = m.db.Get(usersCollection, userID, uInfo)
+	uInfo, err := m.login(userID, pwd, msg)
 	if err != nil {
+		return "", err
+	}
+
+	// generate token
+	Conf.RLock()
+	defer Conf.RUnlock()
+	expires := _expires(msg)
+
+	// put all useful info into token: who owns the token, when it was issued,
+	// when it expires and credentials to log in AWS, GCP etc.
+	// If a user is a super user, it is enough to pass only isAdmin marker
+	if uInfo.IsAdmin() {
+		token, err = tok.IssueAdminJWT(expires, userID, Conf.Server.Secret)
+	} else {
+		token, err = tok.IssueJWT(expires, userID, uInfo.BucketACLs, uInfo.ClusterACLs, Conf.Server.Secret)
+	}
+	return token, err
+}
+
+// Validates user credentials and returns user info with all ACLs (including roles')
+// for the cluster specified in the login message.
+func (m *mgr) login(userID, pwd string, msg *authn.LoginMsg) (*authn.User, error) {
+	var (
+		uInfo = &authn.User{}
+		cid   string
+	)
+	if err := m.db.Get(usersCollection, userID, uInfo); err != nil {
 		nlog.Errorln(err)
-		return "", errInvalidCredentials
+		return nil, errInvalidCredentials
 	}
 	if !isSamePassword(pwd, uInfo.Password) {
-		return "", errInvalidCredentials
+		return nil, errInvalidCredentials
 	}
 	if !uInfo.IsAdmin() {
 		if msg.ClusterID == "" {
-			return "", fmt.Errorf("Couldn't issue token for %q: cluster ID not set", userID)
+			return nil, fmt.Errorf("Couldn't issue token for %q: cluster ID not set", userID)
 		}
 		cid = m.cluLookup(msg.ClusterID, msg.ClusterID)
 		if cid == "" {
-			return "", cos.NewErrNotFound(m, "cluster "+msg.ClusterID)
+			return nil, cos.NewErrNotFound(m, "cluster "+msg.ClusterID)
 		}
 		uInfo.ClusterACLs = mergeClusterACLs(make([]*authn.CluACL, 0, len(uInfo.ClusterACLs)), uInfo.ClusterACLs, cid)
 		uInfo.BucketACLs = mergeBckACLs(make([]*authn.BckACL, 0, len(uInfo.BucketACLs)), uInfo.BucketACLs, cid)
@@ -404,11 +441,14 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 		uInfo.ClusterACLs = mergeClusterACLs(uInfo.ClusterACLs, rInfo.ClusterACLs, cid)
 		uInfo.BucketACLs = mergeBckACLs(uInfo.BucketACLs, rInfo.BucketACLs, cid)
 	}
+	if !uInfo.IsAdmin() {
+		m.fixClusterIDs(uInfo.ClusterACLs)
+	}
+	return uInfo, nil
+}
 
-	// generate token
-	Conf.RLock()
-	defer Conf.RUnlock()
-	issued := time.Now()
+// must be called under Conf lock
+func _expires(msg *authn.LoginMsg) time.Time {
 	expDelta := time.Duration(Conf.Server.ExpirePeriod)
 	if msg.ExpiresIn != nil {
 		expDelta = *msg.ExpiresIn
@@ -416,18 +456,7 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 	if expDelta == 0 {
 		expDelta = foreverTokenTime
 	}
-	expires = issued.Add(expDelta)
-
-	// put all useful info into token: who owns the token, when it was issued,
-	// when it expires and credentials to log in AWS, GCP etc.
-	// If a user is a super user, it is enough to pass only isAdmin marker
-	if uInfo.IsAdmin() {
-		token, err = tok.IssueAdminJWT(expires, userID, Conf.Server.Secret)
-	} else {
-		m.fixClusterIDs(uInfo.ClusterACLs)
-		token, err = tok.IssueJWT(expires, userID, uInfo.BucketACLs, uInfo.ClusterACLs, Conf.Server.Secret)
-	}
-	return token, err
+	return time.Now().Add(expDelta)
 }
 
 // Before putting a list of cluster permissions to a token, cluster aliases
@@ -491,10 +520,127 @@ func (m *mgr) generateRevokedTokenList() ([]string, error) {
 	return revokeList, nil
 }
 
+//
+// S3 credentials ============================================================
+//
+
+// Issues new S3 credentials for a user: random access key, secret key derived from the latter,
+// and session token that carries user's ACLs (same as the regular token).
+// Only the access key and the session token are stored - the latter, to push to AIS gateways
+// (where it resolves the access key to the user) and to be able to revoke.
+// The session token itself is never returned to the user.
+func (m *mgr) issueS3Cred(userID, pwd string, msg *authn.LoginMsg) (*authn.S3Cred, error) {
+	uInfo, err := m.login(userID, pwd, msg)
+	if err != nil {
+		return nil, err
+	}
+	Conf.RLock()
+	var (
+		secret = Conf.Server.Secret
+		cred   = &authn.S3Cred{AccessKey: newS3AccessKey(), UserID: userID, Expires: _expires(msg)}
+	)
+	Conf.RUnlock()
+	cred.SessionToken, err = tok.IssueS3JWT(cred.Expires, userID, cred.AccessKey, uInfo.BucketACLs, uInfo.ClusterACLs,
+		uInfo.IsAdmin(), secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.db.Set(s3credsCollection, cred.AccessKey, cred); err != nil {
+		return nil, err
+	}
+	go m.broadcastS3(cred.SessionToken)
+
+	cred.SecretKey = tok.S3SecretKey(cred.AccessKey, secret)
+	cred.SessionToken = ""
+	return cred, nil
+}
+
+// Returns user's (or all, if userID is empty) non-expired credentials - access keys only.
+// Expired ones are removed from the database.
+func (m *mgr) s3CredList(userID string) ([]*authn.S3Cred, error) {
+	all, err := m._s3Creds()
+	if err != nil {
+		return nil, err
+	}
+	creds := make([]*authn.S3Cred, 0, len(all))
+	for _, cred := range all {
+		if userID == "" || cred.UserID == userID {
+			cred.SessionToken = ""
+			creds = append(creds, cred)
+		}
+	}
+	sort.Slice(creds, func(i, j int) bool { return creds[i].Expires.Before(creds[j].Expires) })
+	return creds, nil
+}
+
+// Returns session tokens of all non-expired credentials (to push to AIS clusters).
+func (m *mgr) s3Tokens() ([]string, error) {
+	all, err := m._s3Creds()
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(all))
+	for _, cred := range all {
+		tokens = append(tokens, cred.SessionToken)
+	}
+	return tokens, nil
+}
+
+func (m *mgr) _s3Creds() ([]*authn.S3Cred, error) {
+	recs, err := m.db.GetAll(s3credsCollection, "")
+	if err != nil {
+		return nil, err
+	}
+	var (
+		now   = time.Now()
+		creds = make([]*authn.S3Cred, 0, len(recs))
+	)
+	for ak, str := range recs {
+		cred := &authn.S3Cred{}
+		if err := jsoniter.Unmarshal([]byte(str), cred); err != nil {
+			nlog.Errorf("failed to unmarshal S3 credentials %q: %v", ak, err)
+			continue
+		}
+		if cred.Expires.Before(now) {
+			m.db.Delete(s3credsCollection, ak)
+			continue
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+// Revokes S3 credentials by revoking the corresponding session token.
+func (m *mgr) revokeS3Cred(userID, accessKey string) error {
+	cred := &authn.S3Cred{}
+	if err := m.db.Get(s3credsCollection, accessKey, cred); err != nil || cred.UserID != userID {
+		return cos.NewErrNotFound(m, "S3 access key "+accessKey+" of user "+userID)
+	}
+	if err := m.revokeToken(cred.SessionToken); err != nil {
+		return err
+	}
+	return m.db.Delete(s3credsCollection, accessKey)
+}
+
 //
 // private helpers ============================================================
 //
 
+// AWS-like access key ID: 20 uppercase alphanumeric characters
+func newS3AccessKey() string {
+	const (
+		prefix = "AKAIS"
+		chars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	)
+	b := make([]byte, 20-len(prefix))
+	_, err := rand.Read(b)
+	cos.AssertNoErr(err)
+	for i := range b {
+		b[i] = chars[int(b[i])%len(chars)]
+	}
+	return prefix + string(b)
+}
+
 func encryptPassword(password string) string {
 	b, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	cos.AssertNoErr(err)
@@ -6,6 +6,9 @@
 package tok
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -26,6 +29,7 @@ type Token struct {
 	ClusterACLs []*authn.CluACL `json:"clusters"`
 	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
 	IsAdmin     bool            `json:"admin"`
+	S3AccessKey string          `json:"s3key,omitempty"` // session token of the S3 credentials (see IssueS3JWT)
 }
 
 var (
@@ -57,6 +61,32 @@ func IssueJWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clus
 	return t.SignedString([]byte(secret))
 }
 
+// Session token for the S3 access key (and the secret key derived from it - see S3SecretKey);
+// carries the same ACLs as the regular user token.
+func IssueS3JWT(expires time.Time, userID, accessKey string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	isAdmin bool, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"s3key":    accessKey,
+	}
+	if isAdmin {
+		claims["admin"] = true
+	} else {
+		claims["buckets"], claims["clusters"] = bucketACLs, clusterACLs
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return t.SignedString([]byte(secret))
+}
+
+// S3 secret key is not stored anywhere - AuthN and AIS gateways derive it
+// from the access key and the shared secret.
+func S3SecretKey(accessKey, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("s3:" + accessKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // Header format: 'Authorization: Bearer <token>'
 func ExtractToken(hdr http.Header) (string, error) {
 	s := hdr.Get(apc.HdrAuthorization)
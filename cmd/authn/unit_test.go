@@ -193,6 +193,53 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestS3Cred(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	clu := authn.CluACL{ID: "ABCD", Alias: "cluster-test", URLs: []string{"http://localhost:8080"}}
+	tassert.CheckFatal(t, mgr.db.Set(clustersCollection, clu.ID, clu))
+	defer mgr.delCluster(clu.ID)
+	loginMsg := &authn.LoginMsg{ClusterID: clu.ID}
+
+	_, err = mgr.issueS3Cred(users[0], passs[1], loginMsg)
+	tassert.Errorf(t, err == errInvalidCredentials, "expected invalid credentials, got %v", err)
+
+	cred, err := mgr.issueS3Cred(users[0], passs[0], loginMsg)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cred.AccessKey != "" && cred.SecretKey != "", "incomplete S3 credentials %+v", cred)
+	tassert.Errorf(t, cred.SessionToken == "", "session token must not be returned")
+
+	// secret key is derived (never stored), session token (stored and pushed to clusters) carries the access key
+	secret := Conf.Secret()
+	tassert.Errorf(t, cred.SecretKey == tok.S3SecretKey(cred.AccessKey, secret), "secret key mismatch")
+	tassert.CheckFatal(t, mgr.db.Get(s3credsCollection, cred.AccessKey, cred))
+	tokens, err := mgr.s3Tokens()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(tokens) == 1 && tokens[0] == cred.SessionToken, "expected stored session token, got %v", tokens)
+	tk, err := tok.DecryptToken(cred.SessionToken, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == users[0] && tk.S3AccessKey == cred.AccessKey, "invalid session token %+v", tk)
+
+	creds, err := mgr.s3CredList(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(creds) == 1, "expected 1 S3 credential, got %d", len(creds))
+	tassert.Errorf(t, creds[0].SecretKey == "" && creds[0].SessionToken == "", "listed credentials must not include secrets")
+
+	err = mgr.revokeS3Cred(users[1], cred.AccessKey)
+	tassert.Errorf(t, cos.IsErrNotFound(err), "expected not-found when revoking other user's key, got %v", err)
+	tassert.CheckFatal(t, mgr.revokeS3Cred(users[0], cred.AccessKey))
+	creds, err = mgr.s3CredList(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(creds) == 0, "expected no S3 credentials after revocation, got %d", len(creds))
+	var revoked string
+	err = mgr.db.Get(revokedCollection, cred.SessionToken, &revoked)
+	tassert.Errorf(t, err == nil, "session token must be revoked: %v", err)
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
@@ -215,6 +215,7 @@ func (a *acli) setupCommands() {
 		archCmd,
 		logCmd,
 		perfCmd,
+		topCmd,
 		remClusterCmd,
 		a.getAliasCmd(),
 	}
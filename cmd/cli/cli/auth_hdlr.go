@@ -38,6 +38,7 @@ const (
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
+	flagsAuthS3CredAdd   = "s3cred_add"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
@@ -52,6 +53,7 @@ var (
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthS3CredAdd:   {passwordFlag, expireFlag, clusterTokenFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -86,6 +88,13 @@ var (
 				Flags:  authFlags[flagsAuthConfShow],
 				Action: wrapAuthN(showAuthConfigHandler),
 			},
+			{
+				Name:         cmdAuthS3Cred,
+				Usage:        "show user's S3 credentials (access keys and expiration times)",
+				ArgsUsage:    userLoginArgument,
+				Action:       wrapAuthN(showAuthS3CredHandler),
+				BashComplete: oneUserCompletions,
+			},
 		},
 	}
 
@@ -114,6 +123,15 @@ var (
 						ArgsUsage: addAuthClusterArgument,
 						Action:    wrapAuthN(addAuthClusterHandler),
 					},
+					{
+						Name: cmdAuthS3Cred,
+						Usage: "issue S3 credentials (access key and secret key) for an existing user\n" +
+							indent4 + "\tto sign S3 API requests and generate presigned URLs (see 'ais object presign --help')",
+						ArgsUsage:    userLoginArgument,
+						Flags:        authFlags[flagsAuthS3CredAdd],
+						Action:       wrapAuthN(addAuthS3CredHandler),
+						BashComplete: oneUserCompletions,
+					},
 					{
 						Name:         cmdAuthRole,
 						Usage:        "create a new role",
@@ -150,6 +168,13 @@ var (
 						Action:       wrapAuthN(deleteRoleHandler),
 						BashComplete: oneRoleCompletions,
 					},
+					{
+						Name:         cmdAuthS3Cred,
+						Usage:        "revoke user's S3 credentials",
+						ArgsUsage:    deleteAuthS3CredArgument,
+						Action:       wrapAuthN(deleteAuthS3CredHandler),
+						BashComplete: oneUserCompletions,
+					},
 					{
 						Name:      cmdAuthToken,
 						Usage:     "revoke AuthN token",
@@ -382,6 +407,51 @@ func loginUserHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+func addAuthS3CredHandler(c *cli.Context) error {
+	var (
+		expireIn *time.Duration
+		name     = cliAuthnUserName(c)
+		password = cliAuthnUserPassword(c, false)
+		cluID    = parseStrFlag(c, clusterTokenFlag)
+	)
+	if flagIsSet(c, expireFlag) {
+		expireIn = apc.Duration(parseDurationFlag(c, expireFlag))
+	}
+	cred, err := authn.IssueS3Cred(authParams, name, password, cluID, expireIn)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "export AWS_ACCESS_KEY_ID=%s\n", cred.AccessKey)
+	fmt.Fprintf(c.App.Writer, "export AWS_SECRET_ACCESS_KEY=%s\n", cred.SecretKey)
+	actionWarn(c, fmt.Sprintf("S3 credentials of user %q expire at %s - the secret key is shown only once",
+		name, cos.FormatTime(cred.Expires, "")))
+	return nil
+}
+
+func showAuthS3CredHandler(c *cli.Context) error {
+	userID := c.Args().Get(0)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	creds, err := authn.GetS3Creds(authParams, userID)
+	if err != nil {
+		return err
+	}
+	return teb.Print(creds, teb.AuthNS3CredTmpl)
+}
+
+func deleteAuthS3CredHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	userID, accessKey := c.Args().Get(0), c.Args().Get(1)
+	if err := authn.RevokeS3Cred(authParams, userID, accessKey); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Revoked S3 access key %s of user %q", accessKey, userID))
+	return nil
+}
+
 func logoutUserHandler(c *cli.Context) (err error) {
 	tokenFile, err := tokfile(c)
 	if err != nil {
@@ -0,0 +1,88 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais bucket diff`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+func diffBucketHandler(c *cli.Context) error {
+	bckFrom, bckTo, _, err := parseBcks(c, bucketSrcArgument, bucketDstArgument, 0 /*shift*/, false /*optionalSrcObjname*/)
+	if err != nil {
+		return err
+	}
+	if bckFrom.Equal(&bckTo) {
+		return incorrectUsageMsg(c, "cannot compare bucket %q with itself", bckFrom)
+	}
+	msg := &apc.BckDiffMsg{
+		Prefix: parseStrFlag(c, verbObjPrefixFlag),
+		Report: cos.Either(parseStrFlag(c, bckDiffReportFlag), apc.BckDiffReportBck),
+	}
+	xid, err := api.DiffBuckets(apiBP, bckFrom, bckTo, msg)
+	if err != nil {
+		return V(err)
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	_, xname := xact.GetKindName(apc.ActBckDiff)
+	fmt.Fprintf(c.App.Writer, "%s %s vs %s ...\n", xact.Cname(xname, xid), bckFrom.Cname(msg.Prefix), bckTo.Cname(msg.Prefix))
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActBckDiff, Timeout: timeout}
+	if err := waitXact(apiBP, &xargs); err != nil {
+		return err
+	}
+
+	// summarize
+	xs, err := queryXactions(&xargs)
+	if err != nil {
+		return err
+	}
+	var (
+		total   apc.BckDiffStats
+		reports []string
+	)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			st := &apc.BckDiffStats{}
+			if err := cos.MorphMarshal(snap.Ext, st); err != nil {
+				return err
+			}
+			total.Add(st)
+			if st.Report != "" {
+				reports = append(reports, st.Report)
+			}
+		}
+	}
+	sort.Strings(reports)
+
+	fmt.Fprintf(c.App.Writer, "matched:\t%d", total.Matched)
+	if total.Unverified > 0 {
+		fmt.Fprintf(c.App.Writer, " (including %d matched by size only)", total.Unverified)
+	}
+	fmt.Fprintf(c.App.Writer, "\nmissing:\t%d\nextra:\t\t%d\nmismatched:\t%d\n", total.Missing, total.Extra, total.Mismatched)
+	if !total.Differ() {
+		actionDone(c, fmt.Sprintf("%s and %s are identical", bckFrom.Cname(msg.Prefix), bckTo.Cname(msg.Prefix)))
+		return nil
+	}
+	if len(reports) > 0 {
+		fmt.Fprintln(c.App.Writer, "report:")
+		for _, name := range reports {
+			fmt.Fprintf(c.App.Writer, "\tais://%s/%s\n", msg.Report, name)
+		}
+	}
+	return fmt.Errorf("%s and %s differ", bckFrom.Cname(msg.Prefix), bckTo.Cname(msg.Prefix))
+}
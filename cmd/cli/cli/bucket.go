@@ -45,9 +45,14 @@ func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadR
 	return
 }
 
-// Destroy ais buckets
+// Destroy ais buckets (and, with explicit `--destroy-remote`, Cloud buckets)
 func destroyBuckets(c *cli.Context, buckets []cmn.Bck) error {
+	destroyRemote := flagIsSet(c, destroyRemoteFlag)
 	for _, bck := range buckets {
+		if bck.IsCloud() && !destroyRemote {
+			return fmt.Errorf("cannot remove Cloud bucket %s without %s (to remove in-cluster content only, use 'ais evict')",
+				bck.Cname(""), qflprn(destroyRemoteFlag))
+		}
 		empty, errEmp := isBucketEmpty(bck, true /*cached*/)
 		if errEmp == nil && !empty {
 			if !flagIsSet(c, yesFlag) {
@@ -57,7 +62,12 @@ func destroyBuckets(c *cli.Context, buckets []cmn.Bck) error {
 			}
 		}
 
-		err := api.DestroyBucket(apiBP, bck)
+		var err error
+		if bck.IsCloud() {
+			err = api.DestroyCloudBucket(apiBP, bck)
+		} else {
+			err = api.DestroyBucket(apiBP, bck)
+		}
 		if err == nil {
 			fmt.Fprintf(c.App.Writer, "%q destroyed\n", bck.Cname(""))
 			continue
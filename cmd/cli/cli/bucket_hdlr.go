@@ -78,6 +78,7 @@ var (
 		commandRemove: {
 			ignoreErrorFlag,
 			yesFlag,
+			destroyRemoteFlag,
 		},
 		commandCopy: {
 			listFlag,
@@ -95,6 +96,11 @@ var (
 			latestVerFlag,
 			syncFlag,
 		},
+		cmdBckDiff: {
+			verbObjPrefixFlag,
+			bckDiffReportFlag,
+			waitJobXactFinishedFlag,
+		},
 		commandRename: {
 			waitFlag,
 			waitJobXactFinishedFlag,
@@ -197,6 +203,17 @@ var (
 		Action:       copyBucketHandler,
 		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
 	}
+	bucketCmdDiff = cli.Command{
+		Name: cmdBckDiff,
+		Usage: "compare two buckets (either provider, including remote AIS) by object names, sizes, and checksums, e.g.:\n" +
+			indent1 + "\t- 'ais bucket diff s3://abc ais://nnn'\t- verify that all s3://abc objects were copied to ais://nnn, and nothing else;\n" +
+			indent1 + "\t- 'ais bucket diff ais://nnn ais://@remais/mmm --prefix images/'\t- compare virtual subdirectory 'images/' in a local and a remote AIS bucket;\n" +
+			indent1 + "\t- 'ais bucket diff ais://nnn gs://xyz --report diff-reports'\t- store the report (if any differences) in ais://diff-reports",
+		ArgsUsage:    bucketSrcArgument + " " + bucketDstArgument,
+		Flags:        bucketCmdsFlags[cmdBckDiff],
+		Action:       diffBucketHandler,
+		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
+	}
 	bucketCmdRename = cli.Command{
 		Name:         commandRename,
 		Usage:        "rename/move ais bucket",
@@ -235,16 +252,17 @@ var (
 			makeAlias(showCmdBucket, "", true, commandShow), // alias for `ais show`
 			{
 				Name:      commandCreate,
-				Usage:     "create ais buckets",
+				Usage:     "create ais buckets or, with a Cloud provider (e.g., s3://), create Cloud buckets",
 				ArgsUsage: bucketsArgument,
 				Flags:     bucketCmdsFlags[commandCreate],
 				Action:    createBucketHandler,
 			},
 			bucketCmdCopy,
+			bucketCmdDiff,
 			bucketCmdRename,
 			{
 				Name:      commandRemove,
-				Usage:     "remove ais buckets",
+				Usage:     "remove ais buckets (and, with '--destroy-remote', destroy empty Cloud buckets)",
 				ArgsUsage: bucketsArgument,
 				Flags:     bucketCmdsFlags[commandRemove],
 				Action:    removeBucketHandler,
@@ -480,10 +480,14 @@ func bpropsFilterExtra(c *cli.Context, tag string) bool {
 	switch c.Args().Get(0) {
 	case apc.S3Scheme, apc.AWS:
 		return strings.HasPrefix(tag, "extra.aws")
+	case apc.GSScheme, apc.GCP:
+		return strings.HasPrefix(tag, "extra.gcp")
 	case apc.HTTP:
 		return strings.HasPrefix(tag, "extra.http")
 	case apc.HDFS:
 		return strings.HasPrefix(tag, "extra.hdfs")
+	case apc.POSIX:
+		return strings.HasPrefix(tag, "extra.posix")
 	}
 	return false
 }
@@ -831,6 +835,9 @@ func suggestTargetMpath(c *cli.Context, cmd string) {
 			for _, mpath := range mpl.WaitingDD {
 				fmt.Println(mpath)
 			}
+			for _, mpath := range mpl.ReadOnly {
+				fmt.Println(mpath)
+			}
 		case cmdMpathDetach:
 			for _, mpath := range mpl.Available {
 				fmt.Println(mpath)
@@ -842,6 +849,12 @@ func suggestTargetMpath(c *cli.Context, cmd string) {
 			for _, mpath := range mpl.Available {
 				fmt.Println(mpath)
 			}
+		case cmdMpathRdonly:
+			for _, mpath := range mpl.Available {
+				if !cos.StringInSlice(mpath, mpl.ReadOnly) {
+					fmt.Println(mpath)
+				}
+			}
 		}
 	}
 }
@@ -27,6 +27,7 @@ const (
 	commandJob      = "job"
 	commandLog      = "log"
 	commandPerf     = "performance"
+	commandTop      = "top"
 	commandStorage  = "storage"
 	commandETL      = apc.ETL   // TODO: add `ais show etl`
 	commandAlias    = "alias"   // TODO: ditto alias
@@ -54,20 +55,25 @@ const (
 // - show subcommands (`show <what>`)
 // - 3rd level subcommands
 const (
-	commandCat       = "cat"
-	commandConcat    = "concat"
-	commandCopy      = "cp"
-	commandCreate    = "create"
-	commandGet       = "get"
-	commandList      = "ls"
-	commandSetCustom = "set-custom"
-	commandPut       = "put"
-	commandRemove    = "rm"
-	commandRename    = "mv"
-	commandSet       = "set"
-	commandStart     = apc.ActXactStart
-	commandStop      = apc.ActXactStop
-	commandWait      = "wait"
+	commandCat        = "cat"
+	commandConcat     = "concat"
+	commandPresign    = "presign"
+	commandCopy       = "cp"
+	commandCreate     = "create"
+	commandGet        = "get"
+	commandList       = "ls"
+	commandSetCustom  = "set-custom"
+	commandPut        = "put"
+	commandSync       = "sync"
+	commandRemove     = "rm"
+	commandRename     = "mv"
+	commandSet        = "set"
+	commandStart      = apc.ActXactStart
+	commandStop       = apc.ActXactStop
+	commandPause      = apc.ActXactPause
+	commandResume     = apc.ActXactResume
+	commandPrioritize = "prioritize"
+	commandWait       = "wait"
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
@@ -96,6 +102,7 @@ const (
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
 	cmdStgValidate  = "validate"
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
+	cmdBckDiff      = "diff"    // display name for apc.ActBckDiff
 
 	cmdCluster    = commandCluster
 	cmdNode       = "node"
@@ -123,6 +130,7 @@ const (
 	cmdMpathEnable  = "enable"
 	cmdMpathDetach  = cmdDetach
 	cmdMpathDisable = "disable"
+	cmdMpathRdonly  = "readonly"
 
 	// Node subcommands
 	cmdJoin                = "join"
@@ -156,6 +164,7 @@ const (
 	cmdAuthRole    = "role"
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
+	cmdAuthS3Cred  = "s3-cred"
 	cmdAuthConfig  = cmdConfig
 
 	// K8s subcommans
@@ -264,6 +273,7 @@ const (
 	optionalPrefixArgument = "BUCKET[/OBJECT_NAME_or_PREFIX]"
 	putObjectArgument      = "[-|FILE|DIRECTORY[/PATTERN]] " + optionalPrefixArgument
 	promoteObjectArgument  = "FILE|DIRECTORY[/PATTERN] " + optionalPrefixArgument
+	syncObjectArgument     = "DIRECTORY " + optionalPrefixArgument + " | " + optionalPrefixArgument + " DIRECTORY"
 
 	shardArgument         = "BUCKET/SHARD_NAME"
 	optionalShardArgument = "BUCKET[/SHARD_NAME]"
@@ -323,6 +333,7 @@ const (
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	deleteAuthS3CredArgument  = "USER_NAME ACCESS_KEY"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -358,6 +369,7 @@ var (
 	//
 	allPropsFlag        = cli.BoolFlag{Name: scopeAll, Usage: "all object properties including custom (user-defined)"}
 	allJobsFlag         = cli.BoolFlag{Name: scopeAll, Usage: "all jobs, including finished and aborted"}
+	queuedJobsFlag      = cli.BoolFlag{Name: "queued", Usage: "show jobs that are queued (by the primary) waiting for conflicts to clear"}
 	allRunningJobsFlag  = cli.BoolFlag{Name: scopeAll, Usage: "all running jobs"}
 	allFinishedJobsFlag = cli.BoolFlag{Name: scopeAll, Usage: "all finished jobs"}
 	rmrfFlag            = cli.BoolFlag{Name: scopeAll, Usage: "remove all objects (use it with extreme caution!)"}
@@ -713,6 +725,10 @@ var (
 		Name:  "skip-lookup",
 		Usage: "skip checking source and destination buckets' existence (trading off extra lookup for performance)\n",
 	}
+	destroyRemoteFlag = cli.BoolFlag{
+		Name:  "destroy-remote",
+		Usage: "when removing Cloud bucket, destroy the bucket itself (must be empty) - not only its in-cluster content and metadata",
+	}
 	dontHeadRemoteFlag = cli.BoolFlag{
 		Name: "skip-lookup",
 		Usage: "do not execute HEAD(bucket) request to lookup remote bucket and its properties; possible usage scenarios include:\n" +
@@ -751,6 +767,12 @@ var (
 			"(as seen from the target)",
 	}
 
+	promoteAgentFlag = cli.StringFlag{
+		Name: "agent",
+		Usage: "comma-separated list of promote agent URLs, e.g.: 'http://gpu-node-01:51081,http://gpu-node-02:51081';\n" +
+			indent4 + "\tthe source is a file or directory on the machine(s) running promote agent (see 'aisprm')",
+	}
+
 	yesFlag = cli.BoolFlag{Name: "yes,y", Usage: "assume 'yes' to all questions"}
 
 	chunkSizeFlag = cli.StringFlag{
@@ -763,8 +785,31 @@ var (
 		Usage: "number of concurrent blob-downloading workers (readers); system default when omitted or zero",
 	}
 
+	// parallel (multi-range) GET
+	getNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "number of concurrent range readers to GET a (large) object in parallel;\n" +
+			indent4 + "\tzero or one (default): single-stream GET; range size is controlled via '--chunk-size'",
+	}
+
 	cksumFlag = cli.BoolFlag{Name: "checksum", Usage: "validate checksum"}
 
+	// sync
+	syncCksumFlag = cli.BoolFlag{
+		Name: cksumFlag.Name,
+		Usage: "compare checksums of same-size files and objects (and ignore modification times);\n" +
+			indent4 + "\tslower but detects changes that preserve size and mtime",
+	}
+	syncDeleteFlag = cli.BoolFlag{
+		Name:  "delete",
+		Usage: "delete destination objects (or files) that do not exist in the source",
+	}
+
+	bckDiffReportFlag = cli.StringFlag{
+		Name:  "report",
+		Usage: "name of the ais:// bucket to store the report listing missing, extra, and mismatched objects (default: '" + apc.BckDiffReportBck + "')",
+	}
+
 	putObjCksumText     = indent4 + "\tand provide it as part of the PUT request for subsequent validation on the server side"
 	putObjCksumFlags    = initPutObjCksumFlags()
 	putObjDfltCksumFlag = cli.BoolFlag{
@@ -845,6 +890,19 @@ var (
 		Value: 24 * time.Hour,
 	}
 
+	// S3 presigned URL
+	presignMethodFlag = cli.StringFlag{
+		Name:  "method",
+		Usage: "HTTP method the URL is presigned for: GET, HEAD, or PUT",
+		Value: "GET",
+	}
+	presignExpireFlag = DurationFlag{
+		Name: expireFlag.Name,
+		Usage: "URL expiration time (up to 7 days);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Hour,
+	}
+
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
 		Name:  "dry-run",
@@ -921,6 +979,11 @@ var (
 		Name:  "no-resilver",
 		Usage: "do _not_ resilver data off of the mountpaths that are being disabled or detached",
 	}
+	mpathLabelFlag = cli.StringFlag{
+		Name: "label",
+		Usage: "mountpath label, e.g. 'nvme' or 'hdd'\n" +
+			indent4 + "\t(buckets with the corresponding 'storage_class' property will only place objects on mountpaths so labeled)",
+	}
 	noShutdownFlag = cli.BoolFlag{
 		Name:  "no-shutdown",
 		Usage: "do not shutdown node upon decommissioning it from the cluster",
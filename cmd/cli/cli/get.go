@@ -49,6 +49,9 @@ func getHandler(c *cli.Context) error {
 	if flagIsSet(c, lengthFlag) != flagIsSet(c, offsetFlag) {
 		return fmt.Errorf("%s and %s must be both present (or not)", qflprn(lengthFlag), qflprn(offsetFlag))
 	}
+	if flagIsSet(c, getNumWorkersFlag) && flagIsSet(c, lengthFlag) {
+		return fmt.Errorf(errFmtExclusive, qflprn(getNumWorkersFlag), qflprn(lengthFlag))
+	}
 	if flagIsSet(c, latestVerFlag) {
 		if flagIsSet(c, headObjPresentFlag) {
 			return fmt.Errorf(errFmtExclusive, qflprn(latestVerFlag), qflprn(headObjPresentFlag))
@@ -122,6 +125,9 @@ func getHandler(c *cli.Context) error {
 			return fmt.Errorf("read range (%s, %s) of archived files (%s) is not implemented yet",
 				qflprn(lengthFlag), qflprn(offsetFlag), qflprn(archpathGetFlag))
 		}
+		if flagIsSet(c, getNumWorkersFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(getNumWorkersFlag), qflprn(archpathGetFlag))
+		}
 	}
 
 	// GET multiple -- currently, only prefix (TODO: list/range)
@@ -431,7 +437,21 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 	}
 
 	// do
-	if flagIsSet(c, cksumFlag) {
+	if numWorkers := parseIntFlag(c, getNumWorkersFlag); numWorkers > 1 && archpath == "" && !bck.IsHTTP() {
+		pgetArgs := &api.PgetArgs{
+			Writer:        getArgs.Writer,
+			Query:         getArgs.Query,
+			NumWorkers:    numWorkers,
+			ValidateCksum: flagIsSet(c, cksumFlag),
+		}
+		if pgetArgs.ChunkSize, err = parseSizeFlag(c, chunkSizeFlag, units); err != nil {
+			return err
+		}
+		if file, ok := getArgs.Writer.(*os.File); ok && outFile != fileStdIO {
+			pgetArgs.WriterAt = file
+		}
+		oah, err = api.GetObjectParallel(apiBP, bck, objName, pgetArgs)
+	} else if flagIsSet(c, cksumFlag) {
 		oah, err = api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
 	} else {
 		oah, err = api.GetObject(apiBP, bck, objName, &getArgs)
@@ -9,11 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -47,6 +49,9 @@ var (
 	jobSub = []cli.Command{
 		jobStartSub,
 		jobStopSub,
+		jobPauseSub,
+		jobResumeSub,
+		jobPrioritizeSub,
 		jobWaitSub,
 		jobRemoveSub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
@@ -194,6 +199,42 @@ var (
 	}
 )
 
+// ais job pause | resume
+var (
+	jobPauseSub = cli.Command{
+		Name: commandPause,
+		Usage: "pause a long-running bucket job (e.g., copy-bucket, prefetch, mirror), e.g.:\n" +
+			indent1 + "\t- 'pause tcb-cysbohAGL'\t- pause a given job identified by its unique ID;\n" +
+			indent1 + "\t- 'pause copy-bucket'\t- pause all running bucket-to-bucket copies.\n" +
+			indent1 + "Paused jobs checkpoint their progress, so that (unlike stopping) a subsequent resume -\n" +
+			indent1 + "or the next run of the same job after node restart - will continue from where it left off",
+		ArgsUsage:    jobAnyArg,
+		Action:       pauseJobHandler,
+		BashComplete: runningJobCompletions,
+	}
+	jobResumeSub = cli.Command{
+		Name:         commandResume,
+		Usage:        "resume previously paused job or jobs",
+		ArgsUsage:    jobAnyArg,
+		Action:       resumeJobHandler,
+		BashComplete: runningJobCompletions,
+	}
+)
+
+// ais job prioritize
+var (
+	jobPrioritizeSub = cli.Command{
+		Name: commandPrioritize,
+		Usage: "change the priority of a queued job (higher-priority jobs start first), e.g.:\n" +
+			indent1 + "\t- 'prioritize Q3q8tPyrb 10'\t- move a given queued job ahead of all jobs with priority less than 10.\n" +
+			indent1 + "Jobs get queued when they cannot start due to conflicts with other running jobs (e.g., rebalance),\n" +
+			indent1 + "and only if the cluster feature flag 'Queue-LimitedCoexistence-Conflicts' is set;\n" +
+			indent1 + "use 'ais show job --queued' to list and 'ais stop JOB_ID' to cancel queued jobs",
+		ArgsUsage: jobIDArgument + " PRIORITY",
+		Action:    prioritizeJobHandler,
+	}
+)
+
 // ais wait
 var (
 	waitCmdsFlags = []cli.Flag{
@@ -788,6 +829,15 @@ func stopJobHandler(c *cli.Context) error {
 		return stopXactionKindOrAll(c, xactKind, xname, bck)
 	}
 
+	// queued (not yet started)
+	if queued := findQueuedJob(xactID); queued != nil {
+		if err := api.AbortXaction(apiBP, &xact.ArgsMsg{ID: xactID}); err != nil {
+			return V(err)
+		}
+		actionDone(c, fmt.Sprintf("Canceled queued %s job %s\n", queued.Kind, xactID))
+		return nil
+	}
+
 	// query
 	msg := formatXactMsg(xactID, xname, bck)
 	xargs := xact.ArgsMsg{ID: xactID, Kind: xactKind}
@@ -829,6 +879,100 @@ func stopJobHandler(c *cli.Context) error {
 	return nil
 }
 
+//
+// job queue
+//
+
+func prioritizeJobHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	jobID := c.Args().Get(0)
+	priority, err := strconv.Atoi(c.Args().Get(1))
+	if err != nil {
+		return incorrectUsageMsg(c, "invalid priority %q: %v", c.Args().Get(1), err)
+	}
+	if err := api.PrioritizeJob(apiBP, jobID, priority); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Queued job %s: priority set to %d", jobID, priority))
+	return nil
+}
+
+func findQueuedJob(jobID string) *xact.QueuedJob {
+	jobs, err := api.GetQueuedJobs(apiBP)
+	if err != nil {
+		return nil
+	}
+	for _, job := range jobs {
+		if job.ID == jobID {
+			return job
+		}
+	}
+	return nil
+}
+
+func showQueuedJobs(c *cli.Context) error {
+	jobs, err := api.GetQueuedJobs(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if len(jobs) == 0 && !flagIsSet(c, jsonFlag) {
+		fmt.Fprintln(c.App.Writer, "No queued jobs.")
+		return nil
+	}
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(jobs, teb.QueuedJobsNoHdrTmpl, teb.Jopts(flagIsSet(c, jsonFlag)))
+	}
+	return teb.Print(jobs, teb.QueuedJobsTmpl, teb.Jopts(flagIsSet(c, jsonFlag)))
+}
+
+//
+// job pause | resume
+//
+
+func pauseJobHandler(c *cli.Context) error  { return pauseResume(c, true) }
+func resumeJobHandler(c *cli.Context) error { return pauseResume(c, false) }
+
+func pauseResume(c *cli.Context, pause bool) error {
+	name, xid, _, bck, err := jobArgs(c, 0, true /*ignore daemonID*/)
+	if err != nil {
+		return err
+	}
+	if name == "" && xid == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if name == "" {
+		name, _ = xid2Name(xid)
+	}
+	var xactKind, xname string
+	if name != "" {
+		xactKind, xname = xact.GetKindName(name)
+		if xactKind == "" {
+			return incorrectUsageMsg(c, "unrecognized or misplaced option '%s'", name)
+		}
+		if !xact.Table[xactKind].Pausable {
+			return fmt.Errorf("%q jobs cannot be paused (not supported)", xname)
+		}
+	}
+	var (
+		args = xact.ArgsMsg{ID: xid, Kind: xactKind, Bck: bck}
+		msg  = formatXactMsg(xid, xname, bck)
+	)
+	if pause {
+		if err := api.PauseXaction(apiBP, &args); err != nil {
+			return V(err)
+		}
+		actionDone(c, "Paused "+msg)
+		return nil
+	}
+	if err := api.ResumeXaction(apiBP, &args); err != nil {
+		return V(err)
+	}
+	actionDone(c, "Resumed "+msg)
+	return nil
+}
+
 // NOTE: the '--all' case when both (xactKind == "" && xname == "") - is also handled here
 // TODO: aistore supports `bck` for additional filtering (NIY)
 func stopXactionKindOrAll(c *cli.Context, xactKind, xname string, bck cmn.Bck) error {
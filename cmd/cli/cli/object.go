@@ -40,6 +40,15 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 		OverwriteDst:   flagIsSet(c, overwriteFlag),
 		DeleteSrc:      flagIsSet(c, deleteSrcFlag),
 	}
+	if flagIsSet(c, promoteAgentFlag) {
+		if target != "" {
+			return incorrectUsageMsg(c, "%s and %s cannot be used together", qflprn(promoteAgentFlag), qflprn(targetIDFlag))
+		}
+		args.SrcFQN = ""
+		for _, agent := range splitCsv(parseStrFlag(c, promoteAgentFlag)) {
+			args.Sources = append(args.Sources, apc.PromoteSrc{Agent: agent, Path: fqn})
+		}
+	}
 	xid, err := api.Promote(apiBP, bck, &args)
 	if err != nil {
 		return V(err)
@@ -5,7 +5,9 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -36,6 +38,8 @@ var (
 			offsetFlag,
 			lengthFlag,
 			cksumFlag,
+			getNumWorkersFlag,
+			chunkSizeFlag,
 			yesFlag,
 			headObjPresentFlag,
 			latestVerFlag,
@@ -70,15 +74,29 @@ var (
 			// append
 			appendConcatFlag,
 		),
+		commandSync: {
+			syncCksumFlag,
+			syncDeleteFlag,
+			concurrencyFlag,
+			dryRunFlag,
+			progressFlag,
+			verboseFlag,
+			yesFlag,
+		},
 		commandSetCustom: {
 			setNewCustomMDFlag,
 		},
+		commandPresign: {
+			presignMethodFlag,
+			presignExpireFlag,
+		},
 		commandPromote: {
 			recursFlag,
 			overwriteFlag,
 			notFshareFlag,
 			deleteSrcFlag,
 			targetIDFlag,
+			promoteAgentFlag,
 			verboseFlag,
 		},
 		commandConcat: {
@@ -144,12 +162,27 @@ var (
 			indent1 + "\t- 'promote /tmp/subdir/f3 ais://nnn/aaa/'\t - ais://nnn/aaa/f3\n" +
 			indent1 + "\t- 'promote /tmp/subdir ais://nnn'\t - ais://nnn/f1, ais://nnn/f2, ais://nnn/f3\n" +
 			indent1 + "\t- 'promote /tmp/subdir ais://nnn/aaa/'\t - ais://nnn/aaa/f1, ais://nnn/aaa/f2, ais://nnn/aaa/f3\n" +
+			indent1 + "With '--agent', promote files and directories from (non-cluster) machines running promote agent, e.g.:\n" +
+			indent1 + "\t- 'promote /nvme/data ais://nnn -r --agent http://gpu-node-01:51081'\n" +
 			indent1 + "Other supported options follow below.",
 		ArgsUsage:    promoteObjectArgument,
 		Flags:        objectCmdsFlags[commandPromote],
 		Action:       promoteHandler,
 		BashComplete: putPromApndCompletions,
 	}
+	objectCmdSync = cli.Command{
+		Name: commandSync,
+		Usage: "synchronize local directory and bucket (or virtual directory), in either direction:\n" +
+			indent1 + "\t- 'sync /tmp/data ais://nnn/data'\t- upload new and changed files;\n" +
+			indent1 + "\t- 'sync ais://nnn/data /tmp/data'\t- download new and changed objects;\n" +
+			indent1 + "files and objects differ when their sizes or modification times (or checksums, with '--checksum') differ;\n" +
+			indent1 + "use '--delete' to also remove destination files (or objects) that are not present in the source,\n" +
+			indent1 + "and '--dry-run' to see what would be done.",
+		ArgsUsage:    syncObjectArgument,
+		Flags:        objectCmdsFlags[commandSync],
+		Action:       syncHandler,
+		BashComplete: putPromApndCompletions,
+	}
 	objectCmdConcat = cli.Command{
 		Name: commandConcat,
 		Usage: "append a file, a directory, or multiple files and/or directories\n" +
@@ -168,6 +201,19 @@ var (
 		Action:    setCustomPropsHandler,
 	}
 
+	objectCmdPresign = cli.Command{
+		Name: commandPresign,
+		Usage: "generate S3 presigned URL - a time-limited link to GET, HEAD, or PUT the specified object, e.g.:\n" +
+			indent1 + "\t- 'presign ais://nnn/obj --expire 30m'\t- URL to read ais://nnn/obj during the next 30 minutes;\n" +
+			indent1 + "\t- 'presign ais://nnn/obj --method PUT'\t- URL to write (upload) the object.\n" +
+			indent1 + "S3 credentials are taken from the environment: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY\n" +
+			indent1 + "(see 'ais auth add s3-cred --help')",
+		ArgsUsage:    objectArgument,
+		Flags:        objectCmdsFlags[commandPresign],
+		Action:       presignHandler,
+		BashComplete: bucketCompletions(bcmplop{separator: true}),
+	}
+
 	objectCmdPrefetch = cli.Command{
 		Name:         commandPrefetch,
 		Usage:        prefetchUsage,
@@ -200,9 +246,11 @@ var (
 			bucketsObjectsCmdList,
 			objectCmdPut,
 			objectCmdPromote,
+			objectCmdSync,
 			makeAlias(bucketCmdCopy, "", true, commandCopy), // alias for `ais [bucket] cp`
 			objectCmdConcat,
 			objectCmdSetCustom,
+			objectCmdPresign,
 			objectCmdRemove,
 			objectCmdPrefetch,
 			bucketObjCmdEvict,
@@ -455,6 +503,36 @@ func promoteHandler(c *cli.Context) (err error) {
 	return promote(c, bck, objName, fqn)
 }
 
+func presignHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	method := strings.ToUpper(parseStrFlag(c, presignMethodFlag))
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut:
+	default:
+		return incorrectUsageMsg(c, "invalid %s %q (expecting GET, HEAD, or PUT)", qflprn(presignMethodFlag), method)
+	}
+	creds := &cmn.S3Creds{
+		AccessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+	}
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return errors.New("missing S3 credentials: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables must be set\n" +
+			"(tip: use 'ais auth add s3-cred' to issue credentials)")
+	}
+	u, err := api.PresignS3(apiBP, bck, objName, method, creds, parseDurationFlag(c, presignExpireFlag))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, u)
+	return nil
+}
+
 func setCustomPropsHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -47,6 +47,7 @@ var (
 			longRunFlags,
 			jsonFlag,
 			allJobsFlag,
+			queuedJobsFlag,
 			regexJobsFlag,
 			noHeaderFlag,
 			verboseJobFlag,
@@ -206,6 +207,9 @@ var (
 // - be omitted, in part or in total, and may
 // - come in arbitrary order
 func showJobsHandler(c *cli.Context) error {
+	if flagIsSet(c, queuedJobsFlag) {
+		return showQueuedJobs(c)
+	}
 	name, xid, daemonID, bck, err := jobArgs(c, 0, false /*ignore daemonID*/)
 	if err != nil {
 		return err
@@ -47,6 +47,7 @@ var (
 	mpathCmdsFlags = map[string][]cli.Flag{
 		cmdMpathAttach: {
 			forceFlag,
+			mpathLabelFlag,
 		},
 		cmdMpathEnable: {},
 		cmdMpathDetach: {
@@ -55,6 +56,7 @@ var (
 		cmdMpathDisable: {
 			noResilverFlag,
 		},
+		cmdMpathRdonly: {},
 	}
 
 	mpathCmd = cli.Command{
@@ -95,6 +97,15 @@ var (
 				Action:       mpathDisableHandler,
 				BashComplete: func(c *cli.Context) { suggestTargetMpath(c, cmdMpathDisable) },
 			},
+			{
+				Name: cmdMpathRdonly,
+				Usage: "make mountpath read-only: keep serving reads while placing new writes elsewhere\n" +
+					indent1 + "and gradually draining it (hint: to revert, use 'enable')",
+				ArgsUsage:    nodeMountpathPairArgument,
+				Flags:        mpathCmdsFlags[cmdMpathRdonly],
+				Action:       mpathReadOnlyHandler,
+				BashComplete: func(c *cli.Context) { suggestTargetMpath(c, cmdMpathRdonly) },
+			},
 		},
 	}
 )
@@ -586,6 +597,9 @@ func mpathAttachHandler(c *cli.Context) (err error)  { return mpathAction(c, apc
 func mpathEnableHandler(c *cli.Context) (err error)  { return mpathAction(c, apc.ActMountpathEnable) }
 func mpathDetachHandler(c *cli.Context) (err error)  { return mpathAction(c, apc.ActMountpathDetach) }
 func mpathDisableHandler(c *cli.Context) (err error) { return mpathAction(c, apc.ActMountpathDisable) }
+func mpathReadOnlyHandler(c *cli.Context) (err error) {
+	return mpathAction(c, apc.ActMountpathReadOnly)
+}
 
 func mpathAction(c *cli.Context, action string) error {
 	if c.NArg() == 0 {
@@ -628,7 +642,7 @@ func mpathAction(c *cli.Context, action string) error {
 		switch action {
 		case apc.ActMountpathAttach:
 			acted = "attached"
-			err = api.AttachMountpath(apiBP, si, mountpath, flagIsSet(c, forceFlag))
+			err = api.AttachLabeledMountpath(apiBP, si, mountpath, parseStrFlag(c, mpathLabelFlag), flagIsSet(c, forceFlag))
 		case apc.ActMountpathEnable:
 			acted = "enabled"
 			err = api.EnableMountpath(apiBP, si, mountpath)
@@ -638,6 +652,9 @@ func mpathAction(c *cli.Context, action string) error {
 		case apc.ActMountpathDisable:
 			acted = "disabled"
 			err = api.DisableMountpath(apiBP, si, mountpath, flagIsSet(c, noResilverFlag))
+		case apc.ActMountpathReadOnly:
+			acted = "made read-only"
+			err = api.ReadOnlyMountpath(apiBP, si, mountpath)
 		default:
 			return incorrectUsageMsg(c, "invalid mountpath action %q", action)
 		}
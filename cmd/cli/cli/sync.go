@@ -0,0 +1,462 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais sync` (bidirectional directory-to-bucket synchronization).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+	"github.com/vbauerster/mpb/v4"
+)
+
+// Compare local directory with bucket[/prefix] and transfer only the differences.
+// Files and objects are matched by their relative names (object name = prefix + relative path).
+// They differ when:
+// - sizes differ, or
+// - with '--checksum': checksums differ (the bucket's checksum type, computed locally), or
+// - otherwise: modification times differ, whereby object's "mtime" is the mtime of the file
+//   it was uploaded from (stored in the object's custom metadata - see syncMtimeMD);
+//   objects that were not uploaded by `ais sync` have no mtime - in which case checksums
+//   are compared (if available).
+// Downloaded files get their objects' mtimes, so that the next sync finds them unchanged.
+
+const (
+	syncTmpSuffix = ".ais-sync.tmp"
+	syncMtimeMD   = "sync-mtime" // custom metadata: source file's mtime (unix nanoseconds)
+
+	// (filesystems may have coarser timestamp resolution)
+	syncMtimeWindow = int64(time.Second)
+)
+
+type (
+	syncEntry struct {
+		name  string // relative name (object name minus prefix)
+		path  string // local file
+		cksum string // object's checksum value
+		mtime int64  // unix nanoseconds; zero when unknown
+		size  int64
+	}
+	syncCtx struct {
+		c        *cli.Context
+		dir      string
+		bck      cmn.Bck
+		prefix   string
+		cksumTy  string // bucket's checksum type (or MD5 for Cloud buckets)
+		local    map[string]*syncEntry
+		remote   map[string]*syncEntry
+		transfer []*syncEntry // upload or download
+		remove   []*syncEntry // delete at destination
+		// progress
+		progress *mpb.Progress
+		barObjs  *mpb.Bar
+		barSize  *mpb.Bar
+		errSb    strings.Builder
+		mu       sync.Mutex
+		errCount atomic.Int32
+		upload   bool
+		cksum    bool // '--checksum'
+		verbose  bool
+	}
+)
+
+func syncHandler(c *cli.Context) (err error) {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, "source", "destination")
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "too many arguments (expecting source and destination)")
+	}
+	var (
+		src, dst = c.Args().Get(0), c.Args().Get(1)
+		uri, dir string
+		ctx      = &syncCtx{c: c, cksum: flagIsSet(c, syncCksumFlag), verbose: flagIsSet(c, verboseFlag)}
+	)
+	switch {
+	case strings.Contains(src, apc.BckProviderSeparator) && !strings.Contains(dst, apc.BckProviderSeparator):
+		uri, dir = src, dst
+	case strings.Contains(dst, apc.BckProviderSeparator) && !strings.Contains(src, apc.BckProviderSeparator):
+		uri, dir, ctx.upload = dst, src, true
+	default:
+		return incorrectUsageMsg(c, "expecting local directory and bucket (in either order), got %q and %q", src, dst)
+	}
+	if ctx.bck, ctx.prefix, err = parseBckObjURI(c, uri, true /*emptyObjnameOK*/); err != nil {
+		return err
+	}
+	if ctx.prefix != "" && !cos.IsLastB(ctx.prefix, '/') {
+		ctx.prefix += "/" // virtual directory
+	}
+	ctx.dir = filepath.Clean(dir)
+
+	if ctx.upload {
+		finfo, err := os.Stat(ctx.dir)
+		if err != nil {
+			return &errDoesNotExist{what: "directory", name: ctx.dir}
+		}
+		if !finfo.IsDir() {
+			return fmt.Errorf("%q is not a directory", ctx.dir)
+		}
+	}
+	bprops, err := headBucket(ctx.bck, false /*don't add*/)
+	if err != nil {
+		return err
+	}
+	ctx.cksumTy = bprops.Cksum.Type
+	if ctx.bck.IsCloud() {
+		ctx.cksumTy = cos.ChecksumMD5 // (ETag)
+	}
+	if ctx.cksumTy == cos.ChecksumNone {
+		if ctx.cksum {
+			actionWarn(c, fmt.Sprintf("%s: checksums are disabled - comparing sizes and modification times", ctx.bck.Cname("")))
+		}
+		ctx.cksumTy, ctx.cksum = "", false
+	}
+
+	if ctx.local, err = ctx.lsLocal(); err != nil {
+		return err
+	}
+	if ctx.remote, err = ctx.lsRemote(); err != nil {
+		return err
+	}
+	if err = ctx.diff(); err != nil {
+		return err
+	}
+	return ctx.do()
+}
+
+/////////////
+// syncCtx //
+/////////////
+
+func (ctx *syncCtx) lsLocal() (map[string]*syncEntry, error) {
+	entries := make(map[string]*syncEntry, 64)
+	if !ctx.upload {
+		if _, err := os.Stat(ctx.dir); os.IsNotExist(err) {
+			return entries, nil // will be created
+		}
+	}
+	err := filepath.WalkDir(ctx.dir, func(path string, de fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+		if !de.Type().IsRegular() || strings.HasSuffix(path, syncTmpSuffix) {
+			return nil
+		}
+		finfo, err := de.Info()
+		if err != nil {
+			return nil // (removed in the meantime)
+		}
+		name := filepath.ToSlash(trimPrefix(path, ctx.dir))
+		entries[name] = &syncEntry{name: name, path: path, size: finfo.Size(), mtime: finfo.ModTime().UnixNano()}
+		return nil
+	})
+	return entries, err
+}
+
+func (ctx *syncCtx) lsRemote() (map[string]*syncEntry, error) {
+	msg := &apc.LsoMsg{Prefix: ctx.prefix}
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsCustom)
+	lst, err := api.ListObjects(apiBP, ctx.bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, V(err)
+	}
+	entries := make(map[string]*syncEntry, len(lst.Entries))
+	for _, e := range lst.Entries {
+		if e.Flags&apc.EntryIsDir != 0 {
+			continue
+		}
+		name := strings.TrimPrefix(e.Name, ctx.prefix)
+		entries[name] = &syncEntry{name: name, size: e.Size, cksum: e.Checksum, mtime: syncMtime(e.Custom)}
+	}
+	return entries, nil
+}
+
+// compute what to transfer and what to delete
+func (ctx *syncCtx) diff() error {
+	srcs, dsts := ctx.remote, ctx.local
+	if ctx.upload {
+		srcs, dsts = ctx.local, ctx.remote
+	}
+	for name, src := range srcs {
+		dst, ok := dsts[name]
+		if !ok {
+			ctx.transfer = append(ctx.transfer, src)
+			continue
+		}
+		changed, err := ctx.changed(src, dst)
+		if err != nil {
+			return err
+		}
+		if changed {
+			ctx.transfer = append(ctx.transfer, src)
+		}
+	}
+	if flagIsSet(ctx.c, syncDeleteFlag) {
+		for name, dst := range dsts {
+			if _, ok := srcs[name]; !ok {
+				ctx.remove = append(ctx.remove, dst)
+			}
+		}
+	}
+	sort.Slice(ctx.transfer, func(i, j int) bool { return ctx.transfer[i].name < ctx.transfer[j].name })
+	sort.Slice(ctx.remove, func(i, j int) bool { return ctx.remove[i].name < ctx.remove[j].name })
+	return nil
+}
+
+func (ctx *syncCtx) changed(src, dst *syncEntry) (bool, error) {
+	if src.size != dst.size {
+		return true, nil
+	}
+	lcl, obj := src, dst
+	if !ctx.upload {
+		lcl, obj = dst, src
+	}
+	if ctx.cksum || obj.mtime == 0 {
+		// (e.g., multipart ETag)
+		if ctx.cksumTy != "" && obj.cksum != "" && !strings.Contains(obj.cksum, cmn.AwsMultipartDelim) {
+			v, err := fileCksum(lcl.path, ctx.cksumTy)
+			if err != nil {
+				return false, err
+			}
+			return v != obj.cksum, nil
+		}
+		if obj.mtime == 0 {
+			return false, nil // same size, nothing else to compare
+		}
+	}
+	d := src.mtime - dst.mtime
+	return d >= syncMtimeWindow || d <= -syncMtimeWindow, nil
+}
+
+// parse object's custom metadata (see cmn.CustomMD2S) for the source file's mtime
+func syncMtime(custom string) int64 {
+	v, ok := cmn.S2CustomVal(custom, syncMtimeMD)
+	if !ok {
+		return 0
+	}
+	mtime, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || mtime < 0 {
+		return 0
+	}
+	return mtime
+}
+
+// local destination of a given object; the name must not resolve outside the directory
+func syncPath(dir, name string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return "", fmt.Errorf("object name %q resolves outside destination directory %q", name, dir)
+	}
+	return filepath.Join(dir, filepath.FromSlash(name)), nil
+}
+
+func fileCksum(path, ty string) (string, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	cksum := cos.NewCksumHash(ty)
+	_, err = io.Copy(cksum.H, fh)
+	fh.Close()
+	if err != nil {
+		return "", err
+	}
+	cksum.Finalize()
+	return cksum.Value(), nil
+}
+
+func (ctx *syncCtx) do() error {
+	var (
+		c     = ctx.c
+		verb  = "download"
+		dst   = ctx.dir
+		size  int64
+		cptn  string
+		total = len(ctx.transfer) + len(ctx.remove)
+	)
+	if ctx.upload {
+		verb, dst = "upload", ctx.bck.Cname(ctx.prefix)
+	}
+	for _, e := range ctx.transfer {
+		size += e.size
+	}
+	if total == 0 {
+		n := len(ctx.remote)
+		if ctx.upload {
+			n = len(ctx.local)
+		}
+		actionDone(c, fmt.Sprintf("%s is up to date (%d file%s)", dst, n, cos.Plural(n)))
+		return nil
+	}
+	cptn = fmt.Sprintf("%s %d file%s (%s)", verb, len(ctx.transfer), cos.Plural(len(ctx.transfer)), cos.ToSizeIEC(size, 2))
+	if len(ctx.remove) > 0 {
+		cptn += fmt.Sprintf(", delete %d", len(ctx.remove))
+	}
+	cptn += " => " + dst
+
+	if flagIsSet(c, dryRunFlag) {
+		actionCptn(c, dryRunHeader()+" ", cptn)
+		for _, e := range ctx.transfer {
+			fmt.Fprintf(c.App.Writer, "%s %s\n", verb, ctx.srcName(e))
+		}
+		for _, e := range ctx.remove {
+			fmt.Fprintf(c.App.Writer, "delete %s\n", ctx.dstName(e))
+		}
+		return nil
+	}
+	if !flagIsSet(c, yesFlag) {
+		if ok := confirm(c, cptn+"?"); !ok {
+			fmt.Fprintln(c.App.Writer, "Operation canceled")
+			return nil
+		}
+	}
+
+	if flagIsSet(c, progressFlag) {
+		var bars []*mpb.Bar
+		ctx.progress, bars = simpleBar(
+			barArgs{total: int64(total), barText: "Synchronized files:", barType: unitsArg},
+			barArgs{total: size, barText: "Total size:         ", barType: sizeArg},
+		)
+		ctx.barObjs, ctx.barSize = bars[0], bars[1]
+	}
+	wg := cos.NewLimitedWaitGroup(parseIntFlag(c, concurrencyFlag), 0)
+	for _, e := range ctx.transfer {
+		wg.Add(1)
+		go func(e *syncEntry) {
+			if ctx.upload {
+				ctx.done(e, e.size, "upload", ctx.put(e))
+			} else {
+				ctx.done(e, e.size, "download", ctx.get(e))
+			}
+			wg.Done()
+		}(e)
+	}
+	for _, e := range ctx.remove {
+		wg.Add(1)
+		go func(e *syncEntry) {
+			ctx.done(e, 0, "delete", ctx.del(e))
+			wg.Done()
+		}(e)
+	}
+	wg.Wait()
+
+	if ctx.progress != nil {
+		ctx.progress.Wait()
+		fmt.Fprint(c.App.Writer, ctx.errSb.String())
+	}
+	if n := ctx.errCount.Load(); n > 0 {
+		return fmt.Errorf("failed to synchronize %d file%s", n, cos.Plural(int(n)))
+	}
+	actionDone(c, "Done")
+	return nil
+}
+
+func (ctx *syncCtx) srcName(e *syncEntry) string {
+	if ctx.upload {
+		return e.path
+	}
+	return ctx.bck.Cname(ctx.prefix + e.name)
+}
+
+func (ctx *syncCtx) dstName(e *syncEntry) string {
+	if ctx.upload {
+		return ctx.bck.Cname(ctx.prefix + e.name)
+	}
+	if e.path == "" {
+		return e.name // (invalid - see syncPath)
+	}
+	return e.path
+}
+
+func (ctx *syncCtx) put(e *syncEntry) error {
+	fh, err := cos.NewFileHandle(e.path)
+	if err != nil {
+		return err
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        ctx.bck,
+		ObjName:    ctx.prefix + e.name,
+		Reader:     fh,
+		Size:       uint64(e.size),
+		CustomMD:   cos.StrKVs{syncMtimeMD: strconv.FormatInt(e.mtime, 10)}, // (to compare with next time)
+	}
+	_, err = api.PutObject(&putArgs)
+	return err
+}
+
+// GET into a temp file, rename, and set the source file's mtime (if known)
+func (ctx *syncCtx) get(e *syncEntry) (err error) {
+	if e.path, err = syncPath(ctx.dir, e.name); err != nil {
+		return err
+	}
+	if err = cos.CreateDir(filepath.Dir(e.path)); err != nil {
+		return err
+	}
+	tmp := e.path + syncTmpSuffix
+	fh, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = api.GetObject(apiBP, ctx.bck, ctx.prefix+e.name, &api.GetArgs{Writer: fh})
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = os.Rename(tmp, e.path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return V(err)
+	}
+	if e.mtime == 0 {
+		return nil // (next time, compare checksums)
+	}
+	mtime := time.Unix(0, e.mtime)
+	return os.Chtimes(e.path, mtime, mtime)
+}
+
+func (ctx *syncCtx) del(e *syncEntry) error {
+	if ctx.upload {
+		return api.DeleteObject(apiBP, ctx.bck, ctx.prefix+e.name)
+	}
+	return os.Remove(e.path)
+}
+
+func (ctx *syncCtx) done(e *syncEntry, size int64, op string, err error) {
+	if err != nil {
+		ctx.errCount.Inc()
+		str := fmt.Sprintf("Failed to %s %s: %v\n", op, ctx.dstName(e), err)
+		if ctx.progress != nil {
+			ctx.mu.Lock()
+			ctx.errSb.WriteString(str)
+			ctx.mu.Unlock()
+		} else {
+			fmt.Fprint(ctx.c.App.ErrWriter, str)
+		}
+	} else if ctx.verbose && ctx.progress == nil {
+		fmt.Fprintf(ctx.c.App.Writer, "%s -> %s\n", ctx.srcName(e), ctx.dstName(e))
+	}
+	if ctx.progress != nil {
+		ctx.barObjs.Increment()
+		ctx.barSize.IncrBy(int(size))
+	}
+}
@@ -0,0 +1,95 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSyncMtime(t *testing.T) {
+	tests := []struct {
+		custom   string
+		expected int64
+	}{
+		{"", 0},
+		{"map[]", 0},
+		{cmn.CustomMD2S(cos.StrKVs{"etag": "abc"}), 0},
+		{cmn.CustomMD2S(cos.StrKVs{syncMtimeMD: "xyz"}), 0},
+		{cmn.CustomMD2S(cos.StrKVs{syncMtimeMD: "-1"}), 0},
+		{cmn.CustomMD2S(cos.StrKVs{"etag": "abc", syncMtimeMD: "12345", "source": "aws"}), 12345},
+	}
+	for _, test := range tests {
+		got := syncMtime(test.custom)
+		tassert.Errorf(t, got == test.expected, "%q: expected %d, got %d", test.custom, test.expected, got)
+	}
+}
+
+func TestSyncPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "dst")
+	for _, name := range []string{"a", "a/b/c", "a/../b", "..a/b"} {
+		path, err := syncPath(dir, name)
+		tassert.CheckFatal(t, err)
+		rel, err := filepath.Rel(dir, path)
+		tassert.Errorf(t, err == nil && filepath.IsLocal(rel), "%q: %q is outside %q", name, path, dir)
+	}
+	for _, name := range []string{"..", "../a", "a/../../b", "/etc/passwd", "a/../.."} {
+		_, err := syncPath(dir, name)
+		tassert.Errorf(t, err != nil, "%q: expected error", name)
+	}
+}
+
+func TestSyncChanged(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		path  = filepath.Join(dir, "f")
+		data  = []byte("0123456789")
+		mtime = time.Now().UnixNano()
+	)
+	tassert.CheckFatal(t, os.WriteFile(path, data, 0o644))
+	cksum, err := fileCksum(path, cos.ChecksumMD5)
+	tassert.CheckFatal(t, err)
+
+	tests := []struct {
+		name     string
+		obj      syncEntry
+		cksum    bool
+		expected bool
+	}{
+		{"same size and mtime", syncEntry{size: 10, mtime: mtime}, false, false},
+		{"different size", syncEntry{size: 11, mtime: mtime}, false, true},
+		{"newer object", syncEntry{size: 10, mtime: mtime + int64(time.Minute)}, false, true},
+		{"older object", syncEntry{size: 10, mtime: mtime - int64(time.Minute)}, false, true},
+		{"within mtime window", syncEntry{size: 10, mtime: mtime + 1000}, false, false},
+		{"no mtime, same checksum", syncEntry{size: 10, cksum: cksum}, false, false},
+		{"no mtime, different checksum", syncEntry{size: 10, cksum: "0123"}, false, true},
+		{"no mtime, no checksum", syncEntry{size: 10}, false, false},
+		{"no mtime, multipart ETag", syncEntry{size: 10, cksum: "0123" + cmn.AwsMultipartDelim + "2"}, false, false},
+		{"--checksum: same checksum", syncEntry{size: 10, cksum: cksum, mtime: mtime + int64(time.Minute)}, true, false},
+		{"--checksum: different checksum", syncEntry{size: 10, cksum: "0123", mtime: mtime}, true, true},
+	}
+	for _, upload := range []bool{true, false} {
+		for _, test := range tests {
+			var (
+				ctx      = &syncCtx{cksumTy: cos.ChecksumMD5, cksum: test.cksum, upload: upload}
+				lcl      = &syncEntry{name: "f", path: path, size: 10, mtime: mtime}
+				obj      = test.obj
+				src, dst = lcl, &obj
+			)
+			if !upload {
+				src, dst = dst, src
+			}
+			changed, err := ctx.changed(src, dst)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, changed == test.expected, "%s (upload %t): expected changed=%t", test.name, upload, test.expected)
+		}
+	}
+}
@@ -0,0 +1,807 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais top` - interactive (full-screen, auto-refreshing) cluster dashboard.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+	"golang.org/x/term"
+)
+
+// The dashboard is a single loop driven by two sources:
+// - periodic cluster samples (node stats and status, disks, mountpaths, running jobs)
+//   collected in the background at `--refresh` intervals;
+// - keystrokes (terminal in raw mode).
+// Each event re-renders the entire screen from the two most recent samples
+// (rates and latencies are computed as deltas between the two).
+
+// views
+const (
+	topViewCluster = iota
+	topViewNode
+	topViewJob
+)
+
+// (cluster view) selectable lists
+const (
+	topFocusNodes = iota
+	topFocusJobs
+)
+
+// keys
+const (
+	topKeyUp = iota + 1
+	topKeyDown
+	topKeyTab
+	topKeyEnter
+	topKeyBack
+	topKeyRefresh
+	topKeyQuit
+)
+
+// ANSI
+const (
+	ansiAltScreenOn  = "\x1b[?1049h\x1b[?25l" // + hide cursor
+	ansiAltScreenOff = "\x1b[?25h\x1b[?1049l" // + show cursor
+	ansiHome         = "\x1b[H\x1b[2J"
+	ansiReverse      = "\x1b[7m"
+	ansiBold         = "\x1b[1m"
+	ansiReset        = "\x1b[0m"
+)
+
+const topHelp = "[↑/↓ k/j] select  [tab] nodes/jobs  [enter] details  [esc] back  [r] refresh  [q] quit"
+
+type (
+	topSample struct {
+		taken   time.Time
+		err     error
+		smap    *meta.Smap
+		tstatus teb.StstMap
+		pstatus teb.StstMap
+		disks   map[string]ios.AllDiskStats   // by target ID
+		mpaths  map[string]*apc.MountpathList // ditto
+		running xact.MultiSnap                // all running jobs
+		job     xact.MultiSnap                // selected job (running or not)
+	}
+	topJob struct {
+		snaps map[string]*core.Snap // by target ID
+		start time.Time
+		id    string
+		kind  string
+		bck   string
+		objs  int64
+		size  int64
+		rate  int64 // bytes per second
+	}
+	topCtx struct {
+		c        *cli.Context
+		cur      *topSample
+		prev     *topSample
+		nodes    []*meta.Snode // targets first, then proxies
+		jobs     []*topJob
+		jobID    string // drill-down (job view)
+		nodeID   string // ditto (node view)
+		units    string
+		interval time.Duration
+		view     int
+		focus    int
+		nodeIdx  int
+		jobIdx   int
+		mu       sync.Mutex // protects jobID (see collect)
+	}
+)
+
+var (
+	topCmdFlags = []cli.Flag{
+		refreshFlag,
+		unitsFlag,
+	}
+	topCmd = cli.Command{
+		Name: commandTop,
+		Usage: "interactive full-screen cluster dashboard: per-node throughput, latency, and disk utilization,\n" +
+			indent1 + "running jobs with progress, rebalance state, and alerts (offline nodes, disabled mountpaths, out of space);\n" +
+			indent1 + "use arrow keys (or k/j) to select, tab to switch between nodes and jobs, and enter to show details",
+		Flags:  topCmdFlags,
+		Action: topHandler,
+	}
+)
+
+func topHandler(c *cli.Context) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return errors.New("'ais " + commandTop + "' requires interactive terminal")
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	ctx := &topCtx{c: c, units: units, interval: _refreshRate(c)}
+
+	// first sample (fail early when the cluster is unreachable)
+	s := ctx.collect()
+	if s.err != nil {
+		return s.err
+	}
+	ctx.update(s)
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(c.App.Writer, ansiAltScreenOn)
+	defer func() {
+		fmt.Fprint(c.App.Writer, ansiAltScreenOff)
+		term.Restore(fd, state)
+	}()
+
+	var (
+		keys    = make(chan int, 16)
+		samples = make(chan *topSample, 1)
+		now     = make(chan struct{}, 1)
+		stop    = make(chan struct{})
+	)
+	defer close(stop)
+	go topReadKeys(keys)
+	go ctx.collector(samples, now, stop)
+
+	ctx.render()
+	for {
+		select {
+		case key := <-keys:
+			switch key {
+			case topKeyQuit:
+				return nil
+			case topKeyRefresh:
+				topRefreshNow(now)
+			default:
+				if ctx.onKey(key) {
+					topRefreshNow(now)
+				}
+			}
+		case s := <-samples:
+			ctx.update(s)
+		}
+		ctx.render()
+	}
+}
+
+func topRefreshNow(now chan struct{}) {
+	select {
+	case now <- struct{}{}:
+	default:
+	}
+}
+
+//
+// input
+//
+
+func topReadKeys(keys chan<- int) {
+	buf := make([]byte, 64)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			keys <- topKeyQuit
+			return
+		}
+		for _, key := range topParseKeys(buf[:n]) {
+			keys <- key
+		}
+	}
+}
+
+// parse raw-mode input (including escape sequences) into keys
+func topParseKeys(b []byte) (keys []int) {
+	for i := 0; i < len(b); i++ {
+		switch b[i] {
+		case 'q', 'Q', 3 /*Ctrl-C*/, 4 /*Ctrl-D*/ :
+			keys = append(keys, topKeyQuit)
+		case 'k':
+			keys = append(keys, topKeyUp)
+		case 'j':
+			keys = append(keys, topKeyDown)
+		case '\t':
+			keys = append(keys, topKeyTab)
+		case '\r', '\n', 'l':
+			keys = append(keys, topKeyEnter)
+		case 127 /*backspace*/, 8, 'h':
+			keys = append(keys, topKeyBack)
+		case 'r':
+			keys = append(keys, topKeyRefresh)
+		case 0x1b:
+			// CSI (ESC '[' <final>) or SS3 (ESC 'O' <final>) arrow keys; otherwise, plain ESC
+			if i+2 < len(b) && (b[i+1] == '[' || b[i+1] == 'O') {
+				switch b[i+2] {
+				case 'A':
+					keys = append(keys, topKeyUp)
+				case 'B':
+					keys = append(keys, topKeyDown)
+				case 'C':
+					keys = append(keys, topKeyEnter)
+				case 'D':
+					keys = append(keys, topKeyBack)
+				}
+				i += 2
+				continue
+			}
+			keys = append(keys, topKeyBack)
+		}
+	}
+	return keys
+}
+
+// returns true when the new view requires fresh data
+func (ctx *topCtx) onKey(key int) (refresh bool) {
+	switch ctx.view {
+	case topViewCluster:
+		switch key {
+		case topKeyUp:
+			if ctx.focus == topFocusNodes {
+				ctx.nodeIdx = max(ctx.nodeIdx-1, 0)
+			} else {
+				ctx.jobIdx = max(ctx.jobIdx-1, 0)
+			}
+		case topKeyDown:
+			if ctx.focus == topFocusNodes {
+				ctx.nodeIdx = min(ctx.nodeIdx+1, max(len(ctx.nodes)-1, 0))
+			} else {
+				ctx.jobIdx = min(ctx.jobIdx+1, max(len(ctx.jobs)-1, 0))
+			}
+		case topKeyTab:
+			if ctx.focus == topFocusNodes && len(ctx.jobs) > 0 {
+				ctx.focus = topFocusJobs
+			} else {
+				ctx.focus = topFocusNodes
+			}
+		case topKeyEnter:
+			if ctx.focus == topFocusNodes && ctx.nodeIdx < len(ctx.nodes) {
+				ctx.nodeID = ctx.nodes[ctx.nodeIdx].ID()
+				ctx.view = topViewNode
+			} else if ctx.focus == topFocusJobs && ctx.jobIdx < len(ctx.jobs) {
+				ctx.mu.Lock()
+				ctx.jobID = ctx.jobs[ctx.jobIdx].id
+				ctx.mu.Unlock()
+				ctx.view = topViewJob
+				refresh = true
+			}
+		}
+	default:
+		if key == topKeyBack {
+			ctx.view = topViewCluster
+			ctx.mu.Lock()
+			ctx.jobID = ""
+			ctx.mu.Unlock()
+		}
+	}
+	return refresh
+}
+
+//
+// data
+//
+
+func (ctx *topCtx) collector(samples chan<- *topSample, now, stop <-chan struct{}) {
+	timer := time.NewTimer(ctx.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-now:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-stop:
+			return
+		}
+		s := ctx.collect()
+		select {
+		case samples <- s:
+		case <-stop:
+			return
+		}
+		timer.Reset(ctx.interval)
+	}
+}
+
+func (ctx *topCtx) collect() *topSample {
+	s := &topSample{taken: time.Now()}
+	if s.smap, s.err = api.GetClusterMap(apiBP); s.err != nil {
+		s.err = V(s.err)
+		return s
+	}
+	var (
+		smap = s.smap
+		mu   = &sync.Mutex{}
+		wg   = cos.NewLimitedWaitGroup(sys.NumCPU(), smap.CountTargets()+smap.CountProxies())
+	)
+	s.tstatus = make(teb.StstMap, smap.CountTargets())
+	s.pstatus = make(teb.StstMap, smap.CountProxies())
+	daeStatus(smap.Tmap, s.tstatus, wg, mu)
+	daeStatus(smap.Pmap, s.pstatus, wg, mu)
+	wg.Wait()
+
+	// disks and mountpaths
+	s.disks = make(map[string]ios.AllDiskStats, len(smap.Tmap))
+	s.mpaths = make(map[string]*apc.MountpathList, len(smap.Tmap))
+	wg = cos.NewLimitedWaitGroup(sys.NumCPU(), smap.CountTargets())
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		wg.Add(1)
+		go func(tsi *meta.Snode) {
+			defer wg.Done()
+			disks, err := api.GetDiskStats(apiBP, tsi.ID())
+			mpl, errM := api.GetMountpaths(apiBP, tsi)
+			mu.Lock()
+			if err == nil {
+				s.disks[tsi.ID()] = disks
+			}
+			if errM == nil {
+				s.mpaths[tsi.ID()] = mpl
+			}
+			mu.Unlock()
+		}(tsi)
+	}
+	wg.Wait()
+
+	// jobs
+	if s.running, s.err = api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{OnlyRunning: true}); s.err != nil {
+		s.err = V(s.err)
+		return s
+	}
+	ctx.mu.Lock()
+	jobID := ctx.jobID
+	ctx.mu.Unlock()
+	if jobID != "" {
+		s.job, _ = api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{ID: jobID})
+	}
+	return s
+}
+
+func (ctx *topCtx) update(s *topSample) {
+	if s.err != nil {
+		// keep showing the last good sample
+		if ctx.cur != nil {
+			ctx.cur.err = s.err
+		}
+		return
+	}
+	ctx.prev, ctx.cur = ctx.cur, s
+
+	// nodes
+	ctx.nodes = ctx.nodes[:0]
+	for _, m := range []meta.NodeMap{s.smap.Tmap, s.smap.Pmap} {
+		ids := make([]string, 0, len(m))
+		for id := range m {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			ctx.nodes = append(ctx.nodes, m[id])
+		}
+	}
+	ctx.nodeIdx = min(ctx.nodeIdx, max(len(ctx.nodes)-1, 0))
+
+	// jobs
+	prevSize := make(map[string]int64, len(ctx.jobs))
+	for _, j := range ctx.jobs {
+		prevSize[j.id] = j.size
+	}
+	ctx.jobs = topJobs(s.running)
+	if ctx.prev != nil {
+		elapsed := s.taken.Sub(ctx.prev.taken).Seconds()
+		for _, j := range ctx.jobs {
+			if size, ok := prevSize[j.id]; ok && elapsed > 0 && j.size > size {
+				j.rate = int64(float64(j.size-size) / elapsed)
+			}
+		}
+	}
+	ctx.jobIdx = min(ctx.jobIdx, max(len(ctx.jobs)-1, 0))
+	if len(ctx.jobs) == 0 {
+		ctx.focus = topFocusNodes
+	}
+}
+
+// aggregate per-target snaps by job ID
+func topJobs(xs xact.MultiSnap) (jobs []*topJob) {
+	byID := make(map[string]*topJob, 8)
+	for tid, snaps := range xs {
+		for _, snap := range snaps {
+			j, ok := byID[snap.ID]
+			if !ok {
+				_, xname := xact.GetKindName(snap.Kind)
+				j = &topJob{id: snap.ID, kind: xname, start: snap.StartTime, snaps: make(map[string]*core.Snap, 4)}
+				switch {
+				case !snap.SrcBck.IsEmpty():
+					j.bck = snap.SrcBck.Cname("") + " => " + snap.DstBck.Cname("")
+				case !snap.Bck.IsEmpty():
+					j.bck = snap.Bck.Cname("")
+				}
+				byID[snap.ID] = j
+				jobs = append(jobs, j)
+			}
+			j.snaps[tid] = snap
+			j.objs += snap.Stats.Objs + snap.Stats.InObjs
+			j.size += snap.Stats.Bytes + snap.Stats.InBytes
+			if snap.StartTime.Before(j.start) {
+				j.start = snap.StartTime
+			}
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].start.Equal(jobs[j].start) {
+			return jobs[i].id < jobs[j].id
+		}
+		return jobs[i].start.Before(jobs[j].start)
+	})
+	return jobs
+}
+
+// throughput (bytes/s), request rate (1/s), and average latency (ns) between the two samples
+func (ctx *topCtx) rates(tid, bpsName, cntName, latName string) (bps, rps, lat int64) {
+	if ctx.prev == nil {
+		return
+	}
+	cur, prev := ctx.cur.tstatus[tid], ctx.prev.tstatus[tid]
+	if cur == nil || prev == nil || cur.Tracker == nil || prev.Tracker == nil {
+		return
+	}
+	seconds := ctx.cur.taken.Sub(ctx.prev.taken).Seconds()
+	if seconds <= 0 {
+		return
+	}
+	if d := cur.Tracker[bpsName].Value - prev.Tracker[bpsName].Value; d > 0 {
+		bps = int64(float64(d) / seconds)
+	}
+	if n := cur.Tracker[cntName].Value - prev.Tracker[cntName].Value; n > 0 {
+		rps = int64(float64(n) / seconds)
+		lat = (cur.Tracker[latName].Value - prev.Tracker[latName].Value) / n
+	}
+	return
+}
+
+func (ctx *topCtx) status(node *meta.Snode) (ds *stats.NodeStatus) {
+	if node.IsTarget() {
+		return ctx.cur.tstatus[node.ID()]
+	}
+	return ctx.cur.pstatus[node.ID()]
+}
+
+// max utilization across target's disks
+func (ctx *topCtx) diskUtil(tid string) (util int64, ok bool) {
+	disks, ok := ctx.cur.disks[tid]
+	for _, ds := range disks {
+		util = max(util, ds.Util)
+	}
+	return util, ok
+}
+
+func (ctx *topCtx) alerts() (lines []string) {
+	for _, node := range ctx.nodes {
+		ds := ctx.status(node)
+		switch {
+		case ds == nil:
+			lines = append(lines, node.StringEx()+": no response")
+			continue
+		case ds.Status != teb.NodeOnline:
+			lines = append(lines, node.StringEx()+": "+ds.Status)
+		}
+		if !node.IsTarget() {
+			continue
+		}
+		if mpl := ctx.cur.mpaths[node.ID()]; mpl != nil {
+			for _, mpath := range mpl.Disabled {
+				lines = append(lines, node.StringEx()+": mountpath "+mpath+" is disabled")
+			}
+			for _, mpath := range mpl.WaitingDD {
+				lines = append(lines, node.StringEx()+": mountpath "+mpath+" is being detached or disabled")
+			}
+		}
+		if ds.TargetCDF.CsErr != "" {
+			lines = append(lines, node.StringEx()+": "+ds.TargetCDF.CsErr)
+		}
+	}
+	// rebalance
+	var (
+		reb        *core.Snap
+		objs, size int64
+	)
+	for _, ds := range ctx.cur.tstatus {
+		if ds.RebSnap == nil {
+			continue
+		}
+		if reb == nil || ds.RebSnap.RebID > reb.RebID {
+			reb = ds.RebSnap
+		}
+	}
+	if reb != nil && reb.Running() {
+		for _, ds := range ctx.cur.tstatus {
+			if ds.RebSnap != nil && ds.RebSnap.RebID == reb.RebID {
+				objs += ds.RebSnap.Stats.InObjs
+				size += ds.RebSnap.Stats.InBytes
+			}
+		}
+		lines = append(lines, fmt.Sprintf("rebalance[%s] is running: %d objects (%s) received so far",
+			reb.ID, objs, teb.FmtSize(size, ctx.units, 2)))
+	}
+	return lines
+}
+
+//
+// render
+//
+
+func (ctx *topCtx) render() {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		width, height = 120, 40
+	}
+	var (
+		lines    []string
+		selected = -1 // line index to highlight
+	)
+	switch ctx.view {
+	case topViewNode:
+		lines = ctx.renderNode()
+	case topViewJob:
+		lines = ctx.renderJob()
+	default:
+		lines, selected = ctx.renderCluster()
+	}
+
+	// header and footer
+	smap := ctx.cur.smap
+	hdr := fmt.Sprintf("ais top - cluster %s, primary %s, Smap v%d, %d targets, %d proxies - %s (every %v)",
+		smap.UUID, smap.Primary.StringEx(), smap.Version, smap.CountTargets(), smap.CountProxies(),
+		ctx.cur.taken.Format(time.TimeOnly), ctx.interval)
+	footer := topHelp
+	if ctx.cur.err != nil {
+		footer = "error: " + ctx.cur.err.Error()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ansiHome)
+	sb.WriteString(ansiBold + topFit(hdr, width) + ansiReset + "\r\n")
+	n := min(len(lines), height-3)
+	for i := 0; i < n; i++ {
+		line := topFit(lines[i], width)
+		if i == selected {
+			line = ansiReverse + line + strings.Repeat(" ", max(width-len([]rune(line)), 0)) + ansiReset
+		}
+		sb.WriteString(line + "\r\n")
+	}
+	for i := n; i < height-2; i++ {
+		sb.WriteString("\r\n")
+	}
+	sb.WriteString(ansiBold + topFit(footer, width) + ansiReset)
+	fmt.Fprint(ctx.c.App.Writer, sb.String())
+}
+
+func topFit(line string, width int) string {
+	if r := []rune(line); len(r) > width {
+		return string(r[:width])
+	}
+	return line
+}
+
+func (ctx *topCtx) renderCluster() (lines []string, selected int) {
+	selected = -1
+	if alerts := ctx.alerts(); len(alerts) > 0 {
+		lines = append(lines, "", "ALERTS")
+		for _, a := range alerts {
+			lines = append(lines, "  ! "+a)
+		}
+	}
+
+	lines = append(lines, "", "NODES")
+	lines = append(lines, fmt.Sprintf("  %-18s %-12s %10s %10s %10s %10s %9s %6s %5s",
+		"NODE", "STATUS", "GET/s", "PUT/s", "GET-LAT", "PUT-LAT", "DISK-UTIL", "USED%", "JOBS"))
+	jobsPerNode := make(map[string]int, len(ctx.nodes))
+	for tid, snaps := range ctx.cur.running {
+		jobsPerNode[tid] = len(snaps)
+	}
+	for i, node := range ctx.nodes {
+		var (
+			ds     = ctx.status(node)
+			status = "n/a"
+		)
+		if ds != nil {
+			status = ds.Status
+		}
+		if i == ctx.nodeIdx && ctx.focus == topFocusNodes {
+			selected = len(lines)
+		}
+		if !node.IsTarget() {
+			lines = append(lines, fmt.Sprintf("  %-18s %-12s", node.StringEx(), status))
+			continue
+		}
+		getBps, _, getLat := ctx.rates(node.ID(), stats.GetThroughput, stats.GetCount, stats.GetLatency)
+		putBps, _, putLat := ctx.rates(node.ID(), stats.PutThroughput, stats.PutCount, stats.PutLatency)
+		util, used := "-", "-"
+		if u, ok := ctx.diskUtil(node.ID()); ok {
+			util = fmt.Sprintf("%d%%", u)
+		}
+		if ds != nil && len(ds.TargetCDF.Mountpaths) > 0 {
+			used = fmt.Sprintf("%d%%", ds.TargetCDF.PctAvg)
+		}
+		lines = append(lines, fmt.Sprintf("  %-18s %-12s %10s %10s %10s %10s %9s %6s %5d",
+			node.StringEx(), status,
+			teb.FmtSize(getBps, ctx.units, 1), teb.FmtSize(putBps, ctx.units, 1),
+			topFmtLat(getLat, ctx.units), topFmtLat(putLat, ctx.units),
+			util, used, jobsPerNode[node.ID()]))
+	}
+
+	lines = append(lines, "", "JOBS")
+	if len(ctx.jobs) == 0 {
+		lines = append(lines, "  (none running)")
+		return lines, selected
+	}
+	lines = append(lines, fmt.Sprintf("  %-28s %-40s %10s %10s %10s %8s %10s",
+		"JOB", "BUCKET", "OBJECTS", "SIZE", "RATE/s", "TARGETS", "ELAPSED"))
+	for i, j := range ctx.jobs {
+		if i == ctx.jobIdx && ctx.focus == topFocusJobs {
+			selected = len(lines)
+		}
+		lines = append(lines, fmt.Sprintf("  %-28s %-40s %10d %10s %10s %8d %10s",
+			xact.Cname(j.kind, j.id), j.bck, j.objs, teb.FmtSize(j.size, ctx.units, 1),
+			teb.FmtSize(j.rate, ctx.units, 1), len(j.snaps), topFmtElapsed(j.start)))
+	}
+	return lines, selected
+}
+
+func (ctx *topCtx) renderNode() (lines []string) {
+	node := ctx.cur.smap.GetNode(ctx.nodeID)
+	if node == nil {
+		return []string{"", ctx.nodeID + ": not present in the cluster map"}
+	}
+	ds := ctx.status(node)
+	if ds == nil {
+		return []string{"", node.StringEx() + ": no response"}
+	}
+	lines = append(lines, "", fmt.Sprintf("%s (%s) - %s - %s", node.StringEx(), node.Type(), ds.Status, node.PubNet.URL))
+	if ds.Version != "" {
+		lines = append(lines, fmt.Sprintf("  version %s, deployment %s, CPU load avg %.2f, memory used %.1f%%",
+			ds.Version, ds.DeploymentType, ds.MemCPUInfo.LoadAvg.One, ds.MemCPUInfo.PctMemUsed))
+	}
+	if !node.IsTarget() {
+		return lines
+	}
+
+	// traffic
+	lines = append(lines, "", "TRAFFIC")
+	for _, op := range []struct{ name, bps, cnt, lat string }{
+		{"GET", stats.GetThroughput, stats.GetCount, stats.GetLatency},
+		{"PUT", stats.PutThroughput, stats.PutCount, stats.PutLatency},
+	} {
+		bps, rps, lat := ctx.rates(node.ID(), op.bps, op.cnt, op.lat)
+		lines = append(lines, fmt.Sprintf("  %-4s %10s/s %8d req/s  avg latency %s",
+			op.name, teb.FmtSize(bps, ctx.units, 1), rps, topFmtLat(lat, ctx.units)))
+	}
+
+	// mountpaths
+	lines = append(lines, "", "MOUNTPATHS")
+	mpaths := make([]string, 0, len(ds.TargetCDF.Mountpaths))
+	for mpath := range ds.TargetCDF.Mountpaths {
+		mpaths = append(mpaths, mpath)
+	}
+	sort.Strings(mpaths)
+	for _, mpath := range mpaths {
+		cdf := ds.TargetCDF.Mountpaths[mpath]
+		lines = append(lines, fmt.Sprintf("  %-24s used %3d%%, %s avail, disks %v",
+			mpath, cdf.PctUsed, teb.FmtSize(int64(cdf.Avail), ctx.units, 1), cdf.Disks))
+	}
+	if mpl := ctx.cur.mpaths[node.ID()]; mpl != nil {
+		for _, mpath := range mpl.Disabled {
+			lines = append(lines, fmt.Sprintf("  %-24s DISABLED", mpath))
+		}
+		for _, mpath := range mpl.WaitingDD {
+			lines = append(lines, fmt.Sprintf("  %-24s DETACHING or DISABLING", mpath))
+		}
+	}
+
+	// disks
+	if disks, ok := ctx.cur.disks[node.ID()]; ok {
+		lines = append(lines, "", "DISKS")
+		lines = append(lines, fmt.Sprintf("  %-12s %12s %12s %12s %12s %6s", "DISK", "READ/s", "READ-AVG", "WRITE/s", "WRITE-AVG", "UTIL"))
+		names := make([]string, 0, len(disks))
+		for name := range disks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			d := disks[name]
+			lines = append(lines, fmt.Sprintf("  %-12s %12s %12s %12s %12s %5d%%", name,
+				teb.FmtSize(d.RBps, ctx.units, 1), teb.FmtSize(d.Ravg, ctx.units, 1),
+				teb.FmtSize(d.WBps, ctx.units, 1), teb.FmtSize(d.Wavg, ctx.units, 1), d.Util))
+		}
+	}
+
+	// jobs
+	if snaps := ctx.cur.running[node.ID()]; len(snaps) > 0 {
+		lines = append(lines, "", "JOBS")
+		for _, snap := range snaps {
+			_, xname := xact.GetKindName(snap.Kind)
+			lines = append(lines, fmt.Sprintf("  %-28s %-10s %10d objects %10s  %s",
+				xact.Cname(xname, snap.ID), teb.FmtXactStatus(snap), snap.Stats.Objs+snap.Stats.InObjs,
+				teb.FmtSize(snap.Stats.Bytes+snap.Stats.InBytes, ctx.units, 1), topFmtElapsed(snap.StartTime)))
+		}
+	}
+
+	// errors
+	var errs []string
+	for name, v := range ds.Tracker {
+		if stats.IsErrMetric(name) && v.Value != 0 {
+			errs = append(errs, fmt.Sprintf("  %-32s %d", name, v.Value))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		lines = append(lines, "", "ERRORS")
+		lines = append(lines, errs...)
+	}
+	return lines
+}
+
+func (ctx *topCtx) renderJob() (lines []string) {
+	jobs := topJobs(ctx.cur.job)
+	if len(jobs) == 0 {
+		return []string{"", "job " + ctx.jobID + ": loading..."}
+	}
+	j := jobs[0]
+	lines = append(lines, "", fmt.Sprintf("%s %s, started %s, elapsed %s",
+		xact.Cname(j.kind, j.id), j.bck, j.start.Format(time.TimeOnly), topFmtElapsed(j.start)))
+	lines = append(lines, fmt.Sprintf("  total: %d objects, %s", j.objs, teb.FmtSize(j.size, ctx.units, 1)))
+	lines = append(lines, "", fmt.Sprintf("  %-16s %-10s %10s %10s %10s %10s %10s %10s  %s",
+		"TARGET", "STATE", "OBJECTS", "SIZE", "IN-OBJS", "IN-SIZE", "OUT-OBJS", "OUT-SIZE", "ERROR"))
+	tids := make([]string, 0, len(j.snaps))
+	for tid := range j.snaps {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		snap := j.snaps[tid]
+		st := snap.Stats
+		lines = append(lines, fmt.Sprintf("  %-16s %-10s %10d %10s %10d %10s %10d %10s  %s",
+			meta.Tname(tid), teb.FmtXactStatus(snap),
+			st.Objs, teb.FmtSize(st.Bytes, ctx.units, 1),
+			st.InObjs, teb.FmtSize(st.InBytes, ctx.units, 1),
+			st.OutObjs, teb.FmtSize(st.OutBytes, ctx.units, 1),
+			cos.Either(snap.AbortErr, snap.Err)))
+	}
+	return lines
+}
+
+func topFmtLat(ns int64, units string) string {
+	if ns <= 0 {
+		return "-"
+	}
+	return teb.FmtDuration(ns, units)
+}
+
+func topFmtElapsed(start time.Time) string {
+	if start.IsZero() {
+		return "-"
+	}
+	return time.Since(start).Round(time.Second).String()
+}
@@ -0,0 +1,65 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestTopParseKeys(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []int
+	}{
+		{"q", []int{topKeyQuit}},
+		{"\x03", []int{topKeyQuit}},
+		{"jjk", []int{topKeyDown, topKeyDown, topKeyUp}},
+		{"\x1b[A\x1b[B", []int{topKeyUp, topKeyDown}},
+		{"\x1bOA", []int{topKeyUp}},
+		{"\x1b[C\x1b[D", []int{topKeyEnter, topKeyBack}},
+		{"\x1b", []int{topKeyBack}},
+		{"\t\r\x7fr", []int{topKeyTab, topKeyEnter, topKeyBack, topKeyRefresh}},
+		{"xyz", nil},
+	}
+	for _, test := range tests {
+		if keys := topParseKeys([]byte(test.input)); !reflect.DeepEqual(keys, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.input, test.expected, keys)
+		}
+	}
+}
+
+func TestTopJobs(t *testing.T) {
+	var (
+		now  = time.Now()
+		bck  = cmn.Bck{Name: "b", Provider: "ais"}
+		snap = func(id string, start time.Time, objs, size int64) *core.Snap {
+			return &core.Snap{
+				ID: id, Kind: "copy-bck", Bck: bck, StartTime: start,
+				Stats: core.Stats{Objs: objs, Bytes: size},
+			}
+		}
+		xs = xact.MultiSnap{
+			"t1": {snap("x2", now, 1, 10), snap("x1", now.Add(-time.Minute), 2, 20)},
+			"t2": {snap("x1", now.Add(-2*time.Minute), 3, 30)},
+		}
+	)
+	jobs := topJobs(xs)
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %d", len(jobs))
+	}
+	j := jobs[0] // the oldest first
+	if j.id != "x1" || j.objs != 5 || j.size != 50 || len(j.snaps) != 2 || !j.start.Equal(now.Add(-2*time.Minute)) {
+		t.Errorf("unexpected %+v", j)
+	}
+	if j := jobs[1]; j.id != "x2" || j.objs != 1 || j.size != 10 || len(j.snaps) != 1 {
+		t.Errorf("unexpected %+v", j)
+	}
+}
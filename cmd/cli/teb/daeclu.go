@@ -27,14 +27,15 @@ const (
 	colVersion   = "VERSION"
 	colBuildTime = "BUILD TIME"
 	colPodName   = "K8s POD"
+	colSuspicion = "SUSPICION"
 )
 
 func NewDaeStatus(st *stats.NodeStatus, smap *meta.Smap, daeType, units string) *Table {
 	switch daeType {
 	case apc.Proxy:
-		return newTableProxies(StstMap{st.Snode.ID(): st}, smap, units)
+		return newTableProxies(StstMap{st.Snode.ID(): st}, smap, nil, units)
 	case apc.Target:
-		return newTableTargets(StstMap{st.Snode.ID(): st}, smap, units)
+		return newTableTargets(StstMap{st.Snode.ID(): st}, smap, nil, units)
 	default:
 		debug.Assert(false)
 		return nil
@@ -42,11 +43,12 @@ func NewDaeStatus(st *stats.NodeStatus, smap *meta.Smap, daeType, units string)
 }
 
 func NewDaeMapStatus(ds *StatsAndStatusHelper, smap *meta.Smap, daeType, units string) *Table {
+	susp := ds.suspicion(smap)
 	switch daeType {
 	case apc.Proxy:
-		return newTableProxies(ds.Pmap, smap, units)
+		return newTableProxies(ds.Pmap, smap, susp, units)
 	case apc.Target:
-		return newTableTargets(ds.Tmap, smap, units)
+		return newTableTargets(ds.Tmap, smap, susp, units)
 	default:
 		debug.Assert(false)
 		return nil
@@ -54,7 +56,7 @@ func NewDaeMapStatus(ds *StatsAndStatusHelper, smap *meta.Smap, daeType, units s
 }
 
 // proxy(ies)
-func newTableProxies(ps StstMap, smap *meta.Smap, units string) *Table {
+func newTableProxies(ps StstMap, smap *meta.Smap, susp map[string]float64, units string) *Table {
 	var (
 		h        = StatsAndStatusHelper{Pmap: ps}
 		pods     = h.pods()
@@ -66,6 +68,7 @@ func newTableProxies(ps StstMap, smap *meta.Smap, units string) *Table {
 			{name: colMemAvail},
 			{name: colLoadAvg},
 			{name: colUptime},
+			{name: colSuspicion, hide: len(susp) == 0},
 			{name: colPodName, hide: len(pods) == 1 && pods[0] == ""},
 			{name: colStatus, hide: len(status) == 1 && status[0] == NodeOnline},
 			{name: colVersion, hide: len(versions) == 1 && len(ps) > 1},
@@ -87,6 +90,7 @@ func newTableProxies(ps StstMap, smap *meta.Smap, units string) *Table {
 				unknownVal,
 				unknownVal,
 				unknownVal,
+				fmtSuspicion(susp, sid),
 				ds.K8sPodName,
 				nstatus,
 				ds.Version,
@@ -114,6 +118,7 @@ func newTableProxies(ps StstMap, smap *meta.Smap, units string) *Table {
 			memAvail,
 			load,
 			uptime,
+			fmtSuspicion(susp, sid),
 			ds.K8sPodName,
 			ds.Status,
 			ds.Version,
@@ -125,7 +130,7 @@ func newTableProxies(ps StstMap, smap *meta.Smap, units string) *Table {
 }
 
 // target(s)
-func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
+func newTableTargets(ts StstMap, smap *meta.Smap, susp map[string]float64, units string) *Table {
 	var (
 		h        = StatsAndStatusHelper{Tmap: ts}
 		pods     = h.pods()
@@ -140,6 +145,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 			{name: colLoadAvg},
 			{name: colRebalance, hide: len(h.rebalance()) == 0},
 			{name: colUptime},
+			{name: colSuspicion, hide: len(susp) == 0},
 			{name: colPodName, hide: len(pods) == 1 && pods[0] == ""},
 			{name: colStatus, hide: len(status) == 1 && status[0] == NodeOnline},
 			{name: colVersion, hide: len(versions) == 1 && len(ts) > 1},
@@ -163,6 +169,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 				unknownVal,
 				unknownVal,
 				unknownVal,
+				fmtSuspicion(susp, sid),
 				ds.K8sPodName,
 				nstatus,
 				ds.Version,
@@ -199,6 +206,7 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 			load,
 			fmtRebStatus(ds.RebSnap),
 			uptime,
+			fmtSuspicion(susp, sid),
 			ds.K8sPodName,
 			ds.Status,
 			ds.Version,
@@ -208,3 +216,10 @@ func newTableTargets(ts StstMap, smap *meta.Smap, units string) *Table {
 	}
 	return table
 }
+
+func fmtSuspicion(susp map[string]float64, sid string) string {
+	if phi, ok := susp[sid]; ok {
+		return fmt.Sprintf("%.2f", phi)
+	}
+	return NotSetVal
+}
@@ -41,6 +41,7 @@ const (
 	xfinishedErrs = "Finished with errors"
 	xrunning      = "Running"
 	xidle         = "Idle"
+	xpaused       = "Paused"
 	xaborted      = "Aborted"
 )
 
@@ -134,6 +135,13 @@ const (
 	TransformListNoHdrTmpl = "{{ range $value := . }}" + transformListBody + "{{end}}"
 	TransformListTmpl      = transformListHdr + TransformListNoHdrTmpl
 
+	queuedJobsHdr  = "JOB ID\t KIND\t BUCKET\t DESTINATION\t PRIORITY\t QUEUED\t ATTEMPTS\t CAUSE\n"
+	queuedJobsBody = "{{$value.ID}}\t {{$value.Kind}}\t {{FormatBckName $value.Bck}}\t " +
+		"{{if $value.DstBck.Name}}{{FormatBckName $value.DstBck}}{{else}}-{{end}}\t " +
+		"{{$value.Priority}}\t {{FormatUnixNano $value.Queued}}\t {{$value.Attempts}}\t {{$value.Cause}}\n"
+	QueuedJobsNoHdrTmpl = "{{ range $value := . }}" + queuedJobsBody + "{{end}}"
+	QueuedJobsTmpl      = queuedJobsHdr + QueuedJobsNoHdrTmpl
+
 	//
 	// all other xactions
 	//
@@ -241,12 +249,15 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\t QUOTA (size, objects)\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\t " +
+		"{{if (and (eq $v.Quota.MaxSize 0) (eq $v.Quota.MaxObjects 0))}}-{{else}}" +
+		"{{FormatBytesSig $v.Quota.Size 2}}/{{if (eq $v.Quota.MaxSize 0)}}-{{else}}{{FormatBytesSig $v.Quota.MaxSize 2}}{{end}} " +
+		"{{$v.Quota.Objects}}/{{if (eq $v.Quota.MaxObjects 0)}}-{{else}}{{$v.Quota.MaxObjects}}{{end}}{{end}}\n" +
 		"{{end}}"
 
 	BucketSummaryValidateTmpl = "BUCKET\t OBJECTS\t MISPLACED\t MISSING COPIES\n" + bucketSummaryValidateBody
@@ -299,6 +310,11 @@ See '--help' and docs/cli for details.`
 		"{{ $user.ID }}\t{{ JoinList $user.Roles }}\n" +
 		"{{end}}"
 
+	AuthNS3CredTmpl = "ACCESS KEY\tUSER\tEXPIRES\n" +
+		"{{ range $cred := . }}" +
+		"{{ $cred.AccessKey }}\t{{ $cred.UserID }}\t{{ FormatTime $cred.Expires }}\n" +
+		"{{end}}"
+
 	AuthNUserVerboseTmpl = "Name\t{{ .ID }}\n" +
 		"Roles\t{{ JoinList .Roles }}\n" +
 		"{{ if ne (len .ClusterACLs) 0 }}" +
@@ -345,14 +361,17 @@ See '--help' and docs/cli for details.`
 
 		"{{range $k, $v := $p.TargetCDF.Mountpaths}}" +
 		"{{if (IsEqS $k $mp)}}{{$v.FS}}{{end}}" +
-		"{{end}}\n" +
+		"{{end}}" +
+		"{{range $ro := $p.Mpl.ReadOnly}}{{if (IsEqS $ro $mp)}} (read-only){{end}}{{end}}" +
+		"{{range $k, $v := $p.Mpl.Labels}}{{if (IsEqS $k $mp)}} (label: {{$v}}){{end}}{{end}}" +
+		"{{FormatMpathHealth $p.Mpl.Health $mp}}\n" +
 
 		"{{end}}{{end}}" +
 
 		"{{if ne (len $p.Mpl.Disabled) 0}}" +
 		"\tDisabled:\n" +
 		"{{range $mp := $p.Mpl.Disabled }}" +
-		"\t\t{{ $mp }}\n" +
+		"\t\t{{ $mp }}{{FormatMpathHealth $p.Mpl.Health $mp}}\n" +
 		"{{end}}{{end}}" +
 		"{{if ne (len $p.Mpl.WaitingDD) 0}}" +
 		"\tTransitioning to disabled or detached pending resilver:\n" +
@@ -408,6 +427,8 @@ var (
 		"FormatDuration":      FormatDuration,
 		"FormatStart":         func(s, e time.Time) string { res, _ := FmtStartEnd(s, e); return res },
 		"FormatEnd":           func(s, e time.Time) string { _, res := FmtStartEnd(s, e); return res },
+		"FormatUnixNano":      func(ns int64) string { return cos.FormatNanoTime(ns, time.Stamp) },
+		"FormatTime":          func(t time.Time) string { return cos.FormatTime(t, time.Stamp) },
 		"FormatDsortStatus":   dsortJobInfoStatus,
 		"FormatLsObjStatus":   fmtLsObjStatus,
 		"FormatLsObjIsCached": fmtLsObjIsCached,
@@ -421,6 +442,7 @@ var (
 		"FormatBool":          FmtBool,
 		"FormatBckName":       func(bck cmn.Bck) string { return bck.Cname("") },
 		"FormatACL":           fmtACL,
+		"FormatMpathHealth":   fmtMpathHealth,
 		"FormatNameArch":      fmtNameArch,
 		"FormatXactState":     FmtXactStatus,
 		//  misc. helpers
@@ -484,6 +506,17 @@ func (h *StatsAndStatusHelper) buildTimes() []string   { return h.toSlice("build
 func (h *StatsAndStatusHelper) rebalance() []string    { return h.toSlice("rebalance_snap") }
 func (h *StatsAndStatusHelper) pods() []string         { return h.toSlice("k8s_pod_name") }
 
+// keepalive suspicion levels - as seen (and reported) by the primary
+func (h *StatsAndStatusHelper) suspicion(smap *meta.Smap) map[string]float64 {
+	if smap == nil || smap.Primary == nil {
+		return nil
+	}
+	if ds, ok := h.Pmap[smap.Primary.ID()]; ok {
+		return ds.Suspicion
+	}
+	return nil
+}
+
 // internal helper for the methods above
 func (h *StatsAndStatusHelper) toSlice(jtag string) []string {
 	if jtag == "status" {
@@ -199,6 +199,23 @@ func toString(lst []string) string {
 	}
 }
 
+// slow-disk detection: most recent latency and its ratio to the peers; flagged mountpaths stand out
+func fmtMpathHealth(health map[string]*apc.MpathHealth, mpath string) string {
+	h, ok := health[mpath]
+	if !ok || len(h.History) == 0 {
+		return ""
+	}
+	last := h.History[len(h.History)-1]
+	s := "\t latency " + FmtDuration(last.Lat*int64(time.Microsecond), cos.UnitsIEC)
+	if last.Ratio > 0 {
+		s += fmt.Sprintf(" (%.1fx peers)", last.Ratio)
+	}
+	if h.SlowSince != 0 {
+		s += "\t" + fred("SLOW") + " since " + cos.FormatNanoTime(h.SlowSince, time.Stamp)
+	}
+	return s
+}
+
 func fmtACL(acl apc.AccessAttrs) string {
 	if acl == 0 {
 		return unknownVal
@@ -267,6 +284,8 @@ func FmtXactStatus(snap *core.Snap) (s string) {
 			return xfinished
 		}
 		return fmt.Sprintf("%s: %q", xfinishedErrs, snap.Err)
+	case snap.IsPaused():
+		s = xpaused
 	case snap.IsIdle():
 		s = xidle
 	default:
@@ -6,16 +6,25 @@
 package cmn
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 )
 
+// ht:// bucket listing methods (see ExtraPropsHTTP)
+const (
+	HTTPListingWebDAV = "webdav" // PROPFIND (Depth: 1)
+	HTTPListingIndex  = "index"  // parse HTML index pages (links)
+)
+
 // Bprops - manageable, user-configurable, and inheritable (from cluster config).
 // Includes per-bucket user-configurable checksum, version, LRU, erasure-coding, and more.
 //
@@ -39,30 +48,97 @@ const (
 
 type (
 	Bprops struct {
-		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
-		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
-		WritePolicy WritePolicyConf `json:"write_policy"`
-		Provider    string          `json:"provider" list:"readonly"`       // backend provider
-		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
-		Cksum       CksumConf       `json:"checksum"`                       // the bucket's checksum
-		EC          ECConf          `json:"ec"`                             // erasure coding
-		LRU         LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
-		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
-		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
-		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
-		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
-		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		BackendBck   Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
+		Extra        ExtraProps      `json:"extra,omitempty" list:"omitempty"`
+		WritePolicy  WritePolicyConf `json:"write_policy"`
+		Provider     string          `json:"provider" list:"readonly"`       // backend provider
+		Renamed      string          `list:"omit"`                           // non-empty if the bucket has been renamed
+		Cksum        CksumConf       `json:"checksum"`                       // the bucket's checksum
+		EC           ECConf          `json:"ec"`                             // erasure coding
+		LRU          LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
+		Mirror       MirrorConf      `json:"mirror"`                         // mirroring
+		Access       apc.AccessAttrs `json:"access,string"`                  // access permissions
+		BID          uint64          `json:"bid,string" list:"omit"`         // unique ID
+		Created      int64           `json:"created,string" list:"readonly"` // creation timestamp
+		Versioning   VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Quota        QuotaConf       `json:"quota"`                          // capacity quota (max size, max objects)
+		ListCache    LsoCacheConf    `json:"list_cache"`                     // remote listing cache
+		RateLimit    RateLimitConf   `json:"rate_limit"`                     // QoS: rate limits and I/O priority
+		Tiering      TieringConf     `json:"tiering"`                        // lifecycle-driven tiering to a "cold" bucket
+		StorageClass string          `json:"storage_class"`                  // placement: mountpath label, e.g. "nvme" (see fs.HrwLabel)
+	}
+
+	// Per-bucket quota: zero means "no limit".
+	// Enforced by each target (for its share of the bucket) when writing new content
+	// (PUT, APPEND, copy, transform), with usage tracked incrementally (see core/quota.go).
+	QuotaConf struct {
+		MaxSize    cos.SizeIEC `json:"max_size"`
+		MaxObjects int64       `json:"max_objects"`
+	}
+	QuotaConfToSet struct {
+		MaxSize    *cos.SizeIEC `json:"max_size,omitempty"`
+		MaxObjects *int64       `json:"max_objects,omitempty"`
+	}
+
+	// Per-bucket QoS: request rate and bandwidth limits (zero means "no limit") and I/O priority.
+	// The limits are cluster-wide: request rate is enforced by each proxy for its share (the limit
+	// divided by the number of active proxies), bandwidth - by each target, ditto.
+	// GETs from a high-priority bucket make background jobs (xactions) yield disk I/O
+	// (see ais/ratelim.go and fs/mpather).
+	RateLimitConf struct {
+		MaxOpsPerSec int         `json:"max_ops_per_sec"`
+		MaxBandwidth cos.SizeIEC `json:"max_bandwidth"` // bytes per second
+		HighPriority bool        `json:"high_priority"`
+	}
+	RateLimitConfToSet struct {
+		MaxOpsPerSec *int         `json:"max_ops_per_sec,omitempty"`
+		MaxBandwidth *cos.SizeIEC `json:"max_bandwidth,omitempty"`
+		HighPriority *bool        `json:"high_priority,omitempty"`
+	}
+
+	// Lifecycle-driven tiering: periodically (every Interval) move objects that have not been
+	// accessed for at least Age to the destination ("cold") bucket, leaving behind zero-size stubs;
+	// GET of a stub transparently promotes the object back (see xact/xs/tier.go)
+	TieringConf struct {
+		Dst      string       `json:"dst"`      // destination bucket, e.g. "ais://cold" or "s3://archive"
+		Age      cos.Duration `json:"age"`      // tier out objects not accessed for at least this long
+		Interval cos.Duration `json:"interval"` // how often to run; zero - DefaultTierInterval
+		Enabled  bool         `json:"enabled"`
+	}
+	TieringConfToSet struct {
+		Dst      *string       `json:"dst,omitempty"`
+		Age      *cos.Duration `json:"age,omitempty"`
+		Interval *cos.Duration `json:"interval,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
+	}
+
+	// Persistent (target-side) cache of the remote bucket's listing (see xact/xs/lso_cache.go);
+	// has no effect when listing in-cluster objects (apc.LsObjCached)
+	LsoCacheConf struct {
+		// Serve list-objects from the cache (when not older than TTL)
+		Enabled bool `json:"enabled"`
+		// Time to live; the cache gets refreshed in the background when older than TTL/2;
+		// zero - DefaultLsoCacheTTL
+		TTL cos.Duration `json:"ttl"`
+	}
+	LsoCacheConfToSet struct {
+		Enabled *bool         `json:"enabled,omitempty"`
+		TTL     *cos.Duration `json:"ttl,omitempty"`
 	}
 
 	ExtraProps struct {
-		AWS  ExtraPropsAWS  `json:"aws,omitempty" list:"omitempty"`
-		HTTP ExtraPropsHTTP `json:"http,omitempty" list:"omitempty"`
-		HDFS ExtraPropsHDFS `json:"hdfs,omitempty" list:"omitempty"`
+		AWS   ExtraPropsAWS   `json:"aws,omitempty" list:"omitempty"`
+		GCP   ExtraPropsGCP   `json:"gcp,omitempty" list:"omitempty"`
+		HTTP  ExtraPropsHTTP  `json:"http,omitempty" list:"omitempty"`
+		HDFS  ExtraPropsHDFS  `json:"hdfs,omitempty" list:"omitempty"`
+		POSIX ExtraPropsPOSIX `json:"posix,omitempty" list:"omitempty"`
 	}
 	ExtraToSet struct { // ref. bpropsFilterExtra
-		AWS  *ExtraPropsAWSToSet  `json:"aws"`
-		HTTP *ExtraPropsHTTPToSet `json:"http"`
-		HDFS *ExtraPropsHDFSToSet `json:"hdfs"`
+		AWS   *ExtraPropsAWSToSet   `json:"aws"`
+		GCP   *ExtraPropsGCPToSet   `json:"gcp"`
+		HTTP  *ExtraPropsHTTPToSet  `json:"http"`
+		HDFS  *ExtraPropsHDFSToSet  `json:"hdfs"`
+		POSIX *ExtraPropsPOSIXToSet `json:"posix"`
 	}
 
 	ExtraPropsAWS struct {
@@ -85,12 +161,30 @@ type (
 		Profile     *string `json:"profile"`
 	}
 
+	// GCS bucket creation options (see also: ExtraPropsAWS.CloudRegion)
+	ExtraPropsGCP struct {
+		// Bucket location (e.g., "US", "EU", "us-central1"); empty - GCS default
+		Location string `json:"location,omitempty"`
+		// Default storage class (e.g., "STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE")
+		StorageClass string `json:"storage_class,omitempty"`
+	}
+	ExtraPropsGCPToSet struct {
+		Location     *string `json:"location"`
+		StorageClass *string `json:"storage_class"`
+	}
+
 	ExtraPropsHTTP struct {
 		// Original URL prior to hashing.
 		OrigURLBck string `json:"original_url,omitempty" list:"readonly"`
+		// Listing method (one of HTTPListing* enumerated below); empty - cannot list remote objects.
+		Listing string `json:"listing,omitempty"`
+		// Write-through: PUT and DELETE objects to (and from) the origin.
+		Writable bool `json:"writable,omitempty"`
 	}
 	ExtraPropsHTTPToSet struct {
 		OrigURLBck *string `json:"original_url"`
+		Listing    *string `json:"listing"`
+		Writable   *bool   `json:"writable"`
 	}
 
 	ExtraPropsHDFS struct {
@@ -101,20 +195,33 @@ type (
 		RefDirectory *string `json:"ref_directory"`
 	}
 
+	ExtraPropsPOSIX struct {
+		// Bucket's root directory (absolute path, must be accessible by all targets).
+		RootDir string `json:"root_dir,omitempty"`
+	}
+	ExtraPropsPOSIXToSet struct {
+		RootDir *string `json:"root_dir"`
+	}
+
 	// Once validated, BpropsToSet are copied to Bprops.
 	// The struct may have extra fields that do not exist in Bprops.
 	// Add tag 'copy:"skip"' to ignore those fields when copying values.
 	BpropsToSet struct {
-		BackendBck  *BackendBckToSet      `json:"backend_bck,omitempty"`
-		Versioning  *VersionConfToSet     `json:"versioning,omitempty"`
-		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
-		LRU         *LRUConfToSet         `json:"lru,omitempty"`
-		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
-		EC          *ECConfToSet          `json:"ec,omitempty"`
-		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
-		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
-		Extra       *ExtraToSet           `json:"extra,omitempty"`
-		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
+		BackendBck   *BackendBckToSet      `json:"backend_bck,omitempty"`
+		Versioning   *VersionConfToSet     `json:"versioning,omitempty"`
+		Cksum        *CksumConfToSet       `json:"checksum,omitempty"`
+		LRU          *LRUConfToSet         `json:"lru,omitempty"`
+		Mirror       *MirrorConfToSet      `json:"mirror,omitempty"`
+		EC           *ECConfToSet          `json:"ec,omitempty"`
+		Access       *apc.AccessAttrs      `json:"access,string,omitempty"`
+		WritePolicy  *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		Extra        *ExtraToSet           `json:"extra,omitempty"`
+		Quota        *QuotaConfToSet       `json:"quota,omitempty"`
+		ListCache    *LsoCacheConfToSet    `json:"list_cache,omitempty"`
+		RateLimit    *RateLimitConfToSet   `json:"rate_limit,omitempty"`
+		Tiering      *TieringConfToSet     `json:"tiering,omitempty"`
+		StorageClass *string               `json:"storage_class,omitempty"`
+		Force        bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
 	BackendBckToSet struct {
@@ -163,6 +270,7 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 		Access:      apc.AccessAll,
 		EC:          c.EC,
 		WritePolicy: wp,
+		ListCache:   LsoCacheConf{TTL: cos.Duration(DefaultLsoCacheTTL)},
 	}
 }
 
@@ -203,7 +311,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 		}
 	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Quota, &bp.LRU, &bp.ListCache, &bp.RateLimit, &bp.Tiering} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -222,6 +330,11 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		return fmt.Errorf("cannot enable mirroring and ec at the same time for the same bucket")
 	}
+	if bp.StorageClass != "" {
+		if err := ValidateMpathLabel(bp.StorageClass); err != nil {
+			return fmt.Errorf("invalid storage_class: %v", err)
+		}
+	}
 	return softErr
 }
 
@@ -253,6 +366,99 @@ func NewBpropsToSet(nvs cos.StrKVs) (props *BpropsToSet, err error) {
 	return
 }
 
+///////////////
+// QuotaConf //
+///////////////
+
+// (see ErrQuotaExceeded)
+const (
+	QuotaSize    = "size"
+	QuotaObjects = "objects"
+)
+
+func (c *QuotaConf) IsSet() bool { return c.MaxSize > 0 || c.MaxObjects > 0 }
+
+func (c *QuotaConf) ValidateAsProps(...any) error {
+	if c.MaxSize < 0 {
+		return fmt.Errorf("invalid quota.max_size=%d (expecting non-negative)", c.MaxSize)
+	}
+	if c.MaxObjects < 0 {
+		return fmt.Errorf("invalid quota.max_objects=%d (expecting non-negative)", c.MaxObjects)
+	}
+	return nil
+}
+
+///////////////////
+// RateLimitConf //
+///////////////////
+
+func (c *RateLimitConf) IsSet() bool { return c.MaxOpsPerSec > 0 || c.MaxBandwidth > 0 }
+
+func (c *RateLimitConf) ValidateAsProps(...any) error {
+	return validateRateLimit("rate_limit", c.MaxOpsPerSec, c.MaxBandwidth)
+}
+
+func validateRateLimit(section string, ops int, bw cos.SizeIEC) error {
+	if ops < 0 {
+		return fmt.Errorf("invalid %s.max_ops_per_sec=%d (expecting non-negative)", section, ops)
+	}
+	if bw < 0 {
+		return fmt.Errorf("invalid %s.max_bandwidth=%d (expecting non-negative)", section, bw)
+	}
+	return nil
+}
+
+/////////////////
+// TieringConf //
+/////////////////
+
+const DefaultTierInterval = time.Hour
+
+func (c *TieringConf) ValidateAsProps(...any) error {
+	if c.Age < 0 || c.Interval < 0 {
+		return fmt.Errorf("invalid tiering.age=%v or tiering.interval=%v (expecting non-negative)", c.Age, c.Interval)
+	}
+	if !c.Enabled {
+		return nil
+	}
+	if c.Age == 0 {
+		return errors.New("tiering.age must be specified (and positive) when tiering is enabled")
+	}
+	_, err := c.DstBck()
+	return err
+}
+
+func (c *TieringConf) DstBck() (Bck, error) {
+	bck, objName, err := ParseBckObjectURI(c.Dst, ParseURIOpts{})
+	if err != nil {
+		return bck, fmt.Errorf("invalid tiering.dst %q: %w", c.Dst, err)
+	}
+	if objName != "" || bck.Name == "" {
+		return bck, fmt.Errorf("invalid tiering.dst %q (expecting bucket, e.g. \"ais://cold\")", c.Dst)
+	}
+	return bck, nil
+}
+
+func (c *TieringConf) IntervalD() time.Duration {
+	if c.Interval == 0 {
+		return DefaultTierInterval
+	}
+	return c.Interval.D()
+}
+
+//////////////////
+// LsoCacheConf //
+//////////////////
+
+const DefaultLsoCacheTTL = time.Hour
+
+func (c *LsoCacheConf) ValidateAsProps(...any) error {
+	if c.TTL < 0 {
+		return fmt.Errorf("invalid list_cache.ttl=%v (expecting non-negative)", c.TTL)
+	}
+	return nil
+}
+
 func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	provider, ok := arg[0].(string)
 	debug.Assert(ok)
@@ -261,10 +467,23 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 		if c.HDFS.RefDirectory == "" {
 			return fmt.Errorf("reference directory must be set for a bucket with HDFS provider")
 		}
+	case apc.POSIX:
+		if c.POSIX.RootDir == "" {
+			return fmt.Errorf("root directory must be set for a bucket with POSIX provider")
+		}
+		if !filepath.IsAbs(c.POSIX.RootDir) {
+			return fmt.Errorf("root directory %q of a bucket with POSIX provider must be absolute", c.POSIX.RootDir)
+		}
 	case apc.HTTP:
 		if c.HTTP.OrigURLBck == "" {
 			return fmt.Errorf("original bucket URL must be set for a bucket with HTTP provider")
 		}
+		switch c.HTTP.Listing {
+		case "", HTTPListingWebDAV, HTTPListingIndex:
+		default:
+			return fmt.Errorf("invalid extra.http.listing %q (expecting one of: %q, %q, or empty)",
+				c.HTTP.Listing, HTTPListingWebDAV, HTTPListingIndex)
+		}
 	}
 	return nil
 }
@@ -312,6 +531,8 @@ func aggr(from, to *BsummResult) {
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
+	to.Quota.Size += from.Quota.Size
+	to.Quota.Objects += from.Quota.Objects
 }
 
 func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
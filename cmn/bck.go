@@ -374,6 +374,7 @@ func (b *Bck) IsAIS() bool {
 func (b *Bck) IsRemoteAIS() bool { return b.Provider == apc.AIS && b.Ns.IsRemote() }
 func (b *Bck) IsHDFS() bool      { return b.Provider == apc.HDFS }
 func (b *Bck) IsHTTP() bool      { return b.Provider == apc.HTTP }
+func (b *Bck) IsPOSIX() bool     { return b.Provider == apc.POSIX }
 
 func (b *Bck) IsRemote() bool {
 	return apc.IsRemoteProvider(b.Provider) || b.IsRemoteAIS() || b.Backend() != nil
@@ -393,7 +394,7 @@ func (b *Bck) IsCloud() bool {
 // A subset of remote backends that maintain assorted items of versioning information -
 // the items including ETag, checksum, etc. - that, in turn, can be used to populate `ObjAttrs`
 // * see related: `ObjAttrs.Equal`
-func (b *Bck) HasVersioningMD() bool { return b.IsCloud() || b.IsRemoteAIS() }
+func (b *Bck) HasVersioningMD() bool { return b.IsCloud() || b.IsRemoteAIS() || b.IsPOSIX() }
 
 func (b *Bck) HasProvider() bool { return b.Provider != "" }
 
@@ -471,6 +472,7 @@ func (qbck QueryBcks) String() string {
 func (qbck *QueryBcks) IsAIS() bool       { b := (*Bck)(qbck); return b.IsAIS() }
 func (qbck *QueryBcks) IsHDFS() bool      { b := (*Bck)(qbck); return b.IsHDFS() }
 func (qbck *QueryBcks) IsHTTP() bool      { b := (*Bck)(qbck); return b.IsHTTP() }
+func (qbck *QueryBcks) IsPOSIX() bool     { b := (*Bck)(qbck); return b.IsPOSIX() }
 func (qbck *QueryBcks) IsRemoteAIS() bool { b := (*Bck)(qbck); return b.IsRemoteAIS() }
 func (qbck *QueryBcks) IsCloud() bool     { return apc.IsCloudProvider(qbck.Provider) }
 
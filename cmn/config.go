@@ -75,7 +75,12 @@ type (
 
 	// ais node: fspaths (a.k.a. mountpaths)
 	FSPConf struct {
-		Paths cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		Paths  cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		Labels cos.StrKVs `json:"labels,omitempty" list:"readonly"` // mountpath => label (e.g. "nvme", "hdd")
+	}
+	// (JSON) per-fspath value, e.g.: "fspaths": {"/ais/nvme0": {"label": "nvme"}, "/ais/sda": {}}
+	fspathConf struct {
+		Label string `json:"label,omitempty"`
 	}
 
 	TestFSPConf struct {
@@ -306,12 +311,26 @@ type (
 		// CapacityUpdTimeStr denotes the frequency at which AIStore updates local capacity utilization
 		CapacityUpdTime cos.Duration `json:"capacity_upd_time"`
 
+		// Policy: the order in which objects get evicted (see LRUPolicy* enum below);
+		// empty value means "atime" (least recently accessed first)
+		Policy string `json:"policy"`
+
+		// TTL: with "ttl" policy, only objects stored (via cold GET or PUT) more than TTL ago
+		// are eligible for eviction (the oldest first)
+		TTL cos.Duration `json:"ttl"`
+
+		// Pinned: objects with these names or name prefixes are never evicted
+		Pinned []string `json:"pinned"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToSet struct {
 		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty"`
 		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty"`
+		Policy          *string       `json:"policy,omitempty"`
+		TTL             *cos.Duration `json:"ttl,omitempty"`
+		Pinned          *[]string     `json:"pinned,omitempty"`
 		Enabled         *bool         `json:"enabled,omitempty"`
 	}
 
@@ -453,31 +472,53 @@ type (
 		TestFileCount int  `json:"test_files"`  // number of files to read/write
 		ErrorLimit    int  `json:"error_limit"` // exceeding err limit causes disabling mountpath
 		Enabled       bool `json:"enabled"`
+
+		// slow-disk detection: mountpath is flagged as slow when its average IO latency exceeds
+		// `slow_factor` times the median latency of its peers (other mountpaths) for `slow_count`
+		// consecutive samples; zero `slow_factor` disables the detection
+		SlowFactor int    `json:"slow_factor,omitempty"`
+		SlowCount  int    `json:"slow_count,omitempty"`
+		SlowAction string `json:"slow_action,omitempty"` // FshcSlowAlert (default) or FshcSlowReadOnly
 	}
 	FSHCConfToSet struct {
-		TestFileCount *int  `json:"test_files,omitempty"`
-		ErrorLimit    *int  `json:"error_limit,omitempty"`
-		Enabled       *bool `json:"enabled,omitempty"`
+		TestFileCount *int    `json:"test_files,omitempty"`
+		ErrorLimit    *int    `json:"error_limit,omitempty"`
+		Enabled       *bool   `json:"enabled,omitempty"`
+		SlowFactor    *int    `json:"slow_factor,omitempty"`
+		SlowCount     *int    `json:"slow_count,omitempty"`
+		SlowAction    *string `json:"slow_action,omitempty"`
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret    string        `json:"secret"`
+		UserLimit UserLimitConf `json:"user_limit"`
+		Enabled   bool          `json:"enabled"`
 	}
 	AuthConfToSet struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret    *string             `json:"secret,omitempty"`
+		UserLimit *UserLimitConfToSet `json:"user_limit,omitempty"`
+		Enabled   *bool               `json:"enabled,omitempty"`
+	}
+	// Per AuthN user rate limits (zero means "no limit") that apply to each user separately;
+	// same cluster-wide semantics as the bucket's cmn.RateLimitConf
+	UserLimitConf struct {
+		MaxOpsPerSec int         `json:"max_ops_per_sec"`
+		MaxBandwidth cos.SizeIEC `json:"max_bandwidth"` // bytes per second
+	}
+	UserLimitConfToSet struct {
+		MaxOpsPerSec *int         `json:"max_ops_per_sec,omitempty"`
+		MaxBandwidth *cos.SizeIEC `json:"max_bandwidth,omitempty"`
 	}
 
 	// keepalive tracker
 	KeepaliveTrackerConf struct {
-		Name     string       `json:"name"`     // "heartbeat" (other enumerated values TBD)
+		Name     string       `json:"name"`     // KeepaliveHeartbeat or KeepalivePhiAccrual (see below)
 		Interval cos.Duration `json:"interval"` // keepalive interval
-		Factor   uint8        `json:"factor"`   // only average
+		Factor   uint8        `json:"factor"`   // phi-accrual: suspicion threshold (ignored by "heartbeat")
 	}
 	KeepaliveTrackerConfToSet struct {
 		Interval *cos.Duration `json:"interval,omitempty"`
-		Name     *string       `json:"name,omitempty" list:"readonly"`
+		Name     *string       `json:"name,omitempty"`
 		Factor   *uint8        `json:"factor,omitempty"`
 	}
 
@@ -552,6 +593,11 @@ type (
 		HousekeepTime  cos.Duration `json:"hk_time"`
 		MinPctTotal    int          `json:"min_pct_total"`
 		MinPctFree     int          `json:"min_pct_free"`
+		// LOM cache snapshot (target only): how often to persist (per mountpath) the most
+		// recently accessed object metadata that gets reloaded upon restart; zero disables
+		LcacheSnapTime cos.Duration `json:"lcache_snap_time,omitempty"`
+		// max number of LOM cache entries to snapshot per mountpath (zero: default)
+		LcacheSnapMax int64 `json:"lcache_snap_max,omitempty"`
 	}
 	MemsysConfToSet struct {
 		MinFree        *cos.SizeIEC  `json:"min_free,omitempty"`
@@ -560,6 +606,8 @@ type (
 		HousekeepTime  *cos.Duration `json:"hk_time,omitempty"`
 		MinPctTotal    *int          `json:"min_pct_total,omitempty"`
 		MinPctFree     *int          `json:"min_pct_free,omitempty"`
+		LcacheSnapTime *cos.Duration `json:"lcache_snap_time,omitempty"`
+		LcacheSnapMax  *int64        `json:"lcache_snap_max,omitempty"`
 	}
 
 	TCBConf struct {
@@ -624,6 +672,7 @@ var (
 	_ Validator = (*ECConf)(nil)
 	_ Validator = (*VersionConf)(nil)
 	_ Validator = (*KeepaliveConf)(nil)
+	_ Validator = (*FSHCConf)(nil)
 	_ Validator = (*PeriodConf)(nil)
 	_ Validator = (*TimeoutConf)(nil)
 	_ Validator = (*ClientConf)(nil)
@@ -636,9 +685,11 @@ var (
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*UserLimitConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
+	_ PropsValidator = (*LRUConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
 	_ PropsValidator = (*WritePolicyConf)(nil)
@@ -731,14 +782,21 @@ func (c *LocalConfig) TestingEnv() bool {
 	return c.TestFSP.Count > 0
 }
 
-func (c *LocalConfig) AddPath(mpath string) {
+func (c *LocalConfig) AddPath(mpath, label string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Set(mpath)
+	if label != "" {
+		if c.FSP.Labels == nil {
+			c.FSP.Labels = make(cos.StrKVs, 1)
+		}
+		c.FSP.Labels[mpath] = label
+	}
 }
 
 func (c *LocalConfig) DelPath(mpath string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Delete(mpath)
+	delete(c.FSP.Labels, mpath)
 }
 
 ////////////////
@@ -998,18 +1056,59 @@ func (c *SpaceConf) String() string {
 // LRUConf //
 /////////////
 
+// LRU eviction policies
+const (
+	LRUPolicyAtime = "atime" // least recently accessed first (default)
+	LRUPolicySize  = "size"  // largest and least recently accessed first (size x time since last access)
+	LRUPolicyLFU   = "lfu"   // least frequently accessed first
+	LRUPolicyTTL   = "ttl"   // stored (cold GET or PUT) more than lru.ttl ago, the oldest first
+)
+
+var SupportedLRUPolicies = []string{LRUPolicyAtime, LRUPolicySize, LRUPolicyLFU, LRUPolicyTTL}
+
 func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return "Disabled"
 	}
-	return fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	s := fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	if c.Policy != "" && c.Policy != LRUPolicyAtime {
+		s += ", lru.policy=" + c.Policy
+	}
+	return s
 }
 
 func (c *LRUConf) Validate() (err error) {
 	if c.CapacityUpdTime.D() < 10*time.Second {
-		err = fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
+		return fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
 	}
-	return
+	return c.validatePolicy()
+}
+
+func (c *LRUConf) ValidateAsProps(...any) error { return c.validatePolicy() }
+
+func (c *LRUConf) validatePolicy() error {
+	if c.Policy != "" && !cos.StringInSlice(c.Policy, SupportedLRUPolicies) {
+		return fmt.Errorf("invalid lru.policy %q (expecting one of: %v)", c.Policy, SupportedLRUPolicies)
+	}
+	if c.Policy == LRUPolicyTTL && c.TTL <= 0 {
+		return fmt.Errorf("invalid lru.ttl %v (must be positive with %q policy)", c.TTL, LRUPolicyTTL)
+	}
+	for _, prefix := range c.Pinned {
+		if prefix == "" {
+			return errors.New("invalid lru.pinned: empty name (prefix)")
+		}
+	}
+	return nil
+}
+
+// returns true if the object is pinned (never to be evicted)
+func (c *LRUConf) IsPinned(objName string) bool {
+	for _, prefix := range c.Pinned {
+		if strings.HasPrefix(objName, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 ///////////////
@@ -1188,21 +1287,100 @@ func (c *WritePolicyConf) Validate() (err error) {
 
 func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 
+///////////////////
+// UserLimitConf //
+///////////////////
+
+func (c *UserLimitConf) IsSet() bool { return c.MaxOpsPerSec > 0 || c.MaxBandwidth > 0 }
+
+func (c *UserLimitConf) Validate() error {
+	return validateRateLimit("auth.user_limit", c.MaxOpsPerSec, c.MaxBandwidth)
+}
+
+//////////////
+// FSHCConf //
+//////////////
+
+// slow mountpath actions
+const (
+	FshcSlowAlert    = "alert"    // log, count (`err.slow.mpath.n`), and show via 'ais storage mountpath'
+	FshcSlowReadOnly = "readonly" // all of the above, and mark the mountpath read-only (resilvering its content away)
+
+	FshcDfltSlowCount = 6
+)
+
+func (c *FSHCConf) Validate() error {
+	if c.SlowFactor == 0 {
+		return nil
+	}
+	if c.SlowFactor < 2 || c.SlowFactor > 1000 {
+		return fmt.Errorf("invalid fshc.slow_factor %d (expecting 2 thru 1000, or zero to disable)", c.SlowFactor)
+	}
+	if c.SlowCount == 0 {
+		c.SlowCount = FshcDfltSlowCount
+	}
+	if c.SlowCount < 0 {
+		return fmt.Errorf("invalid fshc.slow_count %d", c.SlowCount)
+	}
+	switch c.SlowAction {
+	case "":
+		c.SlowAction = FshcSlowAlert
+	case FshcSlowAlert, FshcSlowReadOnly:
+	default:
+		return fmt.Errorf("invalid fshc.slow_action %q (expecting %q or %q)", c.SlowAction, FshcSlowAlert, FshcSlowReadOnly)
+	}
+	return nil
+}
+
 ///////////////////
 // KeepaliveConf //
 ///////////////////
 
+// keepalive trackers
+const (
+	// fixed cutoff: suspect a node that didn't send keepalive within the interval
+	KeepaliveHeartbeat = "heartbeat"
+
+	// adaptive (Hayashibara et al., "The phi accrual failure detector"):
+	// learn the distribution of keepalive inter-arrival times and suspect a node
+	// when phi = -log10(P(next keepalive is still to come)) exceeds the threshold (factor)
+	KeepalivePhiAccrual = "phi"
+
+	KeepaliveDfltPhiThreshold = 8 // P(false suspicion) ~= 10^-8
+	keepaliveMaxPhiThreshold  = 16
+)
+
 func (c *KeepaliveConf) Validate() (err error) {
-	if c.Proxy.Name != "heartbeat" {
-		err = fmt.Errorf("invalid keepalivetracker.proxy.name %s", c.Proxy.Name)
-	} else if c.Target.Name != "heartbeat" {
-		err = fmt.Errorf("invalid keepalivetracker.target.name %s", c.Target.Name)
-	} else if c.RetryFactor < 1 || c.RetryFactor > 10 {
+	if err = c.Proxy.validate("proxy"); err != nil {
+		return err
+	}
+	if err = c.Target.validate("target"); err != nil {
+		return err
+	}
+	if c.RetryFactor < 1 || c.RetryFactor > 10 {
 		err = fmt.Errorf("invalid keepalivetracker.retry_factor %d (expecting 1 thru 10)", c.RetryFactor)
 	}
 	return
 }
 
+func (c *KeepaliveTrackerConf) validate(tag string) error {
+	switch c.Name {
+	case KeepaliveHeartbeat:
+	case KeepalivePhiAccrual:
+		if c.Factor == 0 {
+			c.Factor = KeepaliveDfltPhiThreshold
+		}
+		if c.Factor > keepaliveMaxPhiThreshold {
+			return fmt.Errorf("invalid keepalivetracker.%s.factor %d (phi threshold, expecting 1 thru %d)",
+				tag, c.Factor, keepaliveMaxPhiThreshold)
+		}
+	default:
+		return fmt.Errorf("invalid keepalivetracker.%s.name %q (expecting %q or %q)",
+			tag, c.Name, KeepaliveHeartbeat, KeepalivePhiAccrual)
+	}
+	return nil
+}
+
 func KeepaliveRetryDuration(c *Config) time.Duration {
 	d := c.Timeout.CplaneOperation.D() * time.Duration(c.Keepalive.RetryFactor)
 	return min(d, c.Timeout.MaxKeepalive.D()+time.Second/2)
@@ -1347,19 +1525,36 @@ func (c *DsortConf) ValidateWithOpts(allowEmpty bool) (err error) {
 /////////////
 
 func (c *FSPConf) UnmarshalJSON(data []byte) (err error) {
-	m := cos.NewStrSet()
+	m := make(map[string]fspathConf)
 	err = jsoniter.Unmarshal(data, &m)
 	if err != nil {
 		return
 	}
-	c.Paths = m
+	c.Paths = make(cos.StrSet, len(m))
+	c.Labels = nil
+	for fspath, v := range m {
+		c.Paths.Set(fspath)
+		if v.Label != "" {
+			if c.Labels == nil {
+				c.Labels = make(cos.StrKVs, 2)
+			}
+			c.Labels[fspath] = v.Label
+		}
+	}
 	return
 }
 
 func (c *FSPConf) MarshalJSON() (data []byte, err error) {
-	return cos.MustMarshal(c.Paths), nil
+	m := make(map[string]fspathConf, len(c.Paths))
+	for fspath := range c.Paths {
+		m[fspath] = fspathConf{Label: c.Labels[fspath]}
+	}
+	return cos.MustMarshal(m), nil
 }
 
+// returns the configured label of a given mountpath (empty string if none)
+func (c *FSPConf) Label(mpath string) string { return c.Labels[mpath] }
+
 func (c *FSPConf) Validate(contextConfig *Config) error {
 	debug.Assertf(cos.StringInSlice(contextConfig.role, []string{apc.Proxy, apc.Target}),
 		"unexpected role: %q", contextConfig.role)
@@ -1372,12 +1567,24 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		return NewErrInvalidFSPathsConf(ErrNoMountpaths)
 	}
 
-	cleanMpaths := make(map[string]struct{})
+	var (
+		cleanMpaths = make(map[string]struct{})
+		cleanLabels cos.StrKVs
+	)
 	for fspath := range c.Paths {
 		mpath, err := ValidateMpath(fspath)
 		if err != nil {
 			return err
 		}
+		if label, ok := c.Labels[fspath]; ok {
+			if err := ValidateMpathLabel(label); err != nil {
+				return NewErrInvalidFSPathsConf(fmt.Errorf("%q: %v", fspath, err))
+			}
+			if cleanLabels == nil {
+				cleanLabels = make(cos.StrKVs, len(c.Labels))
+			}
+			cleanLabels[mpath] = label
+		}
 		l := len(mpath)
 		// disallow mountpath nesting
 		for mpath2 := range cleanMpaths {
@@ -1391,7 +1598,15 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		}
 		cleanMpaths[mpath] = struct{}{}
 	}
-	c.Paths = cleanMpaths
+	c.Paths, c.Labels = cleanMpaths, cleanLabels
+	return nil
+}
+
+// mountpath label (and, respectively, bucket storage class - see Bprops.StorageClass)
+func ValidateMpathLabel(label string) error {
+	if !cos.IsAlphaNice(label) {
+		return fmt.Errorf("invalid mountpath label %q (expecting letters, digits, dashes and underscores)", label)
+	}
 	return nil
 }
 
@@ -1493,6 +1708,12 @@ func (c *MemsysConf) Validate() (err error) {
 	if c.MinPctFree < 0 || c.MinPctFree > 95 {
 		return fmt.Errorf("invalid memsys.min_pct_free %d%%", c.MinPctFree)
 	}
+	if d := c.LcacheSnapTime.D(); d != 0 && (d < time.Minute || d > 24*time.Hour) {
+		return fmt.Errorf("invalid memsys.lcache_snap_time %s (expected zero (disabled) or range [1m, 24h])", c.LcacheSnapTime)
+	}
+	if c.LcacheSnapMax < 0 {
+		return fmt.Errorf("invalid memsys.lcache_snap_max %d (expecting non-negative)", c.LcacheSnapMax)
+	}
 	return nil
 }
 
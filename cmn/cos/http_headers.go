@@ -35,11 +35,12 @@ const (
 	HdrContentLength      = "Content-Length"
 
 	// misc. gen
-	HdrUserAgent = "User-Agent"
-	HdrAccept    = "Accept"
-	HdrLocation  = "Location"
-	HdrServer    = "Server"
-	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrUserAgent  = "User-Agent"
+	HdrAccept     = "Accept"
+	HdrLocation   = "Location"
+	HdrServer     = "Server"
+	HdrETag       = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrRetryAfter = "Retry-After"
 )
 
 //
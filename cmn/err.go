@@ -101,6 +101,18 @@ type (
 		usedPct        int32
 		oos            bool
 	}
+	ErrQuotaExceeded struct {
+		node  string // target that enforces its share of the quota
+		bck   Bck
+		what  string // "size" | "objects"
+		used  int64  // this target's usage
+		share int64  // this target's share of the limit
+		limit int64  // bucket (cluster-wide) limit
+	}
+	ErrRateLimited struct {
+		what  string // bucket or user
+		limit int
+	}
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
 	errAccessDenied       struct {
@@ -429,6 +441,41 @@ func IsErrCapExceeded(err error) bool {
 	return ok
 }
 
+// ErrQuotaExceeded
+
+func NewErrQuotaExceeded(node string, bck *Bck, what string, used, share, limit int64) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{node: node, bck: *bck, what: what, used: used, share: share, limit: limit}
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	if e.what == QuotaSize {
+		return fmt.Sprintf("%s: bucket %s quota exceeded (size %s, this target's share %s of the %s limit)",
+			e.node, e.bck.Cname(""), cos.ToSizeIEC(e.used, 2), cos.ToSizeIEC(e.share, 2), cos.ToSizeIEC(e.limit, 2))
+	}
+	return fmt.Sprintf("%s: bucket %s quota exceeded (%d objects, this target's share %d of the %d limit)",
+		e.node, e.bck.Cname(""), e.used, e.share, e.limit)
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	_, ok := err.(*ErrQuotaExceeded)
+	return ok
+}
+
+// ErrRateLimited
+
+func NewErrRateLimited(what string, limit int) *ErrRateLimited {
+	return &ErrRateLimited{what: what, limit: limit}
+}
+
+func (e *ErrRateLimited) Error() string {
+	return fmt.Sprintf("%s: request rate limit exceeded (max %d requests per second)", e.what, e.limit)
+}
+
+func IsErrRateLimited(err error) bool {
+	_, ok := err.(*ErrRateLimited)
+	return ok
+}
+
 // ErrInvalidCksum
 
 func (e *ErrInvalidCksum) Error() string {
@@ -713,6 +760,15 @@ func (e *ErrLimitedCoexistence) Error() string {
 		e.node, e.xaction, e.action, e.detail)
 }
 
+// (also, when received from a remote node)
+func IsErrLimitedCoexistence(err error) bool {
+	if _, ok := err.(*ErrLimitedCoexistence); ok {
+		return true
+	}
+	herr := Err2HTTPErr(err)
+	return herr != nil && herr.TypeCode == "ErrLimitedCoexistence"
+}
+
 ////////////////////
 // ErrXactUsePrev //
 ////////////////////
@@ -1035,8 +1091,10 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		status = errf.status
 	} else if isErrNotFoundExtended(err, status) {
 		status = http.StatusNotFound
-	} else if IsErrCapExceeded(err) {
+	} else if IsErrCapExceeded(err) || IsErrQuotaExceeded(err) {
 		status = http.StatusInsufficientStorage
+	} else if IsErrRateLimited(err) {
+		status = http.StatusTooManyRequests
 	}
 
 	herr.init(r, err, status)
@@ -49,4 +49,10 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+
+	// Checkpoints of resumable xactions: per mountpath
+	CheckpointsDir = ".ais.checkpoints"
+
+	// LOM cache snapshot: per mountpath
+	LcacheSnap = ".ais.lcache"
 )
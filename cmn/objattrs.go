@@ -35,6 +35,10 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// tiering: the object has been moved to the destination ("cold") bucket, leaving behind a stub
+	TierObjMD     = "tier"      // destination bucket (cname)
+	TierSizeObjMD = "tier_size" // size of the tiered-out object
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -108,6 +112,20 @@ func S2CustomMD(custom, version string) (md cos.StrKVs) {
 	return md
 }
 
+// the value of a given key in the custom metadata string (see CustomMD2S above)
+func S2CustomVal(custom, key string) (string, bool) {
+	if len(custom) < 8 || !strings.HasPrefix(custom, "map[") {
+		return "", false
+	}
+	var (
+		lst = strings.Split(custom[4:len(custom)-1], " ")
+		md  = make(cos.StrKVs, 1)
+	)
+	parseCustom(md, lst, key)
+	val, ok := md[key]
+	return val, ok
+}
+
 func parseCustom(md cos.StrKVs, lst []string, key string) {
 	keyX := key + ":"
 	for _, kv := range lst {
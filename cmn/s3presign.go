@@ -0,0 +1,253 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4 (SigV4) query-string authentication ("presigned URLs"):
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+// Used by clients to generate time-limited links, and by AIS gateways to validate them.
+
+const (
+	S3SigV4Algo     = "AWS4-HMAC-SHA256"
+	S3SigV4Service  = "s3"
+	S3SigV4Term     = "aws4_request"
+	S3UnsignedBody  = "UNSIGNED-PAYLOAD"
+	S3DfltRegion    = "us-east-1"
+	S3MaxPresignExp = 7 * 24 * time.Hour // (AWS limit)
+
+	s3AmzDateFmt = "20060102T150405Z"
+	s3DateFmt    = "20060102"
+)
+
+// presigned URL query parameters
+const (
+	S3QparamAlgo          = "X-Amz-Algorithm"
+	S3QparamCredential    = "X-Amz-Credential"
+	S3QparamDate          = "X-Amz-Date"
+	S3QparamExpires       = "X-Amz-Expires"
+	S3QparamSignedHeaders = "X-Amz-SignedHeaders"
+	S3QparamSecurityToken = "X-Amz-Security-Token"
+	S3QparamSignature     = "X-Amz-Signature"
+)
+
+type (
+	// (no session token: AIS gateways resolve the access key server-side)
+	S3Creds struct {
+		AccessKey string
+		SecretKey string
+	}
+	// parsed presigned request
+	S3Presigned struct {
+		Date          time.Time
+		AccessKey     string
+		Scope         string // <date>/<region>/s3/aws4_request
+		Region        string
+		SessionToken  string // (not supported - see S3Creds)
+		Signature     string
+		SignedHeaders []string
+		Expires       time.Duration
+	}
+)
+
+// S3Presign adds SigV4 query parameters (including signature) to the given URL
+// that, in turn, must include the full path, e.g. "http://host:8080/s3/bucket/object".
+func S3Presign(method string, u *url.URL, creds *S3Creds, region string, expires time.Duration, now time.Time) error {
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return errors.New("presign: access key and secret key are required")
+	}
+	if expires <= 0 || expires > S3MaxPresignExp {
+		return fmt.Errorf("presign: invalid expiration %v (expecting positive duration up to %v)", expires, S3MaxPresignExp)
+	}
+	if region == "" {
+		region = S3DfltRegion
+	}
+	now = now.UTC()
+	u.RawPath = s3Escape(u.Path, false /*slash*/) // (strict; compare w/ url.PathEscape)
+	scope := s3Scope(now, region)
+	q := u.Query()
+	q.Set(S3QparamAlgo, S3SigV4Algo)
+	q.Set(S3QparamCredential, creds.AccessKey+"/"+scope)
+	q.Set(S3QparamDate, now.Format(s3AmzDateFmt))
+	q.Set(S3QparamExpires, strconv.FormatInt(int64(expires/time.Second), 10))
+	q.Set(S3QparamSignedHeaders, "host")
+	q.Del(S3QparamSignature)
+	u.RawQuery = s3CanonicalQuery(q)
+
+	hdr := http.Header{}
+	sig := s3Signature(method, u.Host, u.EscapedPath(), u.RawQuery, []string{"host"}, hdr, creds.SecretKey, now, scope, region)
+	u.RawQuery += "&" + S3QparamSignature + "=" + sig
+	return nil
+}
+
+// IsS3Presigned returns true if the request carries SigV4 query parameters.
+func IsS3Presigned(q url.Values) bool { return q.Get(S3QparamAlgo) != "" }
+
+// ParseS3Presigned parses (but does not verify) presigned request's query.
+func ParseS3Presigned(q url.Values) (ps *S3Presigned, err error) {
+	if algo := q.Get(S3QparamAlgo); algo != S3SigV4Algo {
+		return nil, fmt.Errorf("presigned: unsupported algorithm %q", algo)
+	}
+	ps = &S3Presigned{SessionToken: q.Get(S3QparamSecurityToken), Signature: q.Get(S3QparamSignature)}
+	// <access-key>/<date>/<region>/s3/aws4_request
+	cred := strings.Split(q.Get(S3QparamCredential), "/")
+	if len(cred) != 5 || cred[0] == "" || cred[3] != S3SigV4Service || cred[4] != S3SigV4Term {
+		return nil, fmt.Errorf("presigned: invalid credential %q", q.Get(S3QparamCredential))
+	}
+	ps.AccessKey, ps.Region = cred[0], cred[2]
+	ps.Scope = strings.Join(cred[1:], "/")
+	if ps.Date, err = time.Parse(s3AmzDateFmt, q.Get(S3QparamDate)); err != nil {
+		return nil, fmt.Errorf("presigned: invalid date %q", q.Get(S3QparamDate))
+	}
+	if ps.Date.Format(s3DateFmt) != cred[1] {
+		return nil, fmt.Errorf("presigned: date %q does not match credential scope %q", q.Get(S3QparamDate), ps.Scope)
+	}
+	secs, err := strconv.ParseInt(q.Get(S3QparamExpires), 10, 64)
+	if err != nil || secs <= 0 || time.Duration(secs)*time.Second > S3MaxPresignExp {
+		return nil, fmt.Errorf("presigned: invalid expiration %q", q.Get(S3QparamExpires))
+	}
+	ps.Expires = time.Duration(secs) * time.Second
+	if ps.Signature == "" {
+		return nil, errors.New("presigned: missing signature")
+	}
+	ps.SignedHeaders = strings.Split(q.Get(S3QparamSignedHeaders), ";")
+	if !sort.StringsAreSorted(ps.SignedHeaders) {
+		return nil, fmt.Errorf("presigned: signed headers %q are not sorted", q.Get(S3QparamSignedHeaders))
+	}
+	return ps, nil
+}
+
+// Verify checks expiration and recomputes the signature using the given secret key.
+func (ps *S3Presigned) Verify(r *http.Request, secretKey string, now time.Time) error {
+	const skew = 5 * time.Minute
+	switch {
+	case now.Before(ps.Date.Add(-skew)):
+		return errors.New("presigned: request is not yet valid")
+	case now.After(ps.Date.Add(ps.Expires)):
+		return errors.New("presigned: request has expired")
+	}
+	// path as sent (server-side, r.URL.Path may have been rewritten by the time we get here)
+	path := r.URL.EscapedPath()
+	if p, _, _ := strings.Cut(r.RequestURI, "?"); strings.HasPrefix(p, "/") {
+		path = p
+	}
+	q := r.URL.Query()
+	q.Del(S3QparamSignature)
+	sig := s3Signature(r.Method, r.Host, path, s3CanonicalQuery(q), ps.SignedHeaders, r.Header, secretKey,
+		ps.Date, ps.Scope, ps.Region)
+	if !hmac.Equal([]byte(sig), []byte(ps.Signature)) {
+		return errors.New("presigned: signature does not match")
+	}
+	return nil
+}
+
+//
+// internal
+//
+
+func s3Scope(t time.Time, region string) string {
+	return t.Format(s3DateFmt) + "/" + region + "/" + S3SigV4Service + "/" + S3SigV4Term
+}
+
+func s3Signature(method, host, path, query string, signed []string, hdr http.Header, secretKey string,
+	t time.Time, scope, region string) string {
+	var sb strings.Builder
+	// canonical request
+	sb.WriteString(method)
+	sb.WriteByte('\n')
+	sb.WriteString(path)
+	sb.WriteByte('\n')
+	sb.WriteString(query)
+	sb.WriteByte('\n')
+	for _, name := range signed {
+		var v string
+		if name == "host" {
+			v = host
+		} else {
+			v = strings.Join(hdr.Values(name), ",")
+		}
+		sb.WriteString(name)
+		sb.WriteByte(':')
+		sb.WriteString(strings.TrimSpace(v))
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(signed, ";"))
+	sb.WriteByte('\n')
+	sb.WriteString(S3UnsignedBody)
+	creq := sha256.Sum256([]byte(sb.String()))
+
+	// string to sign
+	sts := S3SigV4Algo + "\n" + t.UTC().Format(s3AmzDateFmt) + "\n" + scope + "\n" + hex.EncodeToString(creq[:])
+
+	// signing key
+	key := s3HMAC([]byte("AWS4"+secretKey), t.UTC().Format(s3DateFmt))
+	key = s3HMAC(key, region)
+	key = s3HMAC(key, S3SigV4Service)
+	key = s3HMAC(key, S3SigV4Term)
+	return hex.EncodeToString(s3HMAC(key, sts))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// sorted by key, with keys and values escaped
+func s3CanonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		vs := append([]string(nil), q[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			if sb.Len() > 0 {
+				sb.WriteByte('&')
+			}
+			sb.WriteString(s3Escape(k, true))
+			sb.WriteByte('=')
+			sb.WriteString(s3Escape(v, true))
+		}
+	}
+	return sb.String()
+}
+
+// URI-encode all but unreserved characters (and, optionally, '/')
+func s3Escape(s string, slash bool) string {
+	const hexd = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !slash:
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hexd[c>>4])
+			sb.WriteByte(hexd[c&15])
+		}
+	}
+	return sb.String()
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
 )
 
 func TestConfigTestEnv(t *testing.T) {
@@ -52,6 +54,13 @@ func TestConfigFSPaths(t *testing.T) {
 	for p := range mpaths {
 		tassert.Fatalf(t, newConfig.FSP.Paths.Contains(p), "%q not in config FSP", p)
 	}
+
+	// labels
+	tassert.Fatalf(t, newConfig.FSP.Label("/tmp/ais/3") == "nvme", "expecting %q labeled, got %v", "/tmp/ais/3", newConfig.FSP.Labels)
+	tassert.Fatalf(t, len(newConfig.FSP.Labels) == 1, "expecting a single label, got %v", newConfig.FSP.Labels)
+	var fsp cmn.FSPConf
+	tassert.CheckFatal(t, jsoniter.Unmarshal(cos.MustMarshal(&newConfig.FSP), &fsp))
+	tassert.Fatalf(t, len(fsp.Paths) == len(mpaths) && fsp.Label("/tmp/ais/3") == "nvme", "round-trip: %+v", fsp)
 }
 
 func thisFileDir(t *testing.T) string {
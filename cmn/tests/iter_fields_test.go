@@ -90,11 +90,31 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           false,
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
+					"lru.policy":            "",
+					"lru.ttl":               cos.Duration(0),
+					"lru.pinned":            []string(nil),
 
 					"extra.aws.cloud_region": "us-central",
 					"extra.aws.endpoint":     "",
 					"extra.aws.profile":      "",
 
+					"quota.max_size":    cos.SizeIEC(0),
+					"quota.max_objects": int64(0),
+
+					"list_cache.enabled": false,
+					"list_cache.ttl":     cos.Duration(0),
+
+					"rate_limit.max_ops_per_sec": 0,
+					"rate_limit.max_bandwidth":   cos.SizeIEC(0),
+					"rate_limit.high_priority":   false,
+
+					"tiering.dst":      "",
+					"tiering.age":      cos.Duration(0),
+					"tiering.interval": cos.Duration(0),
+					"tiering.enabled":  false,
+
+					"storage_class": "",
+
 					"access":  apc.AccessAttrs(0),
 					"created": int64(0),
 
@@ -146,6 +166,26 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           (*bool)(nil),
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
 					"lru.capacity_upd_time": (*cos.Duration)(nil),
+					"lru.policy":            (*string)(nil),
+					"lru.ttl":               (*cos.Duration)(nil),
+					"lru.pinned":            (*[]string)(nil),
+
+					"quota.max_size":    (*cos.SizeIEC)(nil),
+					"quota.max_objects": (*int64)(nil),
+
+					"list_cache.enabled": (*bool)(nil),
+					"list_cache.ttl":     (*cos.Duration)(nil),
+
+					"rate_limit.max_ops_per_sec": (*int)(nil),
+					"rate_limit.max_bandwidth":   (*cos.SizeIEC)(nil),
+					"rate_limit.high_priority":   (*bool)(nil),
+
+					"tiering.dst":      (*string)(nil),
+					"tiering.age":      (*cos.Duration)(nil),
+					"tiering.interval": (*cos.Duration)(nil),
+					"tiering.enabled":  (*bool)(nil),
+
+					"storage_class": (*string)(nil),
 
 					"access": apc.AccAttrs(1024),
 
@@ -153,10 +193,15 @@ var _ = Describe("IterFields", func() {
 					"write_policy.md":   apc.WPolicy(apc.WriteDelayed),
 
 					"extra.hdfs.ref_directory": (*string)(nil),
+					"extra.posix.root_dir":     (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
 					"extra.aws.profile":        (*string)(nil),
+					"extra.gcp.location":       (*string)(nil),
+					"extra.gcp.storage_class":  (*string)(nil),
 					"extra.http.original_url":  (*string)(nil),
+					"extra.http.listing":       (*string)(nil),
+					"extra.http.writable":      (*bool)(nil),
 				},
 			),
 			Entry("check for omit tag",
@@ -39,7 +39,8 @@ const (
 	MetaverVMD   = 1 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
 
-	MetaverLOM = 1 // LOM
+	MetaverLOM   = 2 // LOM (v2: unknown records are skipped - see core/lom_xattr.go)
+	MetaverLOMv1 = 1 // ditto, when there's nothing to store that v1 does not have (e.g., access counter)
 
 	MetaverConfig      = 3 // Global Configuration (jsp)
 	MetaverAuthNConfig = 1 // Authn config (jsp) // ditto
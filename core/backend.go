@@ -27,6 +27,7 @@ type (
 		Provider() string
 		MaxPageSize() uint
 		CreateBucket(bck *meta.Bck) (errCode int, err error)
+		DestroyBucket(bck *meta.Bck) (errCode int, err error)
 		ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (errCode int, err error)
 		ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error)
 		PutObj(r io.ReadCloser, lom *LOM) (errCode int, err error)
@@ -99,7 +99,9 @@ func NewCTFromFQN(fqn string, b meta.Bowner) (ct *CT, err error) {
 		digest:      parsedFQN.Digest,
 	}
 	if b != nil {
-		err = ct.bck.InitFast(b)
+		if err = ct.bck.InitFast(b); err == nil && storageClass(ct.bck.Bucket()) != "" {
+			ct.hrwFQN, ct.digest, err = HrwFQN(ct.bck.Bucket(), ct.contentType, ct.objName)
+		}
 	}
 	return
 }
@@ -112,7 +114,7 @@ func NewCTFromBO(bck *cmn.Bck, objName string, b meta.Bowner, ctType ...string)
 		}
 	}
 	var digest uint64
-	ct.mi, digest, err = fs.Hrw(ct.bck.MakeUname(objName))
+	ct.mi, digest, err = HrwMpath(ct.bck.Bucket(), ct.bck.MakeUname(objName))
 	if err != nil {
 		return
 	}
@@ -25,12 +25,27 @@ func ResolveFQN(fqn string) (parsedFQN fs.ParsedFQN, hrwFQN string, err error) {
 }
 
 func HrwFQN(bck *cmn.Bck, contentType, objName string) (fqn string, digest uint64, err error) {
-	var (
-		mi    *fs.Mountpath
-		uname = bck.MakeUname(objName)
-	)
-	if mi, digest, err = fs.Hrw(uname); err == nil {
+	var mi *fs.Mountpath
+	if mi, digest, err = HrwMpath(bck, bck.MakeUname(objName)); err == nil {
 		fqn = mi.MakePathFQN(bck, contentType, objName)
 	}
 	return
 }
+
+// HRW mountpath for a given bucket's object (uname) that takes into account the bucket's
+// storage class, if any; uninitialized bucket (nil props) means no placement restrictions
+func HrwMpath(bck *cmn.Bck, uname string) (*fs.Mountpath, uint64, error) {
+	return fs.HrwLabel(uname, storageClass(bck))
+}
+
+// same as above, including read-only mountpaths (see fs.HrwLookup)
+func HrwLookupMpath(bck *cmn.Bck, uname string) (*fs.Mountpath, error) {
+	return fs.HrwLookup(uname, storageClass(bck))
+}
+
+func storageClass(bck *cmn.Bck) string {
+	if bck.Props == nil {
+		return ""
+	}
+	return bck.Props.StorageClass
+}
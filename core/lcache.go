@@ -39,7 +39,8 @@ type lchk struct {
 	evictedCnt   int64
 	flushColdCnt int64
 	// single entry
-	running atomic.Bool
+	running  atomic.Bool
+	snapping atomic.Bool
 }
 
 func regLomCacheWithHK() {
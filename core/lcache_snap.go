@@ -0,0 +1,376 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/OneOfOne/xxhash"
+)
+
+// LOM cache snapshot (see cmn.MemsysConf.LcacheSnapTime)
+//
+// To avoid post-restart latency cliff, target periodically (and upon shutdown) persists
+// the most recently accessed in-memory object metadata - one file per mountpath - and
+// reloads it at startup. Each reloaded entry is validated against the object's current
+// size and ctime: objects (re)written, or otherwise updated, after the snapshot was taken
+// are skipped and get loaded the usual way - upon first access.
+//
+// File format: header (version, snapshot time) followed by records, whereby each record is:
+// [payload length] [uname, bucket ID, atime, marshaled lmeta] [payload checksum]
+
+const (
+	lcsVer      = 1
+	lcsHdrLen   = 1 + cos.SizeofI64
+	lcsDfltMax  = 256 * 1024       // default max number of entries per mountpath
+	lcsIdleTime = 10 * time.Minute // when disabled: check config again in...
+
+	// ctime is maintained using coarse-grained kernel clock
+	lcsSlack = 100 * time.Millisecond
+)
+
+// stop loading under memory pressure
+const lcsCheckMem = 4096
+
+var errLcsCorrupted = errors.New("corrupted lcache snapshot")
+
+type lcsEntry struct {
+	md     *lmeta
+	digest uint64
+	atime  int64
+}
+
+func regLcacheSnapWithHK() {
+	g.lchk.snapping.Store(false)
+	hk.Reg("lcache-snap"+hk.NameSuffix, g.lchk.snapshot, lcsIdleTime)
+}
+
+// via housekeeper
+func (lchk *lchk) snapshot() time.Duration {
+	config := cmn.GCO.Get()
+	d := config.Memsys.LcacheSnapTime.D()
+	if d == 0 {
+		return lcsIdleTime
+	}
+	if lchk.snapping.CAS(false, true) {
+		go func() {
+			SaveLcache(config)
+			lchk.snapping.Store(false)
+		}()
+	}
+	return d
+}
+
+// upon shutdown (see Term)
+func (lchk *lchk) termSnapshot() {
+	const sleep = time.Second >> 2
+	config := cmn.GCO.Get()
+	if config.Memsys.LcacheSnapTime == 0 {
+		return
+	}
+	for i := 0; i < 8 && !lchk.snapping.CAS(false, true); i++ {
+		time.Sleep(sleep)
+	}
+	SaveLcache(config)
+}
+
+//
+// save
+//
+
+// SaveLcache persists (up to `memsys.lcache_snap_max` per mountpath) most recently accessed
+// cached object metadata; returns the total number of saved entries
+func SaveLcache(config *cmn.Config) int {
+	var (
+		avail = fs.GetAvail()
+		maxn  = int(config.Memsys.LcacheSnapMax)
+		total atomic.Int64
+		wg    sync.WaitGroup
+	)
+	if maxn == 0 {
+		maxn = lcsDfltMax
+	}
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			n, err := saveLcache(mi, maxn)
+			if err != nil {
+				nlog.Errorln("failed to save lcache snapshot", mi.String()+":", err)
+			}
+			total.Add(int64(n))
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	n := int(total.Load())
+	if cmn.Rom.FastV(4, cos.SmoduleCluster) {
+		nlog.Infoln("saved lcache snapshot:", n)
+	}
+	return n
+}
+
+func saveLcache(mi *fs.Mountpath, maxn int) (n int, err error) {
+	var (
+		ents    []lcsEntry
+		started = time.Now().UnixNano()
+		fpath   = filepath.Join(mi.Path, fname.LcacheSnap)
+	)
+	for idx := 0; idx < cos.MultiSyncMapCount; idx++ {
+		mi.LomCache(idx).Range(func(hkey, value any) bool {
+			md := value.(*lmeta)
+			atime := md.Atime
+			if atime < 0 {
+				atime = -atime // prefetched, not yet accessed
+			}
+			ents = append(ents, lcsEntry{md: md, digest: hkey.(uint64), atime: atime})
+			return true
+		})
+	}
+	if len(ents) == 0 {
+		return 0, cos.RemoveFile(fpath)
+	}
+	if len(ents) > maxn {
+		sort.Slice(ents, func(i, j int) bool { return ents[i].atime > ents[j].atime })
+		ents = ents[:maxn]
+	}
+
+	var (
+		file *os.File
+		tmp  = fpath + ".tmp"
+	)
+	if file, err = cos.CreateFile(tmp); err != nil {
+		return 0, err
+	}
+	n, err = writeLcache(file, ents, started)
+	if err == nil {
+		err = cos.FlushClose(file)
+	} else {
+		file.Close()
+	}
+	if err == nil {
+		err = cos.Rename(tmp, fpath)
+	}
+	if err != nil {
+		n = 0
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+	}
+	return n, err
+}
+
+func writeLcache(file *os.File, ents []lcsEntry, started int64) (n int, err error) {
+	var (
+		w   = bufio.NewWriterSize(file, memsys.DefaultBufSize)
+		hdr [lcsHdrLen]byte
+		rec []byte
+	)
+	hdr[0] = lcsVer
+	binary.BigEndian.PutUint64(hdr[1:], uint64(started))
+	if _, err = w.Write(hdr[:]); err != nil {
+		return 0, err
+	}
+	for i := range ents {
+		b := ents[i].pack(rec[:0])
+		if b == nil {
+			continue // busy or dirty
+		}
+		rec = b
+		if _, err = w.Write(rec); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, w.Flush()
+}
+
+// [payload length] [uname, bucket ID, atime, lmeta] [xxhash(payload)]
+// (under read lock to serialize vs concurrent updates; skip if busy)
+// (skip dirty - not yet flushed - metadata that cannot be validated upon reload)
+func (e *lcsEntry) pack(rec []byte) []byte {
+	var (
+		md  = e.md
+		nlc = &g.locker[fs.LcacheIdx(e.digest)]
+	)
+	if !nlc.TryLock(md.uname, false) {
+		return nil
+	}
+	if md.isDirty() {
+		nlc.Unlock(md.uname, false)
+		return nil
+	}
+	buf := md.marshal(g.maxLmeta.Load())
+	rec = binary.BigEndian.AppendUint32(rec, 0) // (below)
+	rec = binary.BigEndian.AppendUint16(rec, uint16(len(md.uname)))
+	rec = append(rec, md.uname...)
+	rec = binary.BigEndian.AppendUint64(rec, md.bckID)
+	rec = binary.BigEndian.AppendUint64(rec, uint64(md.Atime))
+	rec = append(rec, buf...)
+	nlc.Unlock(md.uname, false)
+	g.smm.Free(buf)
+
+	payload := rec[cos.SizeofI32:]
+	binary.BigEndian.PutUint32(rec, uint32(len(payload)))
+	return binary.BigEndian.AppendUint64(rec, xxhash.Checksum64S(payload, cos.MLCG32))
+}
+
+//
+// load
+//
+
+// LoadLcache populates LOM caches from the previously saved snapshots; must be called
+// after BMD is loaded; returns the total number of loaded entries
+func LoadLcache() int {
+	var (
+		avail = fs.GetAvail()
+		total atomic.Int64
+		wg    sync.WaitGroup
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			n, skipped, err := loadLcache(mi)
+			switch {
+			case err == nil:
+				if n > 0 || skipped > 0 {
+					nlog.Infoln(mi.String()+": loaded lcache snapshot:", n, "entries, skipped", skipped)
+				}
+			case os.IsNotExist(err):
+			default:
+				nlog.Errorln("failed to load lcache snapshot", mi.String()+":", err, "[", n, skipped, "]")
+			}
+			total.Add(int64(n))
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	n := total.Load()
+	g.tstats.Add(LcacheSnapLoadedCount, n)
+	return int(n)
+}
+
+func loadLcache(mi *fs.Mountpath) (n, skipped int, err error) {
+	var (
+		file    *os.File
+		hdr     [lcsHdrLen]byte
+		started int64
+		fpath   = filepath.Join(mi.Path, fname.LcacheSnap)
+	)
+	if file, err = os.Open(fpath); err != nil {
+		return
+	}
+	defer file.Close()
+
+	r := bufio.NewReaderSize(file, memsys.DefaultBufSize)
+	if _, err = io.ReadFull(r, hdr[:]); err != nil {
+		return
+	}
+	if hdr[0] != lcsVer {
+		err = fmt.Errorf("%w: unknown version %d", errLcsCorrupted, hdr[0])
+		return
+	}
+	started = int64(binary.BigEndian.Uint64(hdr[1:])) - lcsSlack.Nanoseconds()
+
+	var (
+		b4  [cos.SizeofI32]byte
+		rec []byte
+	)
+	for {
+		if _, err = io.ReadFull(r, b4[:]); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return
+		}
+		l := int(binary.BigEndian.Uint32(b4[:])) + cos.SizeofI64
+		if l > 2*xattrMaxSize {
+			err = fmt.Errorf("%w: invalid record length %d", errLcsCorrupted, l)
+			return
+		}
+		if cap(rec) < l {
+			rec = make([]byte, l)
+		}
+		rec = rec[:l]
+		if _, err = io.ReadFull(r, rec); err != nil {
+			return
+		}
+		payload := rec[:l-cos.SizeofI64]
+		if xxhash.Checksum64S(payload, cos.MLCG32) != binary.BigEndian.Uint64(rec[l-cos.SizeofI64:]) {
+			err = fmt.Errorf("%w: record checksum mismatch", errLcsCorrupted)
+			return
+		}
+		if !loadEntry(mi, payload, started) {
+			skipped++
+			continue
+		}
+		n++
+		if n%lcsCheckMem == 0 && g.pmm.Pressure() >= memsys.PressureHigh {
+			nlog.Warningln(mi.String()+": memory pressure - not loading lcache snapshot any further:", n)
+			return
+		}
+	}
+}
+
+func loadEntry(mi *fs.Mountpath, payload []byte, started int64) bool {
+	if len(payload) < 2 {
+		return false
+	}
+	l := int(binary.BigEndian.Uint16(payload))
+	if len(payload) < 2+l+2*cos.SizeofI64 {
+		return false
+	}
+	var (
+		uname = string(payload[2 : 2+l])
+		off   = 2 + l
+		bckID = binary.BigEndian.Uint64(payload[off:])
+		atime = int64(binary.BigEndian.Uint64(payload[off+cos.SizeofI64:]))
+		lif   = LIF{Uname: uname, BID: bckID}
+	)
+	lom, err := lif.LOM()
+	if err != nil {
+		return false // bucket is gone or has been recreated
+	}
+	defer FreeLOM(lom)
+	if lom.mi != mi {
+		return false // mountpaths have changed
+	}
+	finfo, atimefs, err := ios.FinfoAtime(lom.FQN)
+	if err != nil || ios.GetCTime(finfo).UnixNano() >= started {
+		return false
+	}
+	if err := lom.md.unmarshal(payload[off+2*cos.SizeofI64:]); err != nil {
+		return false
+	}
+	if lom.md.Size != finfo.Size() {
+		return false
+	}
+	lom.md.bckID = bckID
+	lom.md.atimefs = uint64(atimefs)
+	lom.md.Atime = max(atime, atimefs) // (compare w/ FromFS)
+	if atime < 0 {
+		lom.md.Atime = atime // prefetched, not yet accessed
+	}
+	md := lom.md
+	_, loaded := lom.lcache().LoadOrStore(lom.digest, &md)
+	return !loaded
+}
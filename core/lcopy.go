@@ -303,12 +303,17 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		return
 	}
 
+	var qd quotaDelta
+	if !dst.isMirror(lom) {
+		qd = dst.quotaPre()
+	}
 	if err = cos.Rename(workFQN, dstFQN); err != nil {
 		if errRemove := cos.RemoveFile(workFQN); errRemove != nil && !os.IsNotExist(errRemove) {
 			nlog.Errorln("nested err:", errRemove)
 		}
 		return
 	}
+	qd.post(dstFQN)
 
 	if cksumType != cos.ChecksumNone {
 		if !dstCksum.Equal(lom.Checksum()) {
@@ -382,7 +387,7 @@ func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
 		minUtil        = int64(101) // to motivate the first assignment
 	)
 	for mpath, mpathInfo := range availablePaths {
-		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+		if lom.haveMpath(mpath) || !lom.canPlace(mpathInfo) {
 			continue
 		}
 		if util := mpathUtils.Get(mpath); util < minUtil {
@@ -404,6 +409,15 @@ func (lom *LOM) haveMpath(mpath string) bool {
 	return false
 }
 
+// writable and within the bucket's storage class, if any
+func (lom *LOM) canPlace(mi *fs.Mountpath) bool {
+	if mi.IsAnySet(fs.FlagNoWrite) {
+		return false
+	}
+	class := storageClass(lom.Bucket())
+	return class == "" || mi.Label == class
+}
+
 // must be called under w-lock
 // returns mountpath destination to copy this object, or nil if no copying is required
 // - checks hrw location first, and
@@ -412,13 +426,13 @@ func (lom *LOM) haveMpath(mpath string) bool {
 func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 	var (
 		availablePaths = fs.GetAvail()
-		hrwMi, _, err  = fs.Hrw(lom.md.uname)
+		hrwMi, _, err  = HrwMpath(lom.Bucket(), lom.md.uname)
 	)
 	if err != nil {
 		nlog.Errorln(err)
 		return
 	}
-	debug.Assert(!hrwMi.IsAnySet(fs.FlagWaitingDD))
+	debug.Assert(!hrwMi.IsAnySet(fs.FlagNoWrite))
 	if lom.mi.Path != hrwMi.Path {
 		return hrwMi, true
 	}
@@ -428,10 +442,12 @@ func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 	}
 	// count copies vs. configuration
 	// take into account mountpath flags but stop short of `fstat`-ing
+	// (copies on read-only mountpaths are being drained and don't count, and neither do
+	// copies outside the bucket's storage class)
 	expCopies, gotCopies := int(mirror.Copies), 0
 	for fqn, mpi := range lom.md.copies {
 		mpathInfo, ok := availablePaths[mpi.Path]
-		if !ok || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+		if !ok || !lom.canPlace(mpathInfo) {
 			lom.delCopyMd(fqn)
 		} else {
 			gotCopies++
@@ -5,13 +5,17 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
 )
 
 // (compare with cos.CreateFile)
@@ -52,9 +56,17 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 		return exclusive || (len(force) > 0 && force[0] && rc > 0)
 	})
 	lom.Uncache()
+	var size int64 = -1
+	if lom.Bprops() != nil && lom.Bprops().Quota.IsSet() {
+		if finfo, errV := os.Lstat(lom.FQN); errV == nil {
+			size = finfo.Size()
+		}
+	}
 	err = cos.RemoveFile(lom.FQN)
 	if os.IsNotExist(err) {
 		err = nil
+	} else if err == nil && size >= 0 {
+		lom.QuotaAdd(-size, -1)
 	}
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) {
@@ -67,12 +79,51 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 
 // (compare with cos.Rename)
 func (lom *LOM) RenameFrom(workfqn string) error {
+	if lom.roHRW() {
+		return lom.renameFromRO(workfqn)
+	}
 	bdir := lom.mi.MakePathBck(lom.Bucket())
 	if err := cos.Stat(bdir); err != nil {
 		return fmt.Errorf("%s(bdir: %s): %w", lom, bdir, err)
 	}
+	qd := lom.quotaPre()
 	if err := cos.Rename(workfqn, lom.FQN); err != nil {
 		return cmn.NewErrFailedTo(T, "finalize", lom, err)
 	}
+	qd.post(lom.FQN)
+	return nil
+}
+
+// overwriting an object that's still stored on its read-only mountpath (see fromReadOnly):
+// place new content at the (writable) HRW location, and remove the old one
+func (lom *LOM) renameFromRO(workfqn string) error {
+	var (
+		rofqn      = lom.FQN
+		mi, _, err = HrwMpath(lom.Bucket(), lom.md.uname)
+	)
+	if err != nil {
+		return err
+	}
+	qd := lom.quotaPre() // (old size)
+	lom.mi, lom.FQN = mi, lom.HrwFQN
+	if err := cos.Rename(workfqn, lom.FQN); err != nil {
+		if !errors.Is(err, syscall.EXDEV) {
+			return cmn.NewErrFailedTo(T, "finalize", lom, err)
+		}
+		// workfile on the read-only mountpath: copy => (local) workfile => rename
+		tmpfqn := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+		if _, _, err = cos.CopyFile(workfqn, tmpfqn, nil, cos.ChecksumNone); err == nil {
+			err = cos.Rename(tmpfqn, lom.FQN)
+		}
+		if err != nil {
+			cos.RemoveFile(tmpfqn)
+			return cmn.NewErrFailedTo(T, "finalize", lom, err)
+		}
+		cos.RemoveFile(workfqn)
+	}
+	if err := cos.RemoveFile(rofqn); err != nil {
+		nlog.Warningf("%s: failed to remove old content from read-only %s: %v", lom, rofqn, err)
+	}
+	qd.post(lom.FQN)
 	return nil
 }
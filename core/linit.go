@@ -61,7 +61,11 @@ func (lom *LOM) InitFQN(fqn string, expbck *cmn.Bck) (err error) {
 		return
 	}
 	lom.md.uname = lom.bck.MakeUname(lom.ObjName)
-	return nil
+	if storageClass(lom.Bucket()) != "" {
+		// (ResolveFQN above does not know bucket props)
+		lom.HrwFQN, lom.digest, err = HrwFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	}
+	return err
 }
 
 func (lom *LOM) InitCT(ct *CT) {
@@ -82,7 +86,7 @@ func (lom *LOM) InitBck(bck *cmn.Bck) (err error) {
 		return
 	}
 	lom.md.uname = lom.bck.MakeUname(lom.ObjName)
-	lom.mi, lom.digest, err = fs.Hrw(lom.md.uname)
+	lom.mi, lom.digest, err = HrwMpath(lom.Bucket(), lom.md.uname)
 	if err != nil {
 		return
 	}
@@ -40,9 +40,10 @@ const (
 	RemoteDeletedDelCount = "remote.deleted.del.n"
 
 	// lcache stats
-	LcacheCollisionCount = "lcache.collision.n"
-	LcacheEvictedCount   = "lcache.evicted.n"
-	LcacheFlushColdCount = "lcache.flush.cold.n"
+	LcacheCollisionCount  = "lcache.collision.n"
+	LcacheEvictedCount    = "lcache.evicted.n"
+	LcacheFlushColdCount  = "lcache.flush.cold.n"
+	LcacheSnapLoadedCount = "lcache.snap.loaded.n"
 )
 
 type (
@@ -52,6 +53,7 @@ type (
 		cmn.ObjAttrs
 		atimefs uint64 // NOTE: high bit is reserved for `dirty`
 		bckID   uint64
+		nacc    uint64 // number of accesses (see cmn.LRUPolicyLFU)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -103,6 +105,7 @@ func Tinit(t Target, tstats cos.StatsUpdater, runHK bool) {
 	}
 	if runHK {
 		regLomCacheWithHK()
+		regLcacheSnapWithHK()
 	}
 }
 
@@ -112,6 +115,7 @@ func Term() {
 		time.Sleep(sleep)
 	}
 	g.lchk.evictAll(termDuration)
+	g.lchk.termSnapshot()
 }
 
 /////////
@@ -165,6 +169,45 @@ func (lom *LOM) Atime() time.Time      { return time.Unix(0, lom.md.Atime) }
 func (lom *LOM) AtimeUnix() int64      { return lom.md.Atime }
 func (lom *LOM) SetAtimeUnix(tu int64) { lom.md.Atime = tu }
 
+// access counter (used by LFU eviction); gets persisted lazily,
+// when the (dirty) metadata is flushed from the cache
+func (lom *LOM) AccessCnt() uint64 { return lom.md.nacc }
+
+// increment the counter of the cached metadata and (re)cache the latter (compare w/ Recache);
+// compare-and-swap, so that concurrent GETs (under shared lock) don't lose increments
+func (lom *LOM) IncAccessCnt() {
+	debug.Assert(!lom.IsCopy())
+	bid := lom.Bprops().BID
+	debug.Assert(bid != 0)
+	lom.md.bckID = bid
+
+	var (
+		md     lmeta
+		lcache = lom.lcache()
+	)
+	for {
+		md = lom.md
+		val, ok := lcache.Load(lom.digest)
+		if ok {
+			if lmd := val.(*lmeta); lmd.uname == md.uname {
+				md.nacc = lmd.nacc
+				md.cpAtime(lmd)
+			}
+		}
+		md.nacc++
+		md.makeDirty()
+		if ok {
+			if lcache.CompareAndSwap(lom.digest, val, &md) {
+				break
+			}
+		} else if _, loaded := lcache.LoadOrStore(lom.digest, &md); !loaded {
+			break
+		}
+	}
+	lom.md.nacc = md.nacc
+	lom.md.makeDirty()
+}
+
 // custom metadata
 func (lom *LOM) GetCustomMD() cos.StrKVs   { return lom.md.GetCustomMD() }
 func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
@@ -183,7 +226,40 @@ func AllocLomFromHdr(hdr *transport.ObjHdr) (lom *LOM, err error) {
 }
 
 func (lom *LOM) ECEnabled() bool { return lom.Bprops().EC.Enabled }
-func (lom *LOM) IsHRW() bool     { return lom.HrwFQN == lom.FQN } // subj to resilvering
+
+// true if stored at its HRW location or, while not yet drained, at its read-only HRW mountpath
+// (subj to resilvering)
+func (lom *LOM) IsHRW() bool {
+	return lom.HrwFQN == lom.FQN || (lom.roHRW() && cos.Stat(lom.HrwFQN) != nil)
+}
+
+// located at its HRW mountpath that's read-only (see fs.HrwLookup)
+func (lom *LOM) roHRW() bool {
+	if lom.FQN == lom.HrwFQN || !lom.mi.IsAnySet(fs.FlagReadOnly) {
+		return false
+	}
+	mi, err := HrwLookupMpath(lom.Bucket(), lom.md.uname)
+	return err == nil && mi.Path == lom.mi.Path
+}
+
+// not found at its HRW location: the object may still be stored on its read-only
+// HRW mountpath (that's being drained); if it is, re-point this LOM to read it from there
+// (and see RenameFrom for overwrites)
+func (lom *LOM) fromReadOnly() bool {
+	if lom.FQN != lom.HrwFQN || !fs.HasReadOnly() {
+		return false
+	}
+	mi, err := HrwLookupMpath(lom.Bucket(), lom.md.uname)
+	if err != nil || mi.Path == lom.mi.Path || !mi.IsAnySet(fs.FlagReadOnly) {
+		return false
+	}
+	fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	if cos.Stat(fqn) != nil {
+		return false
+	}
+	lom.mi, lom.FQN = mi, fqn
+	return true
+}
 
 func (lom *LOM) Bprops() *cmn.Bprops { return lom.bck.Props }
 
@@ -407,7 +483,13 @@ func (lom *LOM) Load(cacheit, locked bool) error {
 		defer lom.Unlock(false)
 	}
 	if err := lom.FromFS(); err != nil {
-		return err
+		if !os.IsNotExist(err) || !lom.fromReadOnly() {
+			return err
+		}
+		cacheit = false // (lcache belongs to the HRW mountpath)
+		if err := lom.FromFS(); err != nil {
+			return err
+		}
 	}
 	bid := lom.Bprops().BID
 	debug.Assert(bid != 0, lom.Cname())
@@ -33,6 +33,8 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalD = "LOM_TEST_Local_D"
+		bucketLocalQ = "LOM_TEST_Local_Q"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -95,6 +97,9 @@ var _ = Describe("LOM", func() {
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
+		meta.NewBck(bucketLocalD, apc.AIS, cmn.NsGlobal, &cmn.Bprops{StorageClass: "nvme", BID: 8}),
+		meta.NewBck(bucketLocalQ, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{Quota: cmn.QuotaConf{MaxSize: 8 * cos.KiB, MaxObjects: 4}, BID: 9}),
 	)
 
 	BeforeEach(func() {
@@ -992,6 +997,218 @@ var _ = Describe("LOM", func() {
 				Expect(lom.GetCopies()).To(BeNil())
 			})
 		})
+
+		Describe("ToTierStub", func() {
+			It("should replace object and its copies with a stub", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+				dst := meta.CloneBck(&localBckB)
+
+				_, ok := lom.TierDst()
+				Expect(ok).To(BeFalse())
+				_, ok = lom.TierSize()
+				Expect(ok).To(BeFalse())
+
+				lom.Lock(true)
+				Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(lom.ToTierStub(dst)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(mirrorFQNs[1]).NotTo(BeAnExistingFile())
+				finfo, err := os.Stat(mirrorFQNs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(finfo.Size()).To(BeZero())
+
+				// reload and check
+				lom = NewBasicLom(mirrorFQNs[0])
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.SizeBytes()).To(BeZero())
+				Expect(lom.Version()).To(Equal(desiredVersion))
+				Expect(lom.HasCopies()).To(BeFalse())
+				bck, ok := lom.TierDst()
+				Expect(ok).To(BeTrue())
+				expectEqualBck(&bck, &localBckB)
+				size, ok := lom.GetCustomKey(cmn.TierSizeObjMD)
+				Expect(ok).To(BeTrue())
+				Expect(size).To(Equal(strconv.Itoa(testFileSize)))
+				tsize, ok := lom.TierSize()
+				Expect(ok).To(BeTrue())
+				Expect(tsize).To(BeEquivalentTo(testFileSize))
+				Expect(lom.ValidateContentChecksum()).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("storage class", func() {
+		bck := cmn.Bck{Name: bucketLocalD, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+		It("should place objects only on the mountpaths labeled accordingly", func() {
+			avail := fs.GetAvail()
+			avail[mpaths[1]].Label = "nvme"
+			defer func() { avail[mpaths[1]].Label = "" }()
+
+			for i := 0; i < 100; i++ {
+				objName := "obj-" + strconv.Itoa(i)
+				lom := &core.LOM{ObjName: objName}
+				Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+				Expect(lom.Mountpath().Path).To(Equal(mpaths[1]))
+				hrwFQN := lom.FQN
+
+				// e.g., stored prior to setting the bucket's storage class
+				lom = &core.LOM{}
+				Expect(lom.InitFQN(mis[0].MakePathFQN(&bck, fs.ObjectType, objName), nil)).NotTo(HaveOccurred())
+				Expect(lom.IsHRW()).To(BeFalse())
+				Expect(lom.HrwFQN).To(Equal(hrwFQN))
+			}
+		})
+
+		It("should fail when there are no mountpaths labeled accordingly", func() {
+			lom := &core.LOM{ObjName: "obj"}
+			Expect(lom.InitBck(&bck)).To(HaveOccurred())
+		})
+	})
+
+	Describe("quota", func() {
+		const size = cos.KiB
+		var (
+			localBckQ = cmn.Bck{Name: bucketLocalQ, Provider: apc.AIS, Ns: cmn.NsGlobal}
+			bck       *meta.Bck
+		)
+		putObj := func(name string) *core.LOM {
+			lom := &core.LOM{ObjName: name}
+			Expect(lom.InitBck(&localBckQ)).NotTo(HaveOccurred())
+			filePut(lom.FQN, size)
+			return lom
+		}
+		usage := func() (size, objs int64) {
+			Eventually(func() bool {
+				_, _, ok := core.QuotaUsage(bck)
+				return ok
+			}).Should(BeTrue())
+			size, objs, _ = core.QuotaUsage(bck)
+			return size, objs
+		}
+
+		BeforeEach(func() {
+			tmock := mock.NewTarget(bmd)
+			tmock.SO = &quotaSowner{}
+			bck = meta.CloneBck(&localBckQ)
+			Expect(bck.Init(bmd)).NotTo(HaveOccurred())
+			core.QuotaForget(bck)
+		})
+
+		It("should compute baseline and account for additions", func() {
+			putObj("quota/obj-1")
+			putObj("quota/obj-2")
+			size, objs := usage()
+			Expect(objs).To(BeEquivalentTo(2))
+			Expect(size).To(BeEquivalentTo(2 * cos.KiB))
+
+			lom := putObj("quota/obj-3")
+			lom.QuotaAdd(cos.KiB, 1)
+			size, objs = usage()
+			Expect(objs).To(BeEquivalentTo(3))
+			Expect(size).To(BeEquivalentTo(3 * cos.KiB))
+			Expect(core.QuotaCheck(bck, cos.KiB)).NotTo(HaveOccurred())
+		})
+
+		It("should fail when exceeding size or number of objects", func() {
+			for i := 0; i < 3; i++ {
+				putObj("quota/obj-" + strconv.Itoa(i))
+			}
+			usage()
+
+			err := core.QuotaCheck(bck, 6*cos.KiB)
+			Expect(cmn.IsErrQuotaExceeded(err)).To(BeTrue())
+
+			lom := putObj("quota/obj-3")
+			lom.QuotaAdd(cos.KiB, 1)
+			err = core.QuotaCheck(bck, 0)
+			Expect(cmn.IsErrQuotaExceeded(err)).To(BeTrue())
+		})
+
+		It("should recompute usage after forgetting it", func() {
+			putObj("quota/obj-1")
+			_, objs := usage()
+			Expect(objs).To(BeEquivalentTo(1))
+
+			// (not accounted for: e.g., written while quota was unset)
+			putObj("quota/obj-2")
+			putObj("quota/obj-3")
+			_, objs = usage()
+			Expect(objs).To(BeEquivalentTo(1))
+
+			core.QuotaForget(bck)
+			size, objs := usage()
+			Expect(objs).To(BeEquivalentTo(3))
+			Expect(size).To(BeEquivalentTo(3 * cos.KiB))
+		})
+	})
+
+	Describe("lcache snapshot", func() {
+		const (
+			numObjs = 10
+			size    = 1024
+		)
+		uncacheAll := func() {
+			for _, mi := range fs.GetAvail() {
+				core.UncacheMountpath(mi)
+			}
+		}
+
+		It("should reload unmodified objects' metadata upon restart", func() {
+			uncacheAll()
+			fqns := make([]string, 0, numObjs)
+			for i := 0; i < numObjs; i++ {
+				lom := &core.LOM{ObjName: "lcs/obj-" + strconv.Itoa(i)}
+				Expect(lom.InitBck(&localBckB)).NotTo(HaveOccurred())
+				filePut(lom.FQN, size)
+				Expect(lom.Load(true /*cache it*/, false)).NotTo(HaveOccurred())
+				fqns = append(fqns, lom.FQN)
+			}
+			time.Sleep(200 * time.Millisecond) // (ctime granularity)
+			Expect(core.SaveLcache(cmn.GCO.Get())).To(Equal(numObjs))
+
+			// "restart"
+			uncacheAll()
+
+			// modified after the snapshot: content and metadata, respectively
+			createTestFile(fqns[0], 2*size)
+			lom := NewBasicLom(fqns[1])
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			lom.SetCustomKey("k", "v")
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.UncacheUnless()
+
+			Expect(core.LoadLcache()).To(Equal(numObjs - 2))
+
+			// loaded from the cache: on-disk metadata is not being looked at
+			Expect(fs.SetXattr(fqns[2], core.XattrLOM, []byte("garbage"))).NotTo(HaveOccurred())
+			lom = NewBasicLom(fqns[2])
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.SizeBytes()).To(BeEquivalentTo(size))
+			Expect(lom.Version()).To(Equal("1"))
+
+			uncacheAll()
+			Expect(NewBasicLom(fqns[2]).Load(false, false)).To(HaveOccurred())
+		})
+
+		It("should not load when the bucket has been recreated", func() {
+			uncacheAll()
+			lom := &core.LOM{ObjName: "lcs/obj"}
+			Expect(lom.InitBck(&localBckA)).NotTo(HaveOccurred())
+			filePut(lom.FQN, size)
+			Expect(lom.Load(true /*cache it*/, false)).NotTo(HaveOccurred())
+			time.Sleep(200 * time.Millisecond)
+			Expect(core.SaveLcache(cmn.GCO.Get())).To(Equal(1))
+			uncacheAll()
+
+			bck := meta.CloneBck(&localBckA)
+			props, _ := bmd.Get().Get(bck)
+			bid := props.BID
+			props.BID = 100
+			defer func() { props.BID = bid }()
+			Expect(core.LoadLcache()).To(BeZero())
+		})
 	})
 
 	Describe("local and cloud bucket with the same name", func() {
@@ -1094,3 +1311,8 @@ func persist(lom *core.LOM) error {
 	}
 	return lom.Persist()
 }
+
+type quotaSowner struct{}
+
+func (*quotaSowner) Get() *meta.Smap               { return &meta.Smap{} }
+func (*quotaSowner) Listeners() meta.SmapListeners { return nil }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -58,6 +59,7 @@ const (
 	lomObjSize
 	lomObjCopies
 	lomCustomMD
+	lomAccessCnt
 )
 
 // packing format separators
@@ -305,8 +307,9 @@ func (md *lmeta) unmarshal(buf []byte) error {
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", invalid, len(buf))
 	}
-	if buf[0] != cmn.MetaverLOM {
-		return fmt.Errorf("%s: unknown version %d", invalid, buf[0])
+	ver := buf[0]
+	if ver != cmn.MetaverLOM && ver != cmn.MetaverLOMv1 {
+		return fmt.Errorf("%s: unknown version %d", invalid, ver)
 	}
 	if buf[1] != mdCksumTyXXHash {
 		return fmt.Errorf("%s: unknown checksum %d", invalid, buf[1])
@@ -390,8 +393,17 @@ func (md *lmeta) unmarshal(buf []byte) error {
 				custom[entries[i]] = entries[i+1]
 			}
 			md.SetCustomMD(custom)
+		case lomAccessCnt:
+			n, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return errors.New(invalid + " #6.1")
+			}
+			md.nacc = n
 		default:
-			return errors.New(invalid + " #6")
+			// skip unknown (e.g., added by a newer version) records, unless v1
+			if ver == cmn.MetaverLOMv1 {
+				return errors.New(invalid + " #6")
+			}
 		}
 	}
 	if haveCksumType != haveCksumValue {
@@ -430,9 +442,17 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 		buf = _marshRecord(buf, lomCustomMD, "", false)
 		buf = _marshCustomMD(buf, custom)
 	}
+	if md.nacc > 0 {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomAccessCnt, strconv.FormatUint(md.nacc, 10), false)
+	}
 
 	// checksum, prepend, and return
-	buf[0] = cmn.MetaverLOM
+	// (write v1 when possible, for older nodes to be able to read it)
+	buf[0] = cmn.MetaverLOMv1
+	if md.nacc > 0 {
+		buf[0] = cmn.MetaverLOM
+	}
 	buf[1] = mdCksumTyXXHash
 	mdCksumValue := xxhash.Checksum64S(buf[prefLen:], cos.MLCG32)
 	binary.BigEndian.PutUint64(buf[2:], mdCksumValue)
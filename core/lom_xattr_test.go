@@ -6,6 +6,7 @@ package core_test
 
 import (
 	"os"
+	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -119,6 +120,8 @@ var _ = Describe("LOM Xattributes", func() {
 				})
 				Expect(lom.AddCopy(fqns[0], copyMpathInfo)).NotTo(HaveOccurred())
 				Expect(lom.AddCopy(fqns[1], copyMpathInfo)).NotTo(HaveOccurred())
+				lom.IncAccessCnt()
+				lom.IncAccessCnt()
 				Expect(persist(lom)).NotTo(HaveOccurred())
 
 				b, err := fs.GetXattr(localFQN, core.XattrLOM)
@@ -138,6 +141,27 @@ var _ = Describe("LOM Xattributes", func() {
 				Expect(lom.GetCopies()).To(BeEquivalentTo(newLom.GetCopies()))
 				Expect(lom.GetCustomMD()).To(HaveLen(3))
 				Expect(lom.GetCustomMD()).To(BeEquivalentTo(newLom.GetCustomMD()))
+				Expect(newLom.AccessCnt()).To(BeEquivalentTo(2))
+			})
+
+			It("should not lose concurrent access counter increments", func() {
+				const num = 64
+				filePut(localFQN, testFileSize)
+				wg := &sync.WaitGroup{}
+				for i := 0; i < num; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+						lom := NewBasicLom(localFQN)
+						Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+						lom.IncAccessCnt()
+					}()
+				}
+				wg.Wait()
+				newLom := NewBasicLom(localFQN)
+				Expect(newLom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(newLom.AccessCnt()).To(BeEquivalentTo(num))
 			})
 
 			It("should _not_ save meta to disk", func() {
@@ -0,0 +1,59 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Lifecycle-driven tiering (cmn.TieringConf).
+//
+// A tiered-out object is a stub: zero-size object with its original name and the
+// destination bucket recorded in custom metadata (cmn.TierObjMD). Stubs are regular
+// (if empty) objects - rebalance, resilver, and listing treat them as such.
+
+// returns the (cold) bucket the object has been moved to, if any
+func (lom *LOM) TierDst() (cmn.Bck, bool) {
+	cname, ok := lom.GetCustomKey(cmn.TierObjMD)
+	if !ok || cname == "" {
+		return cmn.Bck{}, false
+	}
+	bck, _, err := cmn.ParseBckObjectURI(cname, cmn.ParseURIOpts{})
+	return bck, err == nil
+}
+
+// the size of the tiered-out (cold) copy - to report in place of the stub's (zero) size
+// (HEAD, list-objects)
+func (lom *LOM) TierSize() (int64, bool) {
+	s, ok := lom.GetCustomKey(cmn.TierSizeObjMD)
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(s, 10, 64)
+	return size, err == nil
+}
+
+// replace the object with a stub (must be w-locked and loaded);
+// the caller is responsible for making sure the object has been copied to `dst`
+func (lom *LOM) ToTierStub(dst *meta.Bck) error {
+	size := lom.SizeBytes()
+	if err := lom.DelAllCopies(); err != nil {
+		return err
+	}
+	if err := os.Truncate(lom.FQN, 0); err != nil {
+		return err
+	}
+	lom.SetSize(0)
+	lom.SetCustomKey(cmn.TierObjMD, dst.Cname(""))
+	lom.SetCustomKey(cmn.TierSizeObjMD, strconv.FormatInt(size, 10))
+	if _, err := lom.ComputeSetCksum(); err != nil {
+		return err
+	}
+	return lom.Persist()
+}
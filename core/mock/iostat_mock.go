@@ -23,3 +23,4 @@ func (*IOS) AddMpath(string, string, bool) (ios.FsDisks, error) { return nil, ni
 func (*IOS) RemoveMpath(string, bool)                           {}
 func (*IOS) LogAppend(l []string) []string                      { return l }
 func (*IOS) FillDiskStats(ios.AllDiskStats)                     {}
+func (*IOS) FillMpathStats(ios.AllMpathStats)                   {}
@@ -58,6 +58,10 @@ func (*TargetMock) BMDVersionFixup(*http.Request, ...cmn.Bck)
 func (*TargetMock) FSHC(error, string)                                             {}
 func (*TargetMock) OOS(*fs.CapStatus) fs.CapStatus                                 { return fs.CapStatus{} }
 
+func (*TargetMock) HeadObjAttrsT2T(*core.LOM, *meta.Snode) (*cmn.ObjAttrs, int, error) {
+	return nil, 0, nil
+}
+
 func (*TargetMock) CopyObject(*core.LOM, core.DM, *core.CopyParams) (int64, error) {
 	return 0, nil
 }
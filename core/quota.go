@@ -0,0 +1,171 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Per-bucket quotas (cmn.QuotaConf).
+//
+// Each target tracks - incrementally and only for buckets that have quotas - the total size
+// and the number of objects it stores; the baseline is computed once, by walking the bucket
+// upon first access. Writing new content is then checked against this target's share of the
+// cluster-wide limits (the limit divided by the number of active targets).
+//
+// Usage is approximate: objects written while the baseline is being computed may be counted
+// twice, and mirror copies and EC slices are not counted at all.
+// Whenever bucket's quota changes (including unset and reset) the usage gets recomputed.
+
+type bckUsage struct {
+	size  atomic.Int64
+	objs  atomic.Int64
+	ready atomic.Bool // baseline computed
+}
+
+var usages sync.Map // bucket ID (cmn.Bprops.BID) => *bckUsage
+
+// returns nil when the bucket has no quota
+func quotaUsage(bck *meta.Bck) *bckUsage {
+	if bck.Props == nil || !bck.Props.Quota.IsSet() {
+		return nil
+	}
+	if v, ok := usages.Load(bck.Props.BID); ok {
+		return v.(*bckUsage)
+	}
+	u := &bckUsage{}
+	if v, loaded := usages.LoadOrStore(bck.Props.BID, u); loaded {
+		return v.(*bckUsage)
+	}
+	go u.init(bck.Clone())
+	return u
+}
+
+func (u *bckUsage) init(bck cmn.Bck) {
+	var (
+		wg    sync.WaitGroup
+		avail = fs.GetAvail()
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			var size, objs int64
+			cb := func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() {
+					return nil
+				}
+				if finfo, err := os.Lstat(fqn); err == nil {
+					size += finfo.Size()
+					objs++
+				}
+				return nil
+			}
+			opts := &fs.WalkOpts{Mi: mi, Bck: bck, CTs: []string{fs.ObjectType}, Callback: cb}
+			if err := fs.Walk(opts); err != nil && !os.IsNotExist(err) {
+				nlog.Errorln(mi.String(), "quota usage:", bck.Cname(""), "err:", err)
+			}
+			u.size.Add(size)
+			u.objs.Add(objs)
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	u.ready.Store(true)
+}
+
+// QuotaUsage returns this target's usage of the bucket (ok == false when not tracked or not ready yet)
+func QuotaUsage(bck *meta.Bck) (size, objs int64, ok bool) {
+	u := quotaUsage(bck)
+	if u == nil || !u.ready.Load() {
+		return 0, 0, false
+	}
+	return u.size.Load(), u.objs.Load(), true
+}
+
+// QuotaCheck is called prior to writing `size` bytes (-1 when unknown) into the bucket;
+// returns cmn.ErrQuotaExceeded if writing would exceed this target's share of the bucket quota
+func QuotaCheck(bck *meta.Bck, size int64) error {
+	u := quotaUsage(bck)
+	if u == nil || !u.ready.Load() {
+		return nil
+	}
+	var (
+		quota = &bck.Props.Quota
+		nat   = int64(max(T.Sowner().Get().CountActiveTs(), 1))
+	)
+	if quota.MaxObjects > 0 {
+		limit := cos.DivCeil(quota.MaxObjects, nat)
+		if objs := u.objs.Load(); objs >= limit {
+			return cmn.NewErrQuotaExceeded(T.String(), bck.Bucket(), cmn.QuotaObjects, objs, limit, quota.MaxObjects)
+		}
+	}
+	if quota.MaxSize > 0 {
+		limit := cos.DivCeil(int64(quota.MaxSize), nat)
+		if used := u.size.Load() + max(size, 0); used > limit {
+			return cmn.NewErrQuotaExceeded(T.String(), bck.Bucket(), cmn.QuotaSize, used, limit, int64(quota.MaxSize))
+		}
+	}
+	return nil
+}
+
+// QuotaForget is called when the bucket is destroyed (or evicted) and when its quota props change
+func QuotaForget(bck *meta.Bck) {
+	if bck.Props != nil {
+		usages.Delete(bck.Props.BID)
+	}
+}
+
+//
+// LOM: accounting
+//
+
+type quotaDelta struct {
+	u       *bckUsage
+	prev    int64
+	existed bool
+}
+
+// to be called prior to (over)writing lom.FQN
+func (lom *LOM) quotaPre() (qd quotaDelta) {
+	if qd.u = quotaUsage(lom.Bck()); qd.u == nil {
+		return
+	}
+	if finfo, err := os.Lstat(lom.FQN); err == nil {
+		qd.prev, qd.existed = finfo.Size(), true
+	}
+	return
+}
+
+// upon success
+func (qd *quotaDelta) post(fqn string) {
+	if qd.u == nil {
+		return
+	}
+	finfo, err := os.Lstat(fqn)
+	if err != nil {
+		return
+	}
+	qd.u.size.Add(finfo.Size() - qd.prev)
+	if !qd.existed {
+		qd.u.objs.Inc()
+	}
+}
+
+// QuotaAdd accounts for changes made outside the regular (write => rename) path
+// (e.g., when the object is written in place)
+func (lom *LOM) QuotaAdd(dsize, dobjs int64) {
+	if u := quotaUsage(lom.Bck()); u != nil {
+		u.size.Add(dsize)
+		u.objs.Add(dobjs)
+	}
+}
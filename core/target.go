@@ -74,6 +74,7 @@ type (
 		CopyObject(lom *LOM, dm DM, coi *CopyParams) (int64, error)
 		Promote(params *PromoteParams) (errCode int, err error)
 		HeadObjT2T(lom *LOM, si *meta.Snode) bool
+		HeadObjAttrsT2T(lom *LOM, si *meta.Snode) (oa *cmn.ObjAttrs, errCode int, err error)
 
 		BMDVersionFixup(r *http.Request, bck ...cmn.Bck)
 	}
@@ -102,6 +102,7 @@ type (
 		Stats    Stats `json:"stats"`
 		AbortedX bool  `json:"aborted"`
 		IdleX    bool  `json:"is_idle"`
+		PausedX  bool  `json:"paused,omitempty"`
 	}
 	AllRunningInOut struct {
 		Kind    string
@@ -116,6 +117,7 @@ type (
 
 func (snp *Snap) IsAborted() bool { return snp.AbortedX }
 func (snp *Snap) IsIdle() bool    { return snp.IdleX }
+func (snp *Snap) IsPaused() bool  { return snp.PausedX }
 func (snp *Snap) Started() bool   { return !snp.StartTime.IsZero() }
 func (snp *Snap) Running() bool   { return snp.Started() && !snp.IsAborted() && snp.EndTime.IsZero() }
 func (snp *Snap) Finished() bool  { return snp.Started() && !snp.EndTime.IsZero() }
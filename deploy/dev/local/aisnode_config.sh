@@ -138,6 +138,10 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"auth": {
 		"secret":      "$AIS_SECRET_KEY",
+		"user_limit": {
+			"max_ops_per_sec": 0,
+			"max_bandwidth":   "0"
+		},
 		"enabled":     ${AIS_AUTHN_ENABLED:-false}
 	},
 	"keepalivetracker": {
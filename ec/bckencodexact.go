@@ -27,11 +27,16 @@ type (
 		xctn  *XactBckEncode
 		phase string
 	}
+	reencFactory struct {
+		xreg.RenewBase
+		xctn *XactBckEncode
+	}
 	XactBckEncode struct {
 		xact.Base
-		bck  *meta.Bck
-		wg   *sync.WaitGroup // to wait for EC finishes all objects
-		smap *meta.Smap
+		bck   *meta.Bck
+		wg    *sync.WaitGroup // to wait for EC finishes all objects
+		smap  *meta.Smap
+		reenc bool // apc.ActECReencode
 	}
 )
 
@@ -39,6 +44,7 @@ type (
 var (
 	_ core.Xact      = (*XactBckEncode)(nil)
 	_ xreg.Renewable = (*encFactory)(nil)
+	_ xreg.Renewable = (*reencFactory)(nil)
 )
 
 ////////////////
@@ -52,7 +58,7 @@ func (*encFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
 }
 
 func (p *encFactory) Start() error {
-	p.xctn = newXactBckEncode(p.Bck, p.UUID())
+	p.xctn = newXactBckEncode(p.Bck, p.UUID(), apc.ActECEncode)
 	return nil
 }
 
@@ -70,13 +76,32 @@ func (p *encFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR,
 	return
 }
 
+//////////////////
+// reencFactory //
+//////////////////
+
+func (*reencFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &reencFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *reencFactory) Start() error {
+	p.xctn = newXactBckEncode(p.Bck, p.UUID(), apc.ActECReencode)
+	return nil
+}
+
+func (*reencFactory) Kind() string     { return apc.ActECReencode }
+func (p *reencFactory) Get() core.Xact { return p.xctn }
+
+// the most recent EC configuration always wins
+func (*reencFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprAbort, nil }
+
 ///////////////////
 // XactBckEncode //
 ///////////////////
 
-func newXactBckEncode(bck *meta.Bck, uuid string) (r *XactBckEncode) {
-	r = &XactBckEncode{bck: bck, wg: &sync.WaitGroup{}, smap: core.T.Sowner().Get()}
-	r.InitBase(uuid, apc.ActECEncode, bck)
+func newXactBckEncode(bck *meta.Bck, uuid, kind string) (r *XactBckEncode) {
+	r = &XactBckEncode{bck: bck, wg: &sync.WaitGroup{}, smap: core.T.Sowner().Get(), reenc: kind == apc.ActECReencode}
+	r.InitBase(uuid, kind, bck)
 	return
 }
 
@@ -94,10 +119,13 @@ func (r *XactBckEncode) Run(wg *sync.WaitGroup) {
 		return
 	}
 
+	// pausable but not checkpointed: encoding is asynchronous, and
+	// already erasure-coded objects are skipped anyway (see bckEncode below)
 	opts := &mpather.JgroupOpts{
 		CTs:      []string{fs.ObjectType},
 		VisitObj: r.bckEncode,
 		DoLoad:   mpather.LoadUnsafe,
+		Pauser:   r,
 	}
 	opts.Bck.Copy(r.bck.Bucket())
 	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
@@ -147,6 +175,9 @@ func (r *XactBckEncode) bckEncode(lom *core.LOM, _ []byte) error {
 		nlog.Warningf("metadata FQN generation failed %q: %v", lom, err)
 		return nil
 	}
+	if r.reenc {
+		return r.reencode(lom, mdFQN)
+	}
 	err = cos.Stat(mdFQN)
 	// Metadata file exists - the object was already EC'ed before.
 	if err == nil {
@@ -171,6 +202,36 @@ func (r *XactBckEncode) bckEncode(lom *core.LOM, _ []byte) error {
 	return nil
 }
 
+// Re-encodes the object if its current layout (as per metafile) differs from
+// the one prescribed by the bucket's (new) EC configuration. Objects that were
+// never erasure coded are encoded, same as ec-encode would do.
+func (r *XactBckEncode) reencode(lom *core.LOM, mdFQN string) (err error) {
+	var md *Metadata
+	if md, err = LoadMetadata(mdFQN); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Warningf("failed to load %q: %v", mdFQN, err)
+			return nil
+		}
+		md = nil
+	}
+	if md != nil && !NeedsReencode(md, lom.SizeBytes(), &r.bck.Props.EC) {
+		return nil
+	}
+	r.beforeECObj()
+	if md == nil {
+		err = ECM.EncodeObject(lom, r.afterECObj)
+	} else {
+		err = ECM.ReencodeObject(lom, md, r.afterECObj)
+	}
+	if err != nil {
+		r.afterECObj(lom, err)
+		if err != errSkipped {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *XactBckEncode) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
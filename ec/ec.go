@@ -135,6 +135,8 @@ type (
 		tm      time.Time // to measure different steps
 		IsCopy  bool      // replicate or use erasure coding
 		rebuild bool      // true - internal request to reencode, e.g., from ec-encode xaction
+
+		prev *Metadata // re-encoding: the object's current (outdated) layout
 	}
 
 	RequestsControlMsg struct {
@@ -205,6 +207,7 @@ func Init() {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&reencFactory{})
 
 	if err := initManager(); err != nil {
 		cos.ExitLogf("Failed to init manager: %v", err)
@@ -447,8 +450,9 @@ func WriteSliceAndMeta(hdr *transport.ObjHdr, args *WriteArgs) error {
 			nlog.Errorf("nested error: save replica -> remove metafile: %v", rmErr)
 		}
 	}()
+	var oldMeta *Metadata
 	if args.Generation != 0 {
-		if oldMeta, oldErr := LoadMetadata(ctMeta.FQN()); oldErr == nil && oldMeta.Generation > args.Generation {
+		if oldMeta, _ = LoadMetadata(ctMeta.FQN()); oldMeta != nil && oldMeta.Generation > args.Generation {
 			return nil
 		}
 	}
@@ -464,16 +468,27 @@ func WriteSliceAndMeta(hdr *transport.ObjHdr, args *WriteArgs) error {
 			ctMeta.ObjectName(), ctMeta.Bucket())
 		return err
 	}
-	err = validateBckBID(&hdr.Bck, args.BID)
+	if err = validateBckBID(&hdr.Bck, args.BID); err == nil && oldMeta != nil && oldMeta.IsCopy {
+		// re-encoded: replica => slice
+		rmOutdated(ct.Clone(fs.ObjectType))
+	}
 	return err
 }
 
+// remove outdated replica or slice upon re-encoding (see ReencodeObject)
+func rmOutdated(ct *core.CT) {
+	if err := cos.RemoveFile(ct.FQN()); err != nil && !os.IsNotExist(err) {
+		nlog.Errorf("failed to remove outdated %s: %v", ct.FQN(), err)
+	}
+}
+
 // WriteReplicaAndMeta saves replica and its metafile
 func WriteReplicaAndMeta(lom *core.LOM, args *WriteArgs) (err error) {
+	var oldMeta *Metadata
 	lom.Lock(false)
 	if args.Generation != 0 {
 		ctMeta := core.NewCTFromLOM(lom, fs.ECMetaType)
-		if oldMeta, oldErr := LoadMetadata(ctMeta.FQN()); oldErr == nil && oldMeta.Generation > args.Generation {
+		if oldMeta, _ = LoadMetadata(ctMeta.FQN()); oldMeta != nil && oldMeta.Generation > args.Generation {
 			lom.Unlock(false)
 			return nil
 		}
@@ -512,6 +527,9 @@ func WriteReplicaAndMeta(lom *core.LOM, args *WriteArgs) (err error) {
 			ctMeta.ObjectName(), ctMeta.Bucket())
 		return
 	}
-	err = validateBckBID(lom.Bucket(), args.BID)
+	if err = validateBckBID(lom.Bucket(), args.BID); err == nil && oldMeta != nil && !oldMeta.IsCopy {
+		// re-encoded: slice => replica
+		rmOutdated(ctMeta.Clone(fs.ECSliceType))
+	}
 	return
 }
@@ -246,6 +246,37 @@ func (mgr *Manager) EncodeObject(lom *core.LOM, cb core.OnFinishObj) error {
 	return nil
 }
 
+// ReencodeObject erasure codes an already encoded object anew, in accordance with
+// the bucket's current EC configuration; `md` is the object's current metadata
+// (the layout to replace and clean up upon success, or roll back to upon failure)
+func (mgr *Manager) ReencodeObject(lom *core.LOM, md *Metadata, cb core.OnFinishObj) error {
+	if !lom.Bprops().EC.Enabled {
+		return ErrorECDisabled
+	}
+	cs := fs.Cap()
+	if err := cs.Err(); err != nil {
+		return err
+	}
+	req := allocateReq(ActSplit, lom.LIF())
+	req.IsCopy = IsECCopy(lom.SizeBytes(), &lom.Bprops().EC)
+	req.rebuild = true
+	req.Callback = cb
+	req.prev = md
+
+	mgr.RestoreBckPutXact(lom.Bck()).encode(req, lom)
+	return nil
+}
+
+// NeedsReencode returns true if the object's EC layout (as per its metadata)
+// differs from the one prescribed by the current bucket configuration
+func NeedsReencode(md *Metadata, size int64, ecConf *cmn.ECConf) bool {
+	isCopy := IsECCopy(size, ecConf)
+	if md.IsCopy != isCopy || md.Parity != ecConf.ParitySlices {
+		return true
+	}
+	return !isCopy && md.Data != ecConf.DataSlices
+}
+
 func (mgr *Manager) CleanupObject(lom *core.LOM) {
 	if !lom.Bprops().EC.Enabled {
 		return
@@ -39,6 +39,14 @@ type (
 		cksums       []*cos.CksumHash // checksums of parity slices (filled by reed-solomon)
 		slices       []*slice         // all EC slices (in the order of slice IDs)
 		targets      []*meta.Snode    // target list (in the order of slice IDs: targets[i] receives slices[i])
+		reenc        bool             // re-encoding (the object has its previous layout in place)
+	}
+
+	// the number of data and parity slices, or replicas
+	ecLayout struct {
+		data   int
+		parity int
+		isCopy bool
 	}
 
 	// a mountpath putJogger: processes PUT/DEL requests to one mountpath
@@ -82,8 +90,8 @@ func (ctx *encodeCtx) freeReplica() {
 func (*putJogger) newCtx(lom *core.LOM, meta *Metadata) (ctx *encodeCtx, err error) {
 	ctx = allocCtx()
 	ctx.lom = lom
-	ctx.dataSlices = lom.Bprops().EC.DataSlices
-	ctx.paritySlices = lom.Bprops().EC.ParitySlices
+	ctx.dataSlices = meta.Data
+	ctx.paritySlices = meta.Parity
 	ctx.meta = meta
 
 	totalCnt := ctx.paritySlices + ctx.dataSlices
@@ -165,10 +173,19 @@ func (c *putJogger) stop() {
 func (c *putJogger) ec(req *request, lom *core.LOM) (err error) {
 	switch req.Action {
 	case ActSplit:
-		if err = c.encode(req, lom); err != nil {
-			ctMeta := core.NewCTFromLOM(lom, fs.ECMetaType)
-			errRm := cos.RemoveFile(ctMeta.FQN())
-			debug.AssertNoErr(errRm)
+		var (
+			ecConf    = lom.Bprops().EC
+			lt        = &ecLayout{data: ecConf.DataSlices, parity: ecConf.ParitySlices, isCopy: req.IsCopy}
+			attempted *Metadata
+		)
+		if attempted, err = c.encode(req, lom, lt); err != nil {
+			if req.prev != nil {
+				c.rollback(req, lom, attempted)
+			} else {
+				ctMeta := core.NewCTFromLOM(lom, fs.ECMetaType)
+				errRm := cos.RemoveFile(ctMeta.FQN())
+				debug.AssertNoErr(errRm)
+			}
 		}
 		c.parent.stats.updateEncodeTime(time.Since(req.tm), err != nil)
 	case ActDelete:
@@ -188,7 +205,9 @@ func (c *putJogger) replicate(ctx *encodeCtx) error {
 	err := c.createCopies(ctx)
 	if err != nil {
 		ctx.freeReplica()
-		c.cleanup(ctx.lom)
+		if !ctx.reenc { // (see rollback)
+			c.cleanup(ctx.lom)
+		}
 	}
 	return err
 }
@@ -203,28 +222,30 @@ func (c *putJogger) splitAndDistribute(ctx *encodeCtx) error {
 		if err != errSliceSendFailed {
 			freeSlices(ctx.slices)
 		}
-		c.cleanup(ctx.lom)
+		if !ctx.reenc { // (see rollback)
+			c.cleanup(ctx.lom)
+		}
 	}
 	return err
 }
 
-// calculates and stores data and parity slices
-func (c *putJogger) encode(req *request, lom *core.LOM) error {
+// calculates and stores data and parity slices (or replicas) in accordance with the given layout;
+// returns the resulting metadata or, in case of failure, the layout that's been attempted (if any)
+func (c *putJogger) encode(req *request, lom *core.LOM, lt *ecLayout) (*Metadata, error) {
 	if cmn.Rom.FastV(4, cos.SmoduleEC) {
 		nlog.Infof("Encoding %q...", lom)
 	}
 	var (
-		ecConf     = lom.Bprops().EC
-		reqTargets = ecConf.ParitySlices + 1
+		reqTargets = lt.parity + 1
 		smap       = core.T.Sowner().Get()
 	)
-	if !req.IsCopy {
-		reqTargets += ecConf.DataSlices
+	if !lt.isCopy {
+		reqTargets += lt.data
 	}
 	targetCnt := smap.CountActiveTs()
 	if targetCnt < reqTargets {
-		return fmt.Errorf("%v: given EC config (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
-			cmn.ErrNotEnoughTargets, ecConf.DataSlices, ecConf.ParitySlices, reqTargets, lom, targetCnt, smap.StringEx())
+		return nil, fmt.Errorf("%v: given EC config (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
+			cmn.ErrNotEnoughTargets, lt.data, lt.parity, reqTargets, lom, targetCnt, smap.StringEx())
 	}
 
 	var (
@@ -236,9 +257,9 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		MDVersion:   MDVersionLast,
 		Generation:  generation,
 		Size:        lom.SizeBytes(),
-		Data:        ecConf.DataSlices,
-		Parity:      ecConf.ParitySlices,
-		IsCopy:      req.IsCopy,
+		Data:        lt.data,
+		Parity:      lt.parity,
+		IsCopy:      lt.isCopy,
 		ObjCksum:    cksumValue,
 		CksumType:   cksumType,
 		FullReplica: core.T.SID(),
@@ -250,11 +271,12 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 	ctx, err := c.newCtx(lom, meta)
 	defer c.freeCtx(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	ctx.reenc = req.prev != nil
 	targets, err := smap.HrwTargetList(ctx.lom.Uname(), reqTargets)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ctx.targets = targets[1:]
 	meta.Daemons[targets[0].ID()] = 0 // main or full replica always on the first target
@@ -272,19 +294,77 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		err = c.splitAndDistribute(ctx)
 	}
 	if err != nil {
-		return err
+		return meta, err
 	}
 	metaBuf := bytes.NewReader(meta.NewPack())
 	if err := ctMeta.Write(metaBuf, -1); err != nil {
-		return err
+		return meta, err
 	}
 	if _, exists := core.T.Bowner().Get().Get(ctMeta.Bck()); !exists {
 		if errRm := cos.RemoveFile(ctMeta.FQN()); errRm != nil {
 			nlog.Errorf("nested error: encode -> remove metafile: %v", errRm)
 		}
-		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
+		return meta, fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
+	}
+	if req.prev != nil {
+		// the new layout is now in place (committed) - remove the outdated slices (replicas), if any
+		c.cleanupPrev(lom, req.prev, meta)
+	}
+	return meta, nil
+}
+
+// Re-encoding failed midway: some of the targets may have already stored their new slices
+// (replicas), overwriting the previous ones. Given the full replica (that is always local),
+// restore the previous layout in its entirety or, if that fails as well, make sure that
+// the object is not erasure coded at all - either way, no mix of the two.
+func (c *putJogger) rollback(req *request, lom *core.LOM, attempted *Metadata) {
+	if attempted == nil {
+		return // nothing's been sent
+	}
+	var (
+		prev = req.prev
+		lt   = &ecLayout{data: prev.Data, parity: prev.Parity, isCopy: prev.IsCopy}
+	)
+	req.prev = attempted // (to remove what's not part of the restored layout - see encode)
+	_, err := c.encode(req, lom, lt)
+	req.prev = prev
+	if err == nil {
+		nlog.Warningln(core.T.String()+":", "failed to re-encode", lom.Cname(), "- restored previous layout")
+		return
+	}
+	nlog.Errorln(core.T.String()+":", "failed to re-encode", lom.Cname(), "and restore previous layout:", err)
+	c.cleanupPrev(lom, attempted, prev)
+	if err := c.cleanup(lom); err != nil {
+		nlog.Errorf("nested error: re-encode %s -> cleanup: %v", lom, err)
+	}
+}
+
+// (re-encoding) remove slices and replicas that are not part of the new layout
+func (c *putJogger) cleanupPrev(lom *core.LOM, prev, md *Metadata) {
+	var (
+		nodes = make([]*meta.Snode, 0, len(prev.Daemons))
+		smap  = core.T.Sowner().Get()
+	)
+	for tid := range prev.Daemons {
+		if _, ok := md.Daemons[tid]; ok || tid == core.T.SID() {
+			continue
+		}
+		if tsi := smap.GetTarget(tid); tsi != nil {
+			nodes = append(nodes, tsi)
+		}
+	}
+	if len(nodes) == 0 {
+		return
+	}
+	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(g.smm)
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Callback = c.ctSendCallback
+	c.parent.IncPending()
+	if err := c.parent.mgr.req().Send(o, nil, nodes...); err != nil {
+		nlog.Errorf("%s: failed to cleanup outdated slices of %s: %v", core.T, lom, err)
 	}
-	return nil
 }
 
 func (c *putJogger) ctSendCallback(hdr *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
@@ -0,0 +1,315 @@
+// Package prmagent implements promote agent: a lightweight HTTP server that runs on a
+// non-cluster machine and streams its (local) files to AIS targets to promote them.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package prmagent
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/xoshiro256"
+	"github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	bufSize = 64 * cos.KiB
+
+	// files listed for removal (QparamDeleteSrc) remain removable for that long
+	delTTL = 24 * time.Hour
+)
+
+// Agent serves files under its configured root directories (and nothing else)
+// to the clients that present the shared secret.
+type Agent struct {
+	deletable map[string]int64 // resolved fqn => mono-time listed (see QparamDeleteSrc)
+	secret    string
+	roots     []string
+	mu        sync.Mutex
+}
+
+// interface guard
+var _ http.Handler = (*Agent)(nil)
+
+func New(roots []string, secret string) (*Agent, error) {
+	if len(roots) == 0 {
+		return nil, errors.New("promote agent: no root directories specified")
+	}
+	if secret == "" {
+		return nil, errors.New("promote agent: shared secret is required")
+	}
+	a := &Agent{roots: make([]string, 0, len(roots)), secret: secret, deletable: make(map[string]int64)}
+	for _, root := range roots {
+		if !filepath.IsAbs(root) {
+			return nil, fmt.Errorf("promote agent: root directory must be an absolute path (got %q)", root)
+		}
+		resolved, err := filepath.EvalSymlinks(root)
+		if err != nil {
+			return nil, err
+		}
+		finfo, err := os.Stat(resolved)
+		if err != nil {
+			return nil, err
+		}
+		if !finfo.IsDir() {
+			return nil, fmt.Errorf("promote agent: %q is not a directory", root)
+		}
+		a.roots = append(a.roots, resolved)
+	}
+	return a, nil
+}
+
+func (a *Agent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !a.authorized(r) {
+		http.Error(w, "promote agent: unauthorized", http.StatusUnauthorized)
+		return
+	}
+	path, fqn, status, err := a.fqn(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	switch {
+	case r.URL.Path == PathList && r.Method == http.MethodGet:
+		a.list(w, r, path, fqn)
+	case r.URL.Path == PathFile && r.Method == http.MethodGet:
+		a.get(w, r, fqn)
+	case r.URL.Path == PathFile && r.Method == http.MethodDelete:
+		a.del(w, fqn)
+	default:
+		http.Error(w, "invalid request "+r.Method+" "+r.URL.Path, http.StatusBadRequest)
+	}
+}
+
+func (a *Agent) authorized(r *http.Request) bool {
+	s := r.Header.Get(apc.HdrAuthorization)
+	token, ok := strings.CutPrefix(s, apc.AuthenticationTypeBearer+" ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(a.secret)) == 1
+}
+
+// validate and resolve the requested pathname: following symlinks (if any),
+// the resulting fqn must be under one of the (resolved) roots;
+// returns the requested (cleaned-up) path as well
+func (a *Agent) fqn(r *http.Request) (path, fqn string, _ int, _ error) {
+	path = r.URL.Query().Get(QparamPath)
+	if !filepath.IsAbs(path) {
+		return "", "", http.StatusBadRequest, fmt.Errorf("expecting absolute path, got %q", path)
+	}
+	path = filepath.Clean(path)
+	fqn, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", http.StatusNotFound, err
+		}
+		return "", "", http.StatusBadRequest, err
+	}
+	for _, root := range a.roots {
+		if fqn == root || strings.HasPrefix(fqn, root+string(filepath.Separator)) {
+			return path, fqn, 0, nil
+		}
+	}
+	return "", "", http.StatusForbidden, fmt.Errorf("%q is outside of the served directories", path)
+}
+
+// NOTE: listed pathnames are relative to the requested `path` (that may be a symlink)
+func (a *Agent) list(w http.ResponseWriter, r *http.Request, path, fqn string) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	var (
+		res       = ListResult{IsDir: finfo.IsDir()}
+		q         = r.URL.Query()
+		hrw       = hrwFromQuery(q)
+		deleteSrc = cos.IsParseBool(q.Get(QparamDeleteSrc))
+		resolved  []string
+		dir       string
+	)
+	if cos.IsParseBool(q.Get(QparamStat)) {
+		writeJSON(w, &res)
+		return
+	}
+	add := func(efqn, epath string, size int64) error {
+		if hrw != nil {
+			objName, err := ObjName(epath, dir, hrw.Prefix)
+			if err != nil {
+				return err
+			}
+			if hrw.owner(hrw.Ubase+objName) != hrw.TID {
+				return nil
+			}
+		}
+		res.Entries = append(res.Entries, Entry{FQN: epath, Size: size})
+		if deleteSrc {
+			resolved = append(resolved, efqn)
+		}
+		return nil
+	}
+	if !res.IsDir {
+		err = add(fqn, path, finfo.Size())
+	} else {
+		dir = path
+		recursive := cos.IsParseBool(q.Get(QparamRecursive))
+		err = filepath.WalkDir(fqn, func(efqn string, de fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if de.IsDir() {
+				if efqn != fqn && !recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !de.Type().IsRegular() {
+				return nil
+			}
+			finfo, err := de.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil // removed in the meantime
+				}
+				return err
+			}
+			rel, err := filepath.Rel(fqn, efqn)
+			if err != nil {
+				return err
+			}
+			return add(efqn, filepath.Join(path, rel), finfo.Size())
+		})
+	}
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	if len(resolved) > 0 {
+		a.addDeletable(resolved)
+	}
+	writeJSON(w, &res)
+}
+
+func (*Agent) get(w http.ResponseWriter, r *http.Request, fqn string) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	defer fh.Close()
+	finfo, err := fh.Stat()
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	if finfo.IsDir() {
+		http.Error(w, fqn+" is a directory", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.FormatInt(finfo.Size(), 10))
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	buf := make([]byte, bufSize)
+	if _, err := cos.CopyBuffer(w, fh, buf); err != nil {
+		nlog.Errorf("promote agent: failed to send %q to %s: %v", fqn, r.RemoteAddr, err)
+	}
+}
+
+func (a *Agent) del(w http.ResponseWriter, fqn string) {
+	a.mu.Lock()
+	_, ok := a.deletable[fqn]
+	delete(a.deletable, fqn)
+	a.mu.Unlock()
+	if !ok {
+		http.Error(w, "promote agent: "+fqn+" was not listed for removal", http.StatusForbidden)
+		return
+	}
+	if err := cos.RemoveFile(fqn); err != nil {
+		writeErr(w, err)
+	}
+}
+
+func (a *Agent) addDeletable(fqns []string) {
+	now := mono.NanoTime()
+	a.mu.Lock()
+	for fqn, listed := range a.deletable {
+		if time.Duration(now-listed) > delTTL {
+			delete(a.deletable, fqn)
+		}
+	}
+	for _, fqn := range fqns {
+		a.deletable[fqn] = now
+	}
+	a.mu.Unlock()
+}
+
+/////////////
+// HrwArgs //
+/////////////
+
+// (compare with meta.Smap.HrwName2T)
+func (hrw *HrwArgs) owner(uname string) (tid string) {
+	var (
+		max    uint64
+		digest = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	)
+	for _, id := range hrw.TIDs {
+		cs := xoshiro256.Hash(xxhash.Checksum64S(cos.UnsafeB(id), cos.MLCG32) ^ digest)
+		if cs >= max {
+			max, tid = cs, id
+		}
+	}
+	return tid
+}
+
+//
+// destination naming
+//
+
+// object name of the promoted file: prefix + the file's pathname relative to the promoted
+// directory or, when promoting a single file (dir == ""), prefix + its basename
+// (or the prefix itself, unless the latter ends with '/')
+func ObjName(objfqn, dirfqn, prefix string) (_ string, err error) {
+	var baseName string
+	if dirfqn == "" {
+		if prefix != "" && !cos.IsLastB(prefix, filepath.Separator) {
+			return prefix, nil
+		}
+		baseName = filepath.Base(objfqn)
+	} else {
+		baseName, err = filepath.Rel(dirfqn, objfqn)
+		if err != nil {
+			debug.Assert(false, err, dirfqn, objfqn)
+			return "", err
+		}
+	}
+	return prefix + baseName, nil
+}
+
+func writeErr(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if os.IsNotExist(err) {
+		status = http.StatusNotFound
+	}
+	http.Error(w, err.Error(), status)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	if err := jsoniter.NewEncoder(w).Encode(v); err != nil {
+		nlog.Errorln("promote agent: failed to write response:", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Package prmagent_test is a unit test
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package prmagent_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/prmagent"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const secret = "shared-secret"
+
+func TestPromoteAgent(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		root = t.TempDir()
+		out  = t.TempDir()
+		dir  = filepath.Join(root, "dir")
+	)
+	for _, name := range []string{"a", "b", "sub/c", "sub/deeper/d"} {
+		fqn := filepath.Join(dir, name)
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte("content of "+name), 0o644))
+	}
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(out, "secret"), []byte("secret"), 0o644))
+
+	_, err := prmagent.New([]string{root}, "")
+	tassert.Errorf(t, err != nil, "expected error: no secret")
+	agent, err := prmagent.New([]string{root}, secret)
+	tassert.CheckFatal(t, err)
+	srv := httptest.NewServer(agent)
+	defer srv.Close()
+	client := &prmagent.Client{HTTP: srv.Client(), Secret: secret}
+
+	// list
+	res, err := client.List(ctx, srv.URL, &prmagent.ListArgs{Path: dir})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, res.IsDir, "expected %q to be listed as directory", dir)
+	tassert.Errorf(t, len(fqns(res)) == 2, "expected 2 files, got %v", fqns(res))
+
+	res, err = client.List(ctx, srv.URL, &prmagent.ListArgs{Path: dir, Recursive: true})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(fqns(res)) == 4, "expected 4 files, got %v", fqns(res))
+
+	res, err = client.List(ctx, srv.URL, &prmagent.ListArgs{Path: dir, Recursive: true, Stat: true})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.IsDir && len(res.Entries) == 0, "stat: unexpected %+v", res)
+
+	fqn := filepath.Join(dir, "sub/c")
+	res, err = client.List(ctx, srv.URL, &prmagent.ListArgs{Path: fqn, Recursive: true})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !res.IsDir && len(res.Entries) == 1 && res.Entries[0].FQN == fqn &&
+		res.Entries[0].Size == int64(len("content of sub/c")), "unexpected listing %+v", res)
+
+	// get
+	r, size, err := client.Open(ctx, srv.URL, fqn)
+	tassert.CheckFatal(t, err)
+	b, err := io.ReadAll(r)
+	r.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "content of sub/c" && size == int64(len(b)), "unexpected content %q (%d)", b, size)
+
+	// no (or wrong) secret
+	for _, s := range []string{"", "guess"} {
+		c := &prmagent.Client{HTTP: srv.Client(), Secret: s}
+		_, _, err = c.Open(ctx, srv.URL, fqn)
+		herr, ok := err.(*cmn.ErrHTTP)
+		tassert.Errorf(t, ok && herr.Status == http.StatusUnauthorized,
+			"secret %q: expected %d, got %v", s, http.StatusUnauthorized, err)
+	}
+
+	// outside of the served root(s), including via symlink
+	link := filepath.Join(dir, "link")
+	tassert.CheckFatal(t, os.Symlink(filepath.Join(out, "secret"), link))
+	for _, path := range []string{filepath.Join(out, "secret"), filepath.Join(dir, "../../", filepath.Base(out), "secret"),
+		"relative", link} {
+		_, _, err = client.Open(ctx, srv.URL, path)
+		tassert.Errorf(t, err != nil, "expected %q to be rejected", path)
+	}
+
+	// delete: only files listed on behalf of delete-source request
+	err = client.Remove(ctx, srv.URL, fqn)
+	tassert.Errorf(t, err != nil, "expected %q removal to be rejected", fqn)
+	_, err = client.List(ctx, srv.URL, &prmagent.ListArgs{Path: fqn, DeleteSrc: true})
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, client.Remove(ctx, srv.URL, fqn))
+	_, err = os.Stat(fqn)
+	tassert.Errorf(t, os.IsNotExist(err), "expected %q to be removed (err: %v)", fqn, err)
+	_, _, err = client.Open(ctx, srv.URL, fqn)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err), "expected %d, got %v", http.StatusNotFound, err)
+}
+
+// each target lists exactly its HRW share
+func TestPromoteAgentHrw(t *testing.T) {
+	const numFiles, numTargets = 200, 5
+	var (
+		ctx  = context.Background()
+		root = t.TempDir()
+		bck  = cmn.Bck{Name: "bck", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		smap = &meta.Smap{Tmap: make(meta.NodeMap, numTargets)}
+		tids []string
+	)
+	for i := 0; i < numFiles; i++ {
+		tassert.CheckFatal(t, os.WriteFile(filepath.Join(root, "f"+strconv.Itoa(i)), []byte{1}, 0o644))
+	}
+	for i := 0; i < numTargets; i++ {
+		si := &meta.Snode{}
+		si.Init("t"+strconv.Itoa(i), apc.Target)
+		smap.Tmap[si.ID()] = si
+		tids = append(tids, si.ID())
+	}
+	agent, err := prmagent.New([]string{root}, secret)
+	tassert.CheckFatal(t, err)
+	srv := httptest.NewServer(agent)
+	defer srv.Close()
+	client := &prmagent.Client{HTTP: srv.Client(), Secret: secret}
+
+	var total int
+	for _, tid := range tids {
+		hrw := &prmagent.HrwArgs{TID: tid, TIDs: tids, Ubase: bck.MakeUname(""), Prefix: "pre/"}
+		res, err := client.List(ctx, srv.URL, &prmagent.ListArgs{Path: root, Hrw: hrw})
+		tassert.CheckFatal(t, err)
+		for _, e := range res.Entries {
+			objName, err := prmagent.ObjName(e.FQN, root, "pre/")
+			tassert.CheckFatal(t, err)
+			si, err := smap.HrwName2T(bck.MakeUname(objName))
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, si.ID() == tid, "%s: listed for %s, expected %s", e.FQN, tid, si.ID())
+		}
+		total += len(res.Entries)
+	}
+	tassert.Errorf(t, total == numFiles, "expected %d files in total, got %d", numFiles, total)
+}
+
+func fqns(res *prmagent.ListResult) (l []string) {
+	for _, e := range res.Entries {
+		l = append(l, e.FQN)
+	}
+	sort.Strings(l)
+	return l
+}
@@ -0,0 +1,99 @@
+// Package prmagent implements promote agent: a lightweight HTTP server that runs on a
+// non-cluster machine and streams its (local) files to AIS targets to promote them.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package prmagent
+
+import (
+	"net/url"
+	"strings"
+)
+
+// promote agent API: list (file or directory), get (file), and delete (file)
+//
+// GET    /v1/prm/list?path=/abs/dir&rcr=true  - list files; returns `ListResult`
+// GET    /v1/prm/file?path=/abs/file          - read file
+// DELETE /v1/prm/file?path=/abs/file          - remove file (apc.PromoteArgs.DeleteSrc)
+//
+// Every request must carry the shared secret: 'Authorization: Bearer <secret>'
+// (see env.AIS.PrmSecret). A file can be removed only if it was previously listed
+// with `del=true` - that is, on behalf of a promote request that asked to delete sources.
+
+const (
+	PathList = "/v1/prm/list"
+	PathFile = "/v1/prm/file"
+
+	QparamPath      = "path"
+	QparamRecursive = "rcr"
+	QparamStat      = "stat" // list: only check that the path exists (and whether it's a directory)
+	QparamDeleteSrc = "del"  // list: listed files are to be removed once promoted
+
+	// list: HRW filter - list only the files that "land" on a given target (see HrwArgs)
+	QparamTID    = "tid"
+	QparamTIDs   = "tids"
+	QparamUbase  = "ubase"
+	QparamPrefix = "prefix"
+)
+
+const DefaultPort = 51081
+
+type (
+	Entry struct {
+		FQN  string `json:"fqn"` // agent-local (absolute) pathname
+		Size int64  `json:"size"`
+	}
+	ListResult struct {
+		Entries []Entry `json:"entries"`
+		IsDir   bool    `json:"dir"` // the listed `path` is a directory
+	}
+
+	ListArgs struct {
+		Hrw       *HrwArgs // (optional)
+		Path      string
+		Recursive bool
+		Stat      bool
+		DeleteSrc bool
+	}
+	// destination object name is ObjName(fqn, dir, Prefix); the file is listed
+	// if and only if target TID is the HRW owner of Ubase + object name among TIDs
+	HrwArgs struct {
+		TID    string   // the requesting target
+		TIDs   []string // all (active) targets
+		Ubase  string   // destination bucket's uname (cmn.Bck.MakeUname(""))
+		Prefix string   // destination object name prefix (apc.PromoteArgs.ObjName)
+	}
+)
+
+func (args *ListArgs) query() url.Values {
+	q := url.Values{QparamPath: []string{args.Path}}
+	if args.Recursive {
+		q.Set(QparamRecursive, "true")
+	}
+	if args.Stat {
+		q.Set(QparamStat, "true")
+	}
+	if args.DeleteSrc {
+		q.Set(QparamDeleteSrc, "true")
+	}
+	if hrw := args.Hrw; hrw != nil {
+		q.Set(QparamTID, hrw.TID)
+		q.Set(QparamTIDs, strings.Join(hrw.TIDs, ","))
+		q.Set(QparamUbase, hrw.Ubase)
+		q.Set(QparamPrefix, hrw.Prefix)
+	}
+	return q
+}
+
+func hrwFromQuery(q url.Values) *HrwArgs {
+	tid := q.Get(QparamTID)
+	if tid == "" {
+		return nil
+	}
+	return &HrwArgs{
+		TID:    tid,
+		TIDs:   strings.Split(q.Get(QparamTIDs), ","),
+		Ubase:  q.Get(QparamUbase),
+		Prefix: q.Get(QparamPrefix),
+	}
+}
@@ -0,0 +1,79 @@
+// Package prmagent implements promote agent: a lightweight HTTP server that runs on a
+// non-cluster machine and streams its (local) files to AIS targets to promote them.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package prmagent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// client side (AIS target)
+
+type Client struct {
+	HTTP   *http.Client
+	Secret string // shared secret (see env.AIS.PrmSecret)
+}
+
+func (c *Client) List(ctx context.Context, agent string, args *ListArgs) (*ListResult, error) {
+	resp, err := c.do(ctx, http.MethodGet, agent, PathList, args.query())
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(resp.Body)
+	res := &ListResult{}
+	if err := jsoniter.NewDecoder(resp.Body).Decode(res); err != nil {
+		return nil, fmt.Errorf("promote agent %s: failed to decode %q listing: %w", agent, args.Path, err)
+	}
+	return res, nil
+}
+
+// the caller must close the returned reader
+func (c *Client) Open(ctx context.Context, agent, fqn string) (io.ReadCloser, int64, error) {
+	resp, err := c.do(ctx, http.MethodGet, agent, PathFile, url.Values{QparamPath: []string{fqn}})
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+func (c *Client) Remove(ctx context.Context, agent, fqn string) error {
+	resp, err := c.do(ctx, http.MethodDelete, agent, PathFile, url.Values{QparamPath: []string{fqn}})
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, agent, path string, q url.Values) (*http.Response, error) {
+	u := strings.TrimSuffix(agent, "/") + path + "?" + q.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+c.Secret)
+	resp, err := c.HTTP.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, cos.KiB))
+	resp.Body.Close()
+	msg := strings.TrimSpace(string(b))
+	return nil, cmn.NewErrHTTP(req, fmt.Errorf("promote agent %s: %s", agent, msg), resp.StatusCode)
+}
@@ -0,0 +1,96 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Checkpoints are tiny per-mountpath files that record the last name processed
+// by a resumable (paused, aborted, or interrupted by node restart) traversal.
+// The tag identifies the job - typically, xaction kind and bucket(s) - so that
+// the next run of the same job can pick up where the previous one left off.
+
+func (mi *Mountpath) checkpointPath(tag string) string {
+	return filepath.Join(mi.Path, fname.CheckpointsDir, tag)
+}
+
+// returns empty string when there's no checkpoint
+func (mi *Mountpath) LoadCheckpoint(tag string) (name string) {
+	line, err := cos.ReadOneLine(mi.checkpointPath(tag))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(mi.String(), "failed to load checkpoint", tag, "err:", err)
+		}
+		return ""
+	}
+	return line
+}
+
+func (mi *Mountpath) PersistCheckpoint(tag, name string) error {
+	var (
+		fpath = mi.checkpointPath(tag)
+		tmp   = fpath + ".tmp"
+	)
+	if err := os.WriteFile(tmp, []byte(name+"\n"), cos.PermRWR); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if err = cos.CreateDir(filepath.Dir(tmp)); err != nil {
+			return err
+		}
+		if err = os.WriteFile(tmp, []byte(name+"\n"), cos.PermRWR); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, fpath)
+}
+
+func (mi *Mountpath) RemoveCheckpoint(tag string) error {
+	return cos.RemoveFile(mi.checkpointPath(tag))
+}
+
+// remove a given checkpoint from all available mountpaths
+func RemoveCheckpoints(tag string) {
+	for _, mi := range GetAvail() {
+		if err := mi.RemoveCheckpoint(tag); err != nil {
+			nlog.Errorln(mi.String(), "failed to remove checkpoint", tag, "err:", err)
+		}
+	}
+}
+
+// CmpWalkOrder compares two pathnames in the order of sorted (depth-first) traversal,
+// i.e., component by component - note that this is different from plain string comparison
+// (e.g., "a/b" precedes "a-c" in the walk, even though '-' < '/').
+func CmpWalkOrder(a, b string) int {
+	for {
+		ia, ib := strings.IndexByte(a, filepath.Separator), strings.IndexByte(b, filepath.Separator)
+		ca, cb := a, b
+		if ia >= 0 {
+			ca = a[:ia]
+		}
+		if ib >= 0 {
+			cb = b[:ib]
+		}
+		if c := strings.Compare(ca, cb); c != 0 {
+			return c
+		}
+		switch {
+		case ia < 0 && ib < 0:
+			return 0
+		case ia < 0:
+			return -1 // parent directory precedes its content
+		case ib < 0:
+			return 1
+		}
+		a, b = a[ia+1:], b[ib+1:]
+	}
+}
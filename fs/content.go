@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -38,8 +39,13 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	LsoCacheType = "lc" // persistent cache of the remote bucket's listing (xact/xs/lso_cache.go)
+	BlobDlType   = "bd" // resumable blob download: workfile and checkpoint (xact/xs/blob_download.go)
 )
 
+// abandoned (not updated for so long) blob downloads get removed by space cleanup
+const BlobDlExpiry = 24 * time.Hour
+
 type (
 	ContentResolver interface {
 		// When set to true, services like rebalance have permission to move
@@ -177,6 +183,8 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	LsoCacheContentResolver struct{}
+	BlobDlContentResolver   struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -251,3 +259,37 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+func (*LsoCacheContentResolver) PermToMove() bool    { return false }
+func (*LsoCacheContentResolver) PermToEvict() bool   { return false }
+func (*LsoCacheContentResolver) PermToProcess() bool { return false }
+
+func (*LsoCacheContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*LsoCacheContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+func (*BlobDlContentResolver) PermToMove() bool    { return false }
+func (*BlobDlContentResolver) PermToEvict() bool   { return true }
+func (*BlobDlContentResolver) PermToProcess() bool { return false }
+
+// deterministic (to resume): prefix.name (whereby prefix is one of the WorkfileBlob* enumerated in fqn.go)
+func (*BlobDlContentResolver) GenUniqueFQN(base, prefix string) string {
+	dir, fname := filepath.Split(base)
+	return filepath.Join(dir, prefix+"."+fname)
+}
+
+// (expiration is determined by the caller - see BlobDlExpiry)
+func (*BlobDlContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	i := strings.IndexByte(base, '.')
+	if i < 0 {
+		return "", false, false
+	}
+	switch base[:i] {
+	case WorkfileBlobDl, WorkfileBlobCkpt:
+		return base[i+1:], false, true
+	default:
+		return "", false, false
+	}
+}
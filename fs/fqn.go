@@ -32,6 +32,8 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileBlobDl       = "blob-dl"        // blob download (resumable)
+	WorkfileBlobCkpt     = "blob-ckpt"      // blob download checkpoint
 )
 
 type ParsedFQN struct {
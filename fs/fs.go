@@ -22,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/OneOfOne/xxhash"
@@ -29,15 +30,23 @@ import (
 
 const bidUnknownTTL = 2 * time.Minute // comment below; TODO: unify and move to config along w/ lom cache
 
+// background jobs keep yielding to high-priority foreground I/O for a while after it's done
+// (so that back-to-back requests don't get interleaved with background reads and writes)
+const hipriLinger = 100 * time.Millisecond
+
 const nodeXattrID = "user.ais.daemon_id"
 
 // enum Mountpath.Flags
 const (
 	FlagBeingDisabled uint64 = 1 << iota
 	FlagBeingDetached
+	FlagReadOnly // keeps serving reads while new content gets placed elsewhere (and resilver drains it)
 )
 
-const FlagWaitingDD = FlagBeingDisabled | FlagBeingDetached
+const (
+	FlagWaitingDD = FlagBeingDisabled | FlagBeingDetached
+	FlagNoWrite   = FlagWaitingDD | FlagReadOnly // excluded from placing new content
+)
 
 // Terminology:
 // - a mountpath is equivalent to (configurable) fspath - both terms are used interchangeably;
@@ -51,11 +60,16 @@ type (
 		lomCaches  cos.MultiSyncMap // LOM caches
 		info       string
 		Path       string   // clean path
+		Label      string   // optional (e.g. "nvme", "hdd"), to restrict placement - see HrwLabel
 		cos.FS              // underlying filesystem
 		Disks      []string // owned disks (ios.FsDisks map => slice)
 		flags      uint64   // bit flags (set/get atomic)
 		PathDigest uint64   // (HRW logic)
 		capacity   Capacity
+		hipri      struct {
+			n    atomic.Int32 // in progress
+			last atomic.Int64 // mono-time of the last one done
+		}
 	}
 	MPI map[string]*Mountpath
 
@@ -117,11 +131,16 @@ func NewMountpath(mpath string) (mi *Mountpath, err error) {
 	if fsInfo, err = makeFsInfo(cleanMpath); err != nil {
 		return
 	}
+	fsp := &cmn.GCO.Get().FSP
 	mi = &Mountpath{
 		Path:       cleanMpath,
+		Label:      fsp.Label(cleanMpath),
 		FS:         fsInfo,
 		PathDigest: xxhash.Checksum64S(cos.UnsafeB(cleanMpath), cos.MLCG32),
 	}
+	if mi.Label == "" {
+		mi.Label = fsp.Label(mpath)
+	}
 	return
 }
 
@@ -144,12 +163,21 @@ func (mi *Mountpath) String() string {
 		default:
 			mi.info = fmt.Sprintf("mp[%s, %v]", mi.Path, mi.Disks)
 		}
+		if mi.Label != "" {
+			l := len(mi.info)
+			mi.info = mi.info[:l-1] + ", label=" + mi.Label + "]"
+		}
 	}
-	if !mi.IsAnySet(FlagWaitingDD) {
+	switch {
+	case mi.IsAnySet(FlagWaitingDD):
+		l := len(mi.info)
+		return mi.info[:l-1] + ", waiting-dd]"
+	case mi.IsAnySet(FlagReadOnly):
+		l := len(mi.info)
+		return mi.info[:l-1] + ", read-only]"
+	default:
 		return mi.info
 	}
-	l := len(mi.info)
-	return mi.info[:l-1] + ", waiting-dd]"
 }
 
 func (mi *Mountpath) LomCache(idx int) *sync.Map { return mi.lomCaches.Get(idx) }
@@ -157,10 +185,30 @@ func (mi *Mountpath) LomCache(idx int) *sync.Map { return mi.lomCaches.Get(idx)
 func LcacheIdx(digest uint64) int { return int(digest & cos.MultiSyncMapMask) }
 
 func (mi *Mountpath) IsIdle(config *cmn.Config) bool {
+	if mi.HiPriActive() {
+		return false
+	}
 	curr := mfs.ios.GetMpathUtil(mi.Path)
 	return curr >= 0 && curr < config.Disk.DiskUtilLowWM
 }
 
+// foreground high-priority I/O (e.g., GET from a bucket with rate_limit.high_priority):
+// background jobs (xactions) yield - see mpather jogger
+func (mi *Mountpath) HiPriBegin() { mi.hipri.n.Inc() }
+
+func (mi *Mountpath) HiPriEnd() {
+	mi.hipri.last.Store(mono.NanoTime())
+	mi.hipri.n.Dec()
+}
+
+func (mi *Mountpath) HiPriActive() bool {
+	if mi.hipri.n.Load() > 0 {
+		return true
+	}
+	last := mi.hipri.last.Load()
+	return last != 0 && mono.Since(last) < hipriLinger
+}
+
 func (mi *Mountpath) CreateMissingBckDirs(bck *cmn.Bck) (err error) {
 	for contentType := range CSM.m {
 		dir := mi.MakePathCT(bck, contentType)
@@ -449,6 +497,11 @@ func (mi *Mountpath) ClearDD() {
 	cos.ClearfAtomic(&mi.flags, FlagWaitingDD)
 }
 
+// (VMD => MPI upon startup; compare with ReadOnlyMpath)
+func (mi *Mountpath) SetReadOnly() {
+	cos.SetfAtomic(&mi.flags, FlagReadOnly)
+}
+
 func (mi *Mountpath) diskSize() (size uint64) {
 	numBlocks, _, blockSize, err := ios.GetFSStats(mi.Path)
 	if err != nil {
@@ -507,6 +560,7 @@ func Clblk()                                   { ios.Clblk(mfs.ios) }
 func GetAllMpathUtils() (utils *ios.MpathUtil) { return mfs.ios.GetAllMpathUtils() }
 func GetMpathUtil(mpath string) int64          { return mfs.ios.GetMpathUtil(mpath) }
 func FillDiskStats(m ios.AllDiskStats)         { mfs.ios.FillDiskStats(m) }
+func FillMpathStats(m ios.AllMpathStats)       { mfs.ios.FillMpathStats(m) }
 
 // TestDisableValidation disables fsid checking and allows mountpaths without disks (testing-only)
 func TestDisableValidation() { mfs.allowSharedDisksAndNoDisks = true }
@@ -531,17 +585,33 @@ func MountpathsToLists() (mpl *apc.MountpathList) {
 			mpl.WaitingDD = append(mpl.WaitingDD, mi.Path)
 		} else {
 			mpl.Available = append(mpl.Available, mi.Path)
+			if mi.IsAnySet(FlagReadOnly) {
+				mpl.ReadOnly = append(mpl.ReadOnly, mi.Path)
+			}
 		}
+		_addLabel(mpl, mi)
 	}
-	for mpath := range disabled {
+	for mpath, mi := range disabled {
 		mpl.Disabled = append(mpl.Disabled, mpath)
+		_addLabel(mpl, mi)
 	}
 	sort.Strings(mpl.Available)
+	sort.Strings(mpl.ReadOnly)
 	sort.Strings(mpl.WaitingDD)
 	sort.Strings(mpl.Disabled)
 	return
 }
 
+func _addLabel(mpl *apc.MountpathList, mi *Mountpath) {
+	if mi.Label == "" {
+		return
+	}
+	if mpl.Labels == nil {
+		mpl.Labels = make(map[string]string, 2)
+	}
+	mpl.Labels[mi.Path] = mi.Label
+}
+
 // NOTE: must be under mfs lock
 func _cloneOne(mpis MPI) (clone MPI) {
 	clone = make(MPI, len(mpis))
@@ -573,13 +643,22 @@ func Add(mpath, tid string) (mi *Mountpath, err error) {
 }
 
 // Add adds new mountpath to the target's `avail`
+// - label (optional) overrides the one configured, if any
 // TODO: extend `force=true` to disregard "filesystem sharing"
-func AddMpath(mpath, tid string, cb func(), force bool) (mi *Mountpath, err error) {
+func AddMpath(mpath, label, tid string, cb func(), force bool) (mi *Mountpath, err error) {
 	debug.Assert(tid != "")
+	if label != "" {
+		if err = cmn.ValidateMpathLabel(label); err != nil {
+			return
+		}
+	}
 	mi, err = NewMountpath(mpath)
 	if err != nil {
 		return
 	}
+	if label != "" {
+		mi.Label = label
+	}
 	config := cmn.GCO.Get()
 	if config.TestingEnv() {
 		if err = config.LocalConfig.TestFSP.ValidateMpath(mi.Path); err != nil {
@@ -805,7 +884,7 @@ func Disable(mpath string, cb ...func()) (disabledMpath *Mountpath, err error) {
 			return
 		}
 		availableCopy, disabledCopy := cloneMPI()
-		cos.ClearfAtomic(&mi.flags, FlagWaitingDD)
+		cos.ClearfAtomic(&mi.flags, FlagWaitingDD|FlagReadOnly)
 		disabledCopy[cleanMpath] = mi
 
 		config := cmn.GCO.Get()
@@ -831,11 +910,87 @@ func Disable(mpath string, cb ...func()) (disabledMpath *Mountpath, err error) {
 	return nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
 }
 
+// ReadOnlyMpath marks available mountpath as read-only or, when `readOnly` is false,
+// restores its read-write status. Read-only mountpath continues to serve reads
+// while all new content (including replicas and EC slices) gets placed elsewhere.
+// Returns nil if there's nothing to do.
+func ReadOnlyMpath(mpath string, readOnly bool, cb func()) (*Mountpath, error) {
+	cleanMpath, err := cmn.ValidateMpath(mpath)
+	if err != nil {
+		return nil, err
+	}
+	mfs.mu.Lock()
+	defer mfs.mu.Unlock()
+
+	avail, disabled := Get()
+	mi, ok := avail[cleanMpath]
+	if !ok {
+		if !readOnly {
+			return nil, nil
+		}
+		if _, ok = disabled[cleanMpath]; ok {
+			return nil, fmt.Errorf("mountpath %q is disabled (hint: enable it first)", mpath)
+		}
+		return nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
+	}
+	if mi.IsAnySet(FlagWaitingDD) {
+		if !readOnly {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s is being disabled or detached", mi)
+	}
+	if mi.IsAnySet(FlagReadOnly) == readOnly {
+		return nil, nil
+	}
+	if readOnly {
+		var numWritable int
+		for _, mpi := range avail {
+			if mpi != mi && !mpi.IsAnySet(FlagNoWrite) {
+				numWritable++
+			}
+		}
+		if numWritable == 0 {
+			return nil, fmt.Errorf("cannot mark %s read-only: no other writable mountpaths", mi)
+		}
+	}
+	clone := _cloneOne(avail)
+	if readOnly {
+		cos.SetfAtomic(&mi.flags, FlagReadOnly)
+	} else {
+		cos.ClearfAtomic(&mi.flags, FlagReadOnly)
+	}
+	putAvailMPI(clone)
+	if cb != nil {
+		cb()
+	}
+	return mi, nil
+}
+
+// true if there's at least one read-only mountpath
+func HasReadOnly() bool {
+	for _, mi := range GetAvail() {
+		if mi.IsAnySet(FlagReadOnly) {
+			return true
+		}
+	}
+	return false
+}
+
 func NumAvail() int {
 	avail := GetAvail()
 	return len(avail)
 }
 
+// number of available mountpaths labeled `label` (see Mountpath.Label)
+func NumAvailLabel(label string) (n int) {
+	for _, mi := range GetAvail() {
+		if mi.Label == label {
+			n++
+		}
+	}
+	return n
+}
+
 // returns both available and disabled mountpaths (compare with GetAvail)
 func Get() (MPI, MPI) {
 	var (
@@ -11,11 +11,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 )
 
 const (
@@ -33,11 +36,14 @@ const (
 type (
 	fspathDispatcher interface {
 		DisableMpath(mpath, reason string) (err error)
+		SlowMpath(mpath, reason string, readOnly bool)
 	}
 	FSHC struct {
 		dispatcher fspathDispatcher // listener is notified upon mountpath events (disabled, etc.)
 		fileListCh chan string
 		stopCh     cos.StopCh
+		mstats     ios.AllMpathStats
+		slow       slowDisk
 	}
 )
 
@@ -59,8 +65,12 @@ func (*FSHC) Name() string { return "fshc" }
 func (f *FSHC) Run() error {
 	nlog.Infof("Starting %s", f.Name())
 
+	ticker := time.NewTicker(cmn.GCO.Get().Periodic.StatsTime.D())
+	defer ticker.Stop()
 	for {
 		select {
+		case <-ticker.C:
+			f.checkSlow(cmn.GCO.Get())
 		case filePath := <-f.fileListCh:
 			mi, err := fs.Path2Mpath(filePath)
 			if err != nil {
@@ -87,6 +97,31 @@ func (f *FSHC) OnErr(fqn string) {
 	f.fileListCh <- fqn
 }
 
+// mountpath latency history (nil when slow-disk detection is disabled)
+func (f *FSHC) Health() map[string]*apc.MpathHealth { return f.slow.health() }
+
+func (f *FSHC) checkSlow(config *cmn.Config) {
+	c := &config.FSHC
+	if !c.Enabled || c.SlowFactor == 0 {
+		return
+	}
+	if f.mstats == nil {
+		f.mstats = make(ios.AllMpathStats, 4)
+	}
+	fs.FillMpathStats(f.mstats)
+	flagged, cleared := f.slow.sample(f.mstats, time.Now().UnixNano(), c.SlowFactor, c.SlowCount)
+	for _, mpath := range flagged {
+		reason := fmt.Sprintf("IO latency %s exceeds %dx median latency of the other mountpaths for %d consecutive samples",
+			time.Duration(f.mstats[mpath].Lat)*time.Microsecond, c.SlowFactor, c.SlowCount)
+		f.dispatcher.SlowMpath(mpath, reason, c.SlowAction == cmn.FshcSlowReadOnly)
+	}
+	for _, mpath := range cleared {
+		nlog.Infof("mountpath %s is no longer slow", mpath)
+	}
+	avail, disabled := fs.Get()
+	f.slow.prune(avail, disabled)
+}
+
 func isTestPassed(mpath string, readErrors, writeErrors int, available bool) (passed bool, err error) {
 	config := &cmn.GCO.Get().FSHC
 	nlog.Infof("Tested mountpath %s(%v), read: %d of %d, write(size=%d): %d of %d",
@@ -74,6 +74,8 @@ func (d *MockFSDispatcher) DisableMpath(mpath, reason string) (err error) {
 	return
 }
 
+func (*MockFSDispatcher) SlowMpath(string, string, bool) {}
+
 func setupTests(t *testing.T) {
 	updateTestConfig()
 	initMountpaths(t)
@@ -0,0 +1,147 @@
+// Package health provides a basic mountpath health monitor.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ *
+ */
+package health
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Slow-disk detection: a dying disk often does not fail IOs - it merely becomes
+// (10x, 50x) slower and drags down every request that lands on it.
+// Periodically, compare each mountpath's average IO latency with the median latency
+// of its (busy) peers; a mountpath that is a persistent outlier gets flagged as slow.
+// The flag clears after the same number of consecutive normal samples.
+// Idle mountpaths (no completed IOs) are recorded but otherwise do not count.
+// See also: cmn.FSHCConf (slow_factor, slow_count, slow_action).
+
+const (
+	slowHistSize = 60   // samples per mountpath
+	slowMinLat   = 1000 // never flag a mountpath with latency under 1ms (regardless of the ratio)
+)
+
+type (
+	slowDisk struct {
+		hist map[string]*mpathHist
+		mu   sync.Mutex
+	}
+	mpathHist struct {
+		samples  [slowHistSize]apc.MpathSample
+		since    int64 // flagged as slow
+		idx      int
+		cnt      int
+		outliers int // consecutive
+		normal   int // ditto (to clear the flag)
+	}
+)
+
+// updates per-mountpath history and returns mountpaths that are, respectively,
+// newly flagged and no longer slow
+func (sd *slowDisk) sample(stats ios.AllMpathStats, now int64, factor, count int) (flagged, cleared []string) {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if sd.hist == nil {
+		sd.hist = make(map[string]*mpathHist, len(stats))
+	}
+	for mpath, st := range stats {
+		h, ok := sd.hist[mpath]
+		if !ok {
+			h = &mpathHist{}
+			sd.hist[mpath] = h
+		}
+		s := apc.MpathSample{Time: now, Util: st.Util, Lat: st.Lat}
+		if st.Lat > 0 {
+			if median := peersMedian(stats, mpath); median > 0 {
+				s.Ratio = float64(st.Lat) / float64(median)
+			}
+		}
+		h.add(&s)
+
+		switch {
+		case st.Lat == 0 || s.Ratio == 0: // idle or no peers to compare with
+		case s.Ratio >= float64(factor) && st.Lat >= slowMinLat:
+			h.outliers++
+			h.normal = 0
+			if h.outliers >= count && h.since == 0 {
+				h.since = now
+				flagged = append(flagged, mpath)
+			}
+		default:
+			h.outliers = 0
+			if h.since != 0 {
+				h.normal++
+				if h.normal >= count {
+					h.since, h.normal = 0, 0
+					cleared = append(cleared, mpath)
+				}
+			}
+		}
+	}
+	return flagged, cleared
+}
+
+// median latency of the other busy mountpaths
+func peersMedian(stats ios.AllMpathStats, mpath string) int64 {
+	lats := make([]int64, 0, len(stats))
+	for mp, st := range stats {
+		if mp != mpath && st.Lat > 0 {
+			lats = append(lats, st.Lat)
+		}
+	}
+	l := len(lats)
+	if l == 0 {
+		return 0
+	}
+	slices.Sort(lats)
+	if l&1 == 1 {
+		return lats[l/2]
+	}
+	return (lats[l/2-1] + lats[l/2]) / 2
+}
+
+// keep history of the mountpaths that are still there (including disabled)
+func (sd *slowDisk) prune(avail, disabled fs.MPI) {
+	sd.mu.Lock()
+	for mpath := range sd.hist {
+		if _, ok := avail[mpath]; ok {
+			continue
+		}
+		if _, ok := disabled[mpath]; ok {
+			continue
+		}
+		delete(sd.hist, mpath)
+	}
+	sd.mu.Unlock()
+}
+
+func (sd *slowDisk) health() map[string]*apc.MpathHealth {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if len(sd.hist) == 0 {
+		return nil
+	}
+	res := make(map[string]*apc.MpathHealth, len(sd.hist))
+	for mpath, h := range sd.hist {
+		mh := &apc.MpathHealth{History: make([]apc.MpathSample, 0, h.cnt), SlowSince: h.since, Outliers: h.outliers}
+		for i := h.cnt; i > 0; i-- {
+			mh.History = append(mh.History, h.samples[(h.idx-i+slowHistSize)%slowHistSize])
+		}
+		res[mpath] = mh
+	}
+	return res
+}
+
+func (h *mpathHist) add(s *apc.MpathSample) {
+	h.samples[h.idx] = *s
+	h.idx = (h.idx + 1) % slowHistSize
+	if h.cnt < slowHistSize {
+		h.cnt++
+	}
+}
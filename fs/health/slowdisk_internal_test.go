@@ -0,0 +1,120 @@
+// Package health provides a basic mountpath health monitor.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package health
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSlowDiskDetection(t *testing.T) {
+	const (
+		factor = 10
+		count  = 3
+	)
+	var (
+		sd    slowDisk
+		now   int64
+		stats = func(slow int64) ios.AllMpathStats {
+			return ios.AllMpathStats{
+				"/mp1": {Util: 40, Lat: 2000},
+				"/mp2": {Util: 45, Lat: 2500},
+				"/mp3": {Util: 35, Lat: 1800},
+				"/mp4": {Util: 99, Lat: slow},
+			}
+		}
+		step = func(slow int64) (flagged, cleared []string) {
+			now++
+			return sd.sample(stats(slow), now, factor, count)
+		}
+	)
+
+	// normal
+	for i := 0; i < 5; i++ {
+		flagged, cleared := step(3000)
+		tassert.Fatalf(t, len(flagged) == 0 && len(cleared) == 0, "unexpected %v, %v", flagged, cleared)
+	}
+
+	// 50x slower: flagged after `count` consecutive samples (and only once)
+	for i := 1; i <= count+2; i++ {
+		flagged, _ := step(100_000)
+		if i == count {
+			tassert.Fatalf(t, len(flagged) == 1 && flagged[0] == "/mp4", "expecting /mp4 flagged, got %v", flagged)
+		} else {
+			tassert.Fatalf(t, len(flagged) == 0, "sample %d: unexpected %v", i, flagged)
+		}
+	}
+	h := sd.health()["/mp4"]
+	tassert.Fatalf(t, h.SlowSince == int64(5+count), "expecting slow since sample %d, got %d", 5+count, h.SlowSince)
+	last := h.History[len(h.History)-1]
+	tassert.Errorf(t, last.Ratio > 40 && last.Lat == 100_000, "unexpected last sample %+v", last)
+
+	// idle: recorded, does not count either way
+	for i := 0; i < count+1; i++ {
+		flagged, cleared := step(0)
+		tassert.Fatalf(t, len(flagged) == 0 && len(cleared) == 0, "idle: unexpected %v, %v", flagged, cleared)
+	}
+	tassert.Errorf(t, sd.health()["/mp4"].SlowSince != 0, "idle mountpath must remain flagged")
+
+	// recovered: cleared after `count` consecutive normal samples
+	for i := 1; i <= count; i++ {
+		_, cleared := step(2200)
+		if i == count {
+			tassert.Fatalf(t, len(cleared) == 1 && cleared[0] == "/mp4", "expecting /mp4 cleared, got %v", cleared)
+		} else {
+			tassert.Fatalf(t, len(cleared) == 0, "sample %d: unexpected %v", i, cleared)
+		}
+	}
+
+	// interrupted streak does not flag
+	for i := 0; i < 10; i++ {
+		lat := int64(100_000)
+		if i%count == count-1 {
+			lat = 2000
+		}
+		flagged, _ := step(lat)
+		tassert.Fatalf(t, len(flagged) == 0, "interrupted streak: unexpected %v", flagged)
+	}
+
+	// history is bounded and ordered
+	h = sd.health()["/mp1"]
+	tassert.Errorf(t, len(h.History) == int(now) || len(h.History) == slowHistSize, "history length %d", len(h.History))
+	for i := 1; i < len(h.History); i++ {
+		tassert.Fatalf(t, h.History[i].Time > h.History[i-1].Time, "history out of order at %d", i)
+	}
+	for now < slowHistSize+10 {
+		step(2000)
+	}
+	h = sd.health()["/mp1"]
+	tassert.Fatalf(t, len(h.History) == slowHistSize, "expecting %d samples, got %d", slowHistSize, len(h.History))
+	tassert.Errorf(t, h.History[slowHistSize-1].Time == now, "expecting the most recent sample last")
+
+	// detached mountpaths are pruned
+	sd.prune(fs.MPI{"/mp1": nil, "/mp2": nil}, fs.MPI{"/mp3": nil})
+	tassert.Errorf(t, len(sd.health()) == 3, "expecting 3 mountpaths after pruning, got %d", len(sd.health()))
+}
+
+func TestSlowDiskPeers(t *testing.T) {
+	var sd slowDisk
+
+	// no peers: nothing to compare with
+	for i := int64(1); i <= 10; i++ {
+		flagged, _ := sd.sample(ios.AllMpathStats{"/mp1": {Util: 100, Lat: 1_000_000}}, i, 2, 1)
+		tassert.Fatalf(t, len(flagged) == 0, "single mountpath: unexpected %v", flagged)
+	}
+	tassert.Errorf(t, sd.health()["/mp1"].History[0].Ratio == 0, "expecting zero ratio without peers")
+
+	// fast disks: above the ratio but under the absolute minimum
+	for i := int64(1); i <= 10; i++ {
+		flagged, _ := sd.sample(ios.AllMpathStats{"/mp1": {Lat: 50}, "/mp2": {Lat: 900}}, i, 2, 1)
+		tassert.Fatalf(t, len(flagged) == 0, "fast disks: unexpected %v", flagged)
+	}
+
+	tassert.Errorf(t, peersMedian(ios.AllMpathStats{"a": {Lat: 1}, "b": {Lat: 3}, "c": {Lat: 100}}, "c") == 2, "even")
+	tassert.Errorf(t, peersMedian(ios.AllMpathStats{"a": {Lat: 1}, "b": {Lat: 3}, "c": {Lat: 7}, "d": {}}, "d") == 3, "odd")
+}
@@ -5,6 +5,8 @@
 package fs
 
 import (
+	"fmt"
+
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/xoshiro256"
@@ -15,14 +17,29 @@ import (
 // aka highest random weight (HRW)
 // See also: core/meta/hrw.go
 
-func Hrw(uname string) (mi *Mountpath, digest uint64, err error) {
+func Hrw(uname string) (mi *Mountpath, digest uint64, err error) { return HrwLabel(uname, "") }
+
+// same as above, with placement restricted to mountpaths labeled `label` (if not empty)
+// (see also: bucket's storage class - cmn.Bprops.StorageClass)
+func HrwLabel(uname, label string) (mi *Mountpath, digest uint64, err error) {
+	return _hrw(uname, label, FlagNoWrite)
+}
+
+// same as HrwLabel but including read-only mountpaths: where the content was placed
+// prior to its mountpath becoming read-only (and where it remains until drained)
+func HrwLookup(uname, label string) (mi *Mountpath, err error) {
+	mi, _, err = _hrw(uname, label, FlagWaitingDD)
+	return mi, err
+}
+
+func _hrw(uname, label string, skip uint64) (mi *Mountpath, digest uint64, err error) {
 	var (
 		max   uint64
 		avail = GetAvail()
 	)
 	digest = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
 	for _, mpathInfo := range avail {
-		if mpathInfo.IsAnySet(FlagWaitingDD) {
+		if mpathInfo.IsAnySet(skip) || (label != "" && mpathInfo.Label != label) {
 			continue
 		}
 		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
@@ -33,6 +50,9 @@ func Hrw(uname string) (mi *Mountpath, digest uint64, err error) {
 	}
 	if mi == nil {
 		err = cmn.ErrNoMountpaths
+		if label != "" {
+			err = fmt.Errorf("%w labeled %q", err, label)
+		}
 	}
 	return
 }
@@ -239,6 +239,124 @@ func TestMountpathAddAndDisableMultiple(t *testing.T) {
 	tools.AssertMountpathCount(t, 1, 1)
 }
 
+func TestMountpathReadOnly(t *testing.T) {
+	initFS()
+
+	mp1, mp2, mp3 := "/tmp/mp1", "/tmp/mp2", "/tmp/mp3"
+	tools.AddMpath(t, mp1)
+	tools.AddMpath(t, mp2)
+
+	var cbs int
+	cb := func() { cbs++ }
+	rmi, err := fs.ReadOnlyMpath(mp1, true, cb)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, rmi != nil && rmi.IsAnySet(fs.FlagReadOnly), "expecting %q read-only", mp1)
+	tassert.Errorf(t, fs.HasReadOnly(), "expecting read-only mountpath")
+	tools.AssertMountpathCount(t, 2, 0) // (still available)
+
+	rmi, err = fs.ReadOnlyMpath(mp1, true, cb)
+	tassert.Errorf(t, err == nil && rmi == nil, "already read-only: %v, %v", rmi, err)
+
+	// the last writable one
+	_, err = fs.ReadOnlyMpath(mp2, true, cb)
+	tassert.Errorf(t, err != nil, "expecting error marking the last writable mountpath read-only")
+
+	// new content never lands on a read-only mountpath
+	// (existing content, however, is still looked up there)
+	tools.AddMpath(t, mp3)
+	var lookups int
+	for i := 0; i < 1000; i++ {
+		uname := trand.String(10)
+		mi, _, err := fs.Hrw(uname)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mi.Path != mp1, "hrw selected read-only %s", mi)
+		lmi, err := fs.HrwLookup(uname, "")
+		tassert.CheckFatal(t, err)
+		if lmi.Path == mp1 {
+			lookups++
+		} else {
+			tassert.Fatalf(t, lmi.Path == mi.Path, "hrw lookup %s vs placement %s", lmi, mi)
+		}
+	}
+	tassert.Errorf(t, lookups > 0, "expecting lookups to include read-only %q", mp1)
+
+	mpl := fs.MountpathsToLists()
+	tassert.Errorf(t, len(mpl.Available) == 3, "expecting 3 available, got %v", mpl.Available)
+	tassert.Errorf(t, len(mpl.ReadOnly) == 1 && mpl.ReadOnly[0] == mp1, "expecting read-only %q, got %v",
+		mp1, mpl.ReadOnly)
+
+	// back to read-write
+	rmi, err = fs.ReadOnlyMpath(mp1, false, cb)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, rmi != nil && !rmi.IsAnySet(fs.FlagReadOnly), "expecting %q read-write", mp1)
+	tassert.Errorf(t, !fs.HasReadOnly(), "expecting no read-only mountpaths")
+	tassert.Errorf(t, cbs == 2, "expecting 2 callbacks, got %d", cbs)
+	for i := 0; i < 100; i++ {
+		uname := trand.String(10)
+		mi, _, err := fs.Hrw(uname)
+		tassert.CheckFatal(t, err)
+		lmi, err := fs.HrwLookup(uname, "")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, lmi.Path == mi.Path, "hrw lookup %s vs placement %s", lmi, mi)
+	}
+
+	// disabled: cannot be read-only and does not remain read-only upon re-enabling
+	_, err = fs.ReadOnlyMpath(mp2, true, nil)
+	tassert.CheckFatal(t, err)
+	_, err = fs.Disable(mp2)
+	tassert.CheckFatal(t, err)
+	_, err = fs.ReadOnlyMpath(mp2, true, nil)
+	tassert.Errorf(t, err != nil, "expecting error marking disabled mountpath read-only")
+	_, err = fs.Enable(mp2)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !fs.HasReadOnly(), "re-enabled mountpath must be read-write")
+}
+
+func TestMountpathLabels(t *testing.T) {
+	initFS()
+
+	mp1, mp2, mp3 := "/tmp/mp1", "/tmp/mp2", "/tmp/mp3"
+	config := cmn.GCO.BeginUpdate()
+	config.FSP.Labels = cos.StrKVs{mp1: "nvme", mp3: "nvme"}
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.FSP.Labels = nil
+		cmn.GCO.CommitUpdate(config)
+	}()
+	tools.AddMpath(t, mp1)
+	tools.AddMpath(t, mp2)
+	tools.AddMpath(t, mp3)
+
+	avail := fs.GetAvail()
+	tassert.Errorf(t, avail[mp1].Label == "nvme" && avail[mp2].Label == "" && avail[mp3].Label == "nvme",
+		"unexpected labels: %s, %s, %s", avail[mp1], avail[mp2], avail[mp3])
+
+	var unrestricted int
+	for i := 0; i < 1000; i++ {
+		uname := trand.String(10)
+		mi, _, err := fs.HrwLabel(uname, "nvme")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mi.Label == "nvme", "hrw selected %s that is not labeled %q", mi, "nvme")
+		if mi, _, _ := fs.Hrw(uname); mi.Path == mp2 {
+			unrestricted++
+		}
+	}
+	tassert.Errorf(t, unrestricted > 0, "expecting unlabeled %q to be selected when not restricted", mp2)
+
+	_, _, err := fs.HrwLabel(trand.String(10), "hdd")
+	tassert.Errorf(t, err != nil, "expecting no mountpaths labeled %q", "hdd")
+
+	// the only one labeled "nvme" and writable
+	_, err = fs.ReadOnlyMpath(mp1, true, nil)
+	tassert.CheckFatal(t, err)
+	for i := 0; i < 100; i++ {
+		mi, _, err := fs.HrwLabel(trand.String(10), "nvme")
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mi.Path == mp3, "expecting %q, got %s", mp3, mi)
+	}
+}
+
 func TestMoveToDeleted(t *testing.T) {
 	initFS()
 
@@ -27,7 +27,10 @@ import (
 // walk all or selected buckets, one at a time
 
 const (
-	throttleNumObjects = 64 // unit of self-throttling
+	throttleNumObjects   = 64   // unit of self-throttling
+	checkpointNumObjects = 1024 // unit of checkpointing (resumable traversals only)
+
+	cpDone = "<done>" // this mountpath's traversal is complete (the other ones may be not)
 )
 
 type LoadType int
@@ -60,6 +63,19 @@ type (
 		PerBucket             bool     // num joggers = (num mountpaths) x (num buckets)
 		SkipGloballyMisplaced bool     // skip globally misplaced
 		Throttle              bool     // true: pace itself depending on disk utilization
+
+		// resumable traversal (single bucket only):
+		// - when paused, joggers checkpoint their progress and block until resumed (or aborted);
+		// - when checkpoint tag is specified, joggers walk in sorted order, skip everything up to
+		//   and including the last checkpointed name, and periodically persist their progress
+		Pauser     Pauser
+		Checkpoint string
+	}
+
+	// (implemented by xact.Base)
+	Pauser interface {
+		IsPaused() bool
+		WaitResumed() error
 	}
 
 	// Jgroup runs jogger per mountpath which walk the entire bucket and
@@ -85,6 +101,13 @@ type (
 		stopCh    cos.StopCh
 		bufs      [][]byte
 		num       int64
+		numcp     int64
+		cp        struct {
+			from string // resume from (exclusive)
+			last string // last visited (sequential) or dispatched (parallel)
+			safe string // last persisted
+		}
+		completed bool
 	}
 
 	joggerSyncGroup struct {
@@ -101,6 +124,7 @@ func NewJoggerGroup(opts *JgroupOpts, config *cmn.Config, mpath string) *Jgroup
 		wg, ctx = errgroup.WithContext(context.Background())
 	)
 	debug.Assert(!opts.IncludeCopy || (opts.IncludeCopy && opts.DoLoad > noLoad))
+	debug.Assert(opts.Checkpoint == "" || (len(opts.Buckets) == 0 && !opts.Bck.IsQuery()), "single bucket only")
 
 	jg := &Jgroup{wg: wg}
 	opts.onFinish = jg.markFinished
@@ -154,7 +178,35 @@ func (jg *Jgroup) Stop() error {
 	for _, jogger := range jg.joggers {
 		jogger.abort()
 	}
-	return jg.wg.Wait()
+	err := jg.wg.Wait()
+	if err == nil {
+		jg.finiCheckpoints()
+	}
+	return err
+}
+
+// (upon success only - the caller decides what to do with the checkpoints of a failed traversal)
+// - all joggers completed their respective traversals: nothing to resume
+// - otherwise (aborted): mark completed ones as done
+func (jg *Jgroup) finiCheckpoints() {
+	all := true
+	for _, j := range jg.joggers {
+		if j.opts.Checkpoint == "" {
+			return
+		}
+		all = all && j.completed
+	}
+	for _, j := range jg.joggers {
+		if !all {
+			if j.completed {
+				j.persistCP(cpDone)
+			}
+			continue
+		}
+		if err := j.mi.RemoveCheckpoint(j.opts.Checkpoint); err != nil {
+			nlog.Errorln(j.String(), err)
+		}
+	}
 }
 
 func (jg *Jgroup) ListenFinished() <-chan struct{} {
@@ -196,6 +248,13 @@ func newJogger(ctx context.Context, opts *JgroupOpts, mi *fs.Mountpath, config *
 		j.bdir = mi.MakePathCT(&j.opts.Bck, fs.ObjectType) // this mountpath's bucket dir that contains objects
 		j.objPrefix = filepath.Join(j.bdir, opts.Prefix)
 	}
+	if opts.Checkpoint != "" {
+		j.cp.from = mi.LoadCheckpoint(opts.Checkpoint)
+		j.cp.safe = j.cp.from
+		if j.cp.from != "" {
+			nlog.Infoln(j.String(), "resuming after", j.cp.from)
+		}
+	}
 	j.stopCh.Init()
 	return
 }
@@ -275,11 +334,15 @@ func (j *jogger) runQbck(qbck cmn.QueryBcks) (err error) {
 
 // run single (see also: `PerBucket` above)
 func (j *jogger) runBck(bck *cmn.Bck) (aborted bool, err error) {
+	if j.cp.from == cpDone {
+		j.completed = true
+		return false, nil
+	}
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		CTs:      j.opts.CTs,
 		Callback: j.jog,
-		Sorted:   false,
+		Sorted:   j.opts.Checkpoint != "", // (resumable traversal requires deterministic order)
 	}
 	opts.Bck.Copy(bck)
 
@@ -295,12 +358,16 @@ func (j *jogger) runBck(bck *cmn.Bck) (aborted bool, err error) {
 	}
 
 	if err != nil {
+		if j.syncGroup == nil {
+			j.persistCP(j.cp.last) // (in parallel mode, only drained progress gets persisted - see checkpoint())
+		}
 		if cmn.IsErrAborted(err) {
 			nlog.Infof("%s stopping traversal: %v", j, err)
 			return true, nil
 		}
 		return false, err
 	}
+	j.completed = true // (see finiCheckpoints)
 	return false, nil
 }
 
@@ -314,6 +381,19 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 			return nil
 		}
 	}
+	if j.cp.from != "" {
+		if de.IsDir() {
+			// skip entire subtrees that were fully traversed prior to checkpointing
+			if !strings.HasPrefix(j.cp.from, fqn+string(filepath.Separator)) && fs.CmpWalkOrder(fqn, j.cp.from) < 0 {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if fs.CmpWalkOrder(fqn, j.cp.from) <= 0 {
+			return nil
+		}
+		j.cp.from = "" // passed the checkpoint
+	}
 	if de.IsDir() {
 		return nil
 	}
@@ -321,12 +401,19 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 	if err := j.checkStopped(); err != nil {
 		return err
 	}
+	if j.opts.Pauser != nil && j.opts.Pauser.IsPaused() {
+		j.checkpoint()
+		if err := j.opts.Pauser.WaitResumed(); err != nil {
+			return err
+		}
+	}
 
 	var bufPosition int
 	if j.syncGroup == nil {
 		if err := j.visitFQN(fqn, j.getBuf(0)); err != nil {
 			return err
 		}
+		j.cp.last = fqn
 	} else {
 		select {
 		case bufPosition = <-j.syncGroup.sema:
@@ -342,13 +429,24 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 			}()
 			return j.visitFQN(fqn, j.getBuf(bufPosition))
 		})
+		j.cp.last = fqn
+	}
+	if j.opts.Checkpoint != "" {
+		j.numcp++
+		if j.numcp%checkpointNumObjects == 0 {
+			j.checkpoint()
+		}
 	}
 
 	if j.opts.Throttle {
 		j.num++
-		if (j.num % throttleNumObjects) == 0 {
+		switch {
+		case j.mi.HiPriActive():
+			// yield to high-priority foreground I/O (see fs.Mountpath.HiPriBegin)
+			time.Sleep(ThrottleAvgDur)
+		case (j.num % throttleNumObjects) == 0:
 			j.throttle()
-		} else {
+		default:
 			runtime.Gosched()
 		}
 	}
@@ -439,6 +537,45 @@ func (sg *joggerSyncGroup) abortAsyncTasks() error {
 	return sg.waitForAsyncTasks()
 }
 
+// record progress; in parallel mode, wait for all in-flight visits to complete
+// (so that everything up to and including the checkpointed name is done)
+func (j *jogger) checkpoint() {
+	if j.opts.Checkpoint == "" || j.cp.last == "" {
+		return
+	}
+	if j.syncGroup != nil {
+		tokens := make([]int, 0, j.opts.Parallel)
+		for len(tokens) < j.opts.Parallel {
+			select {
+			case pos := <-j.syncGroup.sema:
+				tokens = append(tokens, pos)
+			case <-j.ctx.Done():
+				for _, pos := range tokens {
+					j.syncGroup.sema <- pos
+				}
+				return
+			}
+		}
+		j.persistCP(j.cp.last)
+		for _, pos := range tokens {
+			j.syncGroup.sema <- pos
+		}
+		return
+	}
+	j.persistCP(j.cp.last)
+}
+
+func (j *jogger) persistCP(name string) {
+	if j.opts.Checkpoint == "" || name == "" || name == j.cp.safe {
+		return
+	}
+	if err := j.mi.PersistCheckpoint(j.opts.Checkpoint, name); err != nil {
+		nlog.Errorln(j.String(), "failed to checkpoint:", err)
+		return
+	}
+	j.cp.safe = name
+}
+
 func (j *jogger) throttle() {
 	curUtil := fs.GetMpathUtil(j.mi.Path)
 	if curUtil >= j.config.Disk.DiskUtilHighWM {
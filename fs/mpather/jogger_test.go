@@ -6,10 +6,12 @@ package mpather_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -309,3 +311,173 @@ func TestJoggerGroupMultiContentTypes(t *testing.T) {
 	err := jg.Stop()
 	tassert.CheckFatal(t, err)
 }
+
+// Interrupt traversal midway and make sure the next run (with the same checkpoint tag)
+// visits the remaining objects - and only those.
+func TestJoggerGroupCheckpoint(t *testing.T) {
+	var (
+		totalObjCnt = 1000
+		stopAt      = int32(totalObjCnt / 3)
+		desc        = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: totalObjCnt},
+			},
+			MountpathsCnt: 4,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		mu      sync.Mutex
+		visited = make(map[string]int, totalObjCnt)
+		counter = atomic.NewInt32(0)
+	)
+	defer os.RemoveAll(out.Dir)
+
+	visit := func(lom *core.LOM, _ []byte) error {
+		mu.Lock()
+		visited[lom.FQN]++
+		mu.Unlock()
+		return nil
+	}
+	opts := &mpather.JgroupOpts{
+		Bck:        out.Bck,
+		CTs:        []string{fs.ObjectType},
+		Checkpoint: "test-checkpoint",
+		VisitObj: func(lom *core.LOM, buf []byte) error {
+			if counter.Inc() > stopAt {
+				return cmn.NewErrAborted("test", "interrupted", nil)
+			}
+			return visit(lom, buf)
+		},
+	}
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	jg.Stop()
+
+	tassert.Fatalf(t, len(visited) == int(stopAt), "expected %d visited objects, got %d", stopAt, len(visited))
+
+	// resume
+	opts.VisitObj = visit
+	jg = mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+
+	tassert.Fatalf(t, len(visited) == totalObjCnt, "expected %d visited objects, got %d", totalObjCnt, len(visited))
+	for fqn, cnt := range visited {
+		tassert.Errorf(t, cnt == 1, "%q visited %d times", fqn, cnt)
+	}
+
+	// completed traversal removes its checkpoints
+	for _, mi := range fs.GetAvail() {
+		cp := mi.LoadCheckpoint(opts.Checkpoint)
+		tassert.Errorf(t, cp == "", "%s: unexpected checkpoint %q", mi, cp)
+	}
+}
+
+type testPauser struct {
+	ch     chan struct{}
+	paused atomic.Bool
+}
+
+func (p *testPauser) IsPaused() bool     { return p.paused.Load() }
+func (p *testPauser) WaitResumed() error { <-p.ch; return nil }
+
+func TestJoggerGroupPause(t *testing.T) {
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: 500},
+			},
+			MountpathsCnt: 3,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		counter = atomic.NewInt32(0)
+		pauser  = &testPauser{ch: make(chan struct{})}
+	)
+	defer os.RemoveAll(out.Dir)
+
+	opts := &mpather.JgroupOpts{
+		Bck:    out.Bck,
+		CTs:    []string{fs.ObjectType},
+		Pauser: pauser,
+		VisitObj: func(*core.LOM, []byte) error {
+			if counter.Inc() == 100 {
+				pauser.paused.Store(true)
+			}
+			return nil
+		},
+	}
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+
+	time.Sleep(100 * time.Millisecond)
+	cnt := counter.Load()
+	time.Sleep(100 * time.Millisecond)
+	tassert.Fatalf(t, cnt == counter.Load(), "expected no progress while paused (%d vs %d)", cnt, counter.Load())
+	tassert.Errorf(t, int(cnt) < len(out.FQNs[fs.ObjectType]), "expected to pause midway")
+
+	pauser.paused.Store(false)
+	close(pauser.ch)
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+	tassert.Errorf(t, int(counter.Load()) == len(out.FQNs[fs.ObjectType]),
+		"invalid number of objects visited (%d vs %d)", counter.Load(), len(out.FQNs[fs.ObjectType]))
+}
+
+// Traversal that fails (as opposed to being aborted) must not be marked done on any
+// of the mountpaths - the next run redoes the remaining work from the last checkpoints.
+func TestJoggerGroupCheckpointError(t *testing.T) {
+	var (
+		totalObjCnt = 600
+		failAt      = int32(totalObjCnt / 2)
+		desc        = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: totalObjCnt},
+			},
+			MountpathsCnt: 3,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		mu      sync.Mutex
+		visited = make(map[string]struct{}, totalObjCnt)
+		counter = atomic.NewInt32(0)
+	)
+	defer os.RemoveAll(out.Dir)
+
+	visit := func(lom *core.LOM, _ []byte) error {
+		mu.Lock()
+		visited[lom.FQN] = struct{}{}
+		mu.Unlock()
+		return nil
+	}
+	opts := &mpather.JgroupOpts{
+		Bck:        out.Bck,
+		CTs:        []string{fs.ObjectType},
+		Checkpoint: "test-checkpoint-err",
+		VisitObj: func(lom *core.LOM, buf []byte) error {
+			if counter.Inc() > failAt {
+				return errors.New("test failure")
+			}
+			return visit(lom, buf)
+		},
+	}
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.Fatalf(t, jg.Stop() != nil, "expected traversal to fail")
+
+	for _, mi := range fs.GetAvail() {
+		cp := mi.LoadCheckpoint(opts.Checkpoint)
+		tassert.Errorf(t, cp != "<done>", "%s: failed traversal must not be checkpointed as done", mi)
+	}
+
+	// rerun
+	opts.VisitObj = visit
+	jg = mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+	tassert.Fatalf(t, len(visited) == totalObjCnt, "expected %d visited objects, got %d", totalObjCnt, len(visited))
+}
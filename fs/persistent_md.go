@@ -25,6 +25,7 @@ const numMarkers = 1
 // List of AIS metadata files and directories (basenames only)
 var mdFilesDirs = []string{
 	fname.MarkersDir,
+	fname.CheckpointsDir,
 
 	fname.Bmd,
 	fname.BmdPrevious,
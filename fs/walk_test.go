@@ -193,3 +193,24 @@ func TestWalkBckSkipDir(t *testing.T) {
 	}
 	tassert.Fatalf(t, expectedTotal == len(fqns), "expected %d objects, got %d", expectedTotal, len(fqns))
 }
+
+func TestCmpWalkOrder(t *testing.T) {
+	tests := []struct {
+		a, b string
+		exp  int
+	}{
+		{"a/b", "a/b", 0},
+		{"a/b", "a/c", -1},
+		{"a/b", "a-c", -1}, // unlike strings.Compare
+		{"a-c", "a/b", 1},
+		{"a", "a/b", -1},
+		{"a/b/c", "a/b", 1},
+		{"a/z", "b/a", -1},
+		{"x/aa/1", "x/a/2", 1},
+	}
+	for _, test := range tests {
+		if res := fs.CmpWalkOrder(test.a, test.b); res != test.exp {
+			t.Errorf("CmpWalkOrder(%q, %q) = %d, expected %d", test.a, test.b, res, test.exp)
+		}
+	}
+}
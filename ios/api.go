@@ -8,4 +8,10 @@ package ios
 type (
 	DiskStats    struct{ RBps, Ravg, WBps, Wavg, Util int64 }
 	AllDiskStats map[string]DiskStats
+
+	// per mountpath: average utilization (%) and average IO latency (microseconds)
+	// of the underlying disks, over the most recent iostat interval;
+	// zero latency means no completed IOs
+	MpathStats    struct{ Util, Lat int64 }
+	AllMpathStats map[string]MpathStats
 )
@@ -36,3 +36,9 @@ func GetATime(osfi os.FileInfo) time.Time {
 	// NOTE: see https://en.wikipedia.org/wiki/Stat_(system_call)#Criticism_of_atime
 	return atime
 }
+
+// (changes upon writing data and updating metadata, including xattrs)
+func GetCTime(osfi os.FileInfo) time.Time {
+	stat := osfi.Sys().(*syscall.Stat_t)
+	return time.Unix(stat.Ctimespec.Sec, stat.Ctimespec.Nsec)
+}
@@ -30,6 +30,7 @@ type (
 		AddMpath(mpath string, fs string, testingEnv bool) (FsDisks, error)
 		RemoveMpath(mpath string, testingEnv bool)
 		FillDiskStats(m AllDiskStats)
+		FillMpathStats(m AllMpathStats)
 	}
 	FsDisks   map[string]int64 // disk name => sector size
 	MpathUtil sync.Map
@@ -50,9 +51,11 @@ type (
 		writes map[string]int64 // completed write requests
 		wbps   map[string]int64 // write B/s
 		wavg   map[string]int64 // average write size
+		lat    map[string]int64 // average IO latency (microseconds)
 
 		mpathUtil   map[string]int64 // Average utilization of the disks, range [0, 100].
 		mpathUtilRO MpathUtil        // Read-only copy of `mpathUtil`.
+		mpathLat    map[string]int64 // Average IO latency of the (busy) disks.
 
 		expireTime int64
 		timestamp  int64
@@ -135,7 +138,9 @@ func newCache(num int) *cache {
 		writes:    make(map[string]int64, num),
 		wbps:      make(map[string]int64, num),
 		wavg:      make(map[string]int64, num),
+		lat:       make(map[string]int64, num),
 		mpathUtil: make(map[string]int64, num),
+		mpathLat:  make(map[string]int64, num),
 	}
 }
 
@@ -294,6 +299,18 @@ func (ios *ios) FillDiskStats(m AllDiskStats) {
 	}
 }
 
+func (ios *ios) FillMpathStats(m AllMpathStats) {
+	cache := ios.refresh()
+	for mpath, util := range cache.mpathUtil {
+		m[mpath] = MpathStats{Util: util, Lat: cache.mpathLat[mpath]}
+	}
+	for mpath := range m {
+		if _, ok := cache.mpathUtil[mpath]; !ok {
+			delete(m, mpath)
+		}
+	}
+}
+
 // update iostat cache
 func (ios *ios) refresh() *cache {
 	var (
@@ -353,6 +370,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 	ncache.timestamp = nowTs
 	for mpath := range ios.mpath2disks {
 		ncache.mpathUtil[mpath] = 0
+		ncache.mpathLat[mpath] = 0
 	}
 	for disk := range ncache.ioms {
 		if _, ok := ios.disk2mpath[disk]; !ok {
@@ -368,6 +386,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.util[disk] = 0
 		ncache.ravg[disk] = 0
 		ncache.wavg[disk] = 0
+		ncache.lat[disk] = 0
 		ds := ios.blockStats[disk]
 		ncache.ioms[disk] = ds.IOMs()
 		ncache.rms[disk] = ds.ReadMs()
@@ -384,6 +403,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		// deltas
 		var (
 			ioMs       = ncache.ioms[disk] - statsCache.ioms[disk]
+			rwMs       = ncache.rms[disk] - statsCache.rms[disk] + ncache.wms[disk] - statsCache.wms[disk]
 			reads      = ncache.reads[disk] - statsCache.reads[disk]
 			writes     = ncache.writes[disk] - statsCache.writes[disk]
 			readBytes  = ncache.rbytes[disk] - statsCache.rbytes[disk]
@@ -424,6 +444,11 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		} else {
 			ncache.wavg[disk] = 0
 		}
+		if n := reads + writes; n > 0 {
+			ncache.lat[disk] = cos.DivRound(rwMs*1000, n)
+		} else if elapsedSeconds == 0 {
+			ncache.lat[disk] = statsCache.lat[disk]
+		}
 	}
 
 	// average and max
@@ -434,6 +459,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 			for d := range disks {
 				u = ncache.util[d]
 				ncache.mpathUtil[mpath] = u
+				ncache.mpathLat[mpath] = ncache.lat[d]
 				break
 			}
 			ncache.mpathUtilRO.Set(mpath, u)
@@ -452,6 +478,17 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.mpathUtil[mpath] = u
 		ncache.mpathUtilRO.Set(mpath, u)
 		maxUtil = max(maxUtil, u)
+
+		var lat, busy int64
+		for d := range disks {
+			if l := ncache.lat[d]; l > 0 {
+				lat += l
+				busy++
+			}
+		}
+		if busy > 0 {
+			ncache.mpathLat[mpath] = cos.DivRound(lat, busy)
+		}
 	}
 	return
 }
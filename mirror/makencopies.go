@@ -6,6 +6,7 @@ package mirror
 
 import (
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -84,6 +85,7 @@ func newMNC(p *mncFactory, slab *memsys.Slab) (r *mncXact) {
 		Throttle: true,
 	}
 	mpopts.Bck.Copy(p.Bck.Bucket())
+	mpopts.Checkpoint = xact.CheckpointTag(apc.ActMakeNCopies, p.Bck.MakeUname(""), strconv.Itoa(p.args.Copies))
 	r.BckJog.Init(p.UUID(), apc.ActMakeNCopies, p.Bck, mpopts, cmn.GCO.Get())
 
 	// name
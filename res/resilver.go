@@ -44,6 +44,7 @@ type (
 		PostDD            func(rmi *fs.Mountpath, action string, xres *xs.Resilver, err error)
 		SkipGlobMisplaced bool
 		SingleRmiJogger   bool
+		Throttle          bool // pace joggers depending on disk utilization (see mpather.JgroupOpts)
 	}
 	joggerCtx struct {
 		xres   *xs.Resilver
@@ -108,6 +109,7 @@ func (res *Res) RunResilver(args Args) {
 			VisitCT:               jctx.visitCT,
 			Slab:                  slab,
 			SkipGloballyMisplaced: args.SkipGlobMisplaced,
+			Throttle:              args.Throttle,
 		}
 	)
 	debug.AssertNoErr(err)
@@ -164,7 +166,7 @@ func wait(jg *mpather.Jgroup, xres *xs.Resilver) (err error) {
 // destination files(on copy failure)
 func (jg *joggerCtx) _mvSlice(ct *core.CT, buf []byte) {
 	uname := ct.Bck().MakeUname(ct.ObjectName())
-	destMpath, _, err := fs.Hrw(uname)
+	destMpath, _, err := core.HrwMpath(ct.Bucket(), uname)
 	if err != nil {
 		jg.xres.AddErr(err)
 		nlog.Infoln("Warning:", err)
@@ -253,7 +255,7 @@ func (jg *joggerCtx) visitObj(lom *core.LOM, buf []byte) (errHrw error) {
 
 	// 1. fix EC metafile
 	var metaOldPath, metaNewPath string
-	if !lom.IsHRW() && lom.Bprops().EC.Enabled {
+	if lom.FQN != lom.HrwFQN && lom.Bprops().EC.Enabled {
 		// copy metafile
 		newMpath, _, errEc := core.ResolveFQN(lom.HrwFQN)
 		if errEc != nil {
@@ -346,6 +348,11 @@ redo:
 		}
 		break
 	}
+
+	// 4. draining read-only mountpath: remove the (now relocated) source
+	if copied && lom != orig && orig.Mountpath().IsAnySet(fs.FlagReadOnly) {
+		jg.drain(lom, orig)
+	}
 ret:
 	// EC: remove old metafile
 	if metaOldPath != "" {
@@ -356,6 +363,20 @@ ret:
 	return nil
 }
 
+func (jg *joggerCtx) drain(lom, orig *core.LOM) {
+	var err error
+	if _, ok := lom.GetCopies()[orig.FQN]; ok {
+		if err = lom.DelCopies(orig.FQN); err == nil {
+			err = lom.Persist()
+		}
+	} else {
+		err = cos.RemoveFile(orig.FQN)
+	}
+	if err != nil {
+		nlog.Warningf("%s: failed to drain %s from %s: %v", jg.xres.Name(), orig, orig.Mountpath(), err)
+	}
+}
+
 func (*joggerCtx) fixHrw(lom *core.LOM, mi *fs.Mountpath, buf []byte) (hlom *core.LOM, err error) {
 	if err = lom.Copy(mi, buf); err != nil {
 		return
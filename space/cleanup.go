@@ -348,7 +348,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.BlobDlType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -370,6 +370,11 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 		if ok && old {
 			j.oldWork = append(j.oldWork, fqn)
 		}
+	case fs.BlobDlType:
+		// resumable blob downloads: remove abandoned
+		if j.blobDlExpired(fqn) {
+			j.oldWork = append(j.oldWork, fqn)
+		}
 	case fs.ECSliceType:
 		// EC slices:
 		// - EC enabled: remove only slices with missing metafiles
@@ -416,6 +421,21 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 	}
 }
 
+// workfile and checkpoint of a given blob download expire together -
+// when neither has been updated for fs.BlobDlExpiry
+func (j *clnJ) blobDlExpired(fqn string) bool {
+	dir, base := filepath.Split(fqn)
+	orig, _, ok := fs.CSM.Resolver(fs.BlobDlType).ParseUniqueFQN(base)
+	if !ok {
+		return true // (unrecognized)
+	}
+	expired := func(fqn string) bool {
+		finfo, err := os.Stat(fqn)
+		return err != nil || finfo.ModTime().UnixNano()+fs.BlobDlExpiry.Nanoseconds() < j.now
+	}
+	return expired(filepath.Join(dir, fs.WorkfileBlobDl+"."+orig)) && expired(filepath.Join(dir, fs.WorkfileBlobCkpt+"."+orig))
+}
+
 // TODO: add stats error counters (stats.ErrLmetaCorruptedCount, ...)
 // TODO: revisit rm-ed byte counting
 func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
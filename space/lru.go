@@ -9,6 +9,8 @@ package space
 import (
 	"container/heap"
 	"fmt"
+	"math"
+	"os"
 	"sort"
 	"sync"
 	"time"
@@ -32,7 +34,12 @@ import (
 // config.Space.HighWM (section "space" in the cluster config).
 //
 // When and if exceeded, AIS target will start gradually evicting objects from its
-// stable storage: oldest first access-time wise.
+// stable storage in the order defined by the bucket's eviction policy (lru.policy):
+//   - "atime" (default) - oldest first access-time wise;
+//   - "size" - largest and least recently accessed first (size weighted by time since last access);
+//   - "lfu"  - least frequently accessed first (see core.LOM.AccessCnt);
+//   - "ttl"  - only the objects stored (cold-GET or PUT) more than lru.ttl ago, oldest first.
+// Objects with names matching lru.pinned (names or prefixes) are never evicted.
 //
 // LRU is implemented as eXtended Action (xaction, see xact/README.md) that gets
 // triggered when/if a used local capacity exceeds high watermark (config.Space.HighWM). LRU then
@@ -67,8 +74,15 @@ type (
 
 // private
 type (
-	// minHeap keeps fileInfo sorted by access time with oldest on top of the heap.
-	minHeap []*core.LOM
+	// eviction candidate: (primary, secondary) sorting keys are determined by the policy -
+	// the smaller the key the sooner the object gets evicted
+	lruCand struct {
+		lom *core.LOM
+		k1  int64
+		k2  int64
+	}
+	// minHeap keeps candidates sorted by eviction order (see above)
+	minHeap []lruCand
 
 	// parent (contains mpath joggers)
 	lruP struct {
@@ -83,9 +97,10 @@ type (
 		// runtime
 		curSize   int64
 		totalSize int64 // difference between lowWM size and used size
-		newest    int64
+		newest    int64 // max primary key in the heap
 		heap      *minHeap
 		bck       cmn.Bck
+		lru       cmn.LRUConf // the bucket's
 		now       int64
 		// init-time
 		p       *lruP
@@ -287,6 +302,7 @@ func (j *lruJ) jogBck() (size int64, err error) {
 	h := (*j.heap)[:0]
 	j.heap = &h
 	heap.Init(j.heap)
+	j.curSize, j.newest = 0, math.MinInt64
 
 	// 2. collect
 	opts := &fs.WalkOpts{
@@ -328,19 +344,54 @@ func (j *lruJ) _visit(lom *core.LOM) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	if j.lru.IsPinned(lom.ObjName) {
+		return
+	}
+	cand := lruCand{lom: lom}
+	switch j.lru.Policy {
+	case cmn.LRUPolicySize:
+		cand.k1, cand.k2 = -j.sizeAge(lom), lom.AtimeUnix()
+	case cmn.LRUPolicyLFU:
+		cand.k1, cand.k2 = int64(lom.AccessCnt()), lom.AtimeUnix()
+	case cmn.LRUPolicyTTL:
+		// time since stored (cold GET or PUT) - object's mtime
+		finfo, err := os.Stat(lom.FQN)
+		if err != nil {
+			return
+		}
+		mtime := finfo.ModTime().UnixNano()
+		if mtime+int64(j.lru.TTL) > j.now {
+			return
+		}
+		cand.k1 = mtime
+	default:
+		cand.k1 = lom.AtimeUnix()
+	}
 	// do nothing if the heap's curSize >= totalSize and
-	// the file is more recent then the the heap's newest.
-	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
+	// the candidate is less evictable than any of those already in the heap
+	if j.curSize >= j.totalSize && cand.k1 > j.newest {
 		return
 	}
-	heap.Push(j.heap, lom)
+	heap.Push(j.heap, cand)
 	j.curSize += lom.SizeBytes()
-	if lom.AtimeUnix() > j.newest {
-		j.newest = lom.AtimeUnix()
+	if cand.k1 > j.newest {
+		j.newest = cand.k1
 	}
 	return true
 }
 
+// size (KiB) times time since last access (seconds); saturates at MaxInt64
+func (j *lruJ) sizeAge(lom *core.LOM) int64 {
+	var (
+		kib = lom.SizeBytes()>>10 + 1
+		age = max(j.now-lom.AtimeUnix(), 0)/int64(time.Second) + 1
+	)
+	if kib > math.MaxInt64/age {
+		return math.MaxInt64
+	}
+	return kib * age
+}
+
 func (j *lruJ) walk(fqn string, de fs.DirEntry) error {
 	if de.IsDir() {
 		return nil
@@ -369,7 +420,7 @@ func (j *lruJ) evict() (size int64, err error) {
 
 	// evict(sic!) and house-keep
 	for h.Len() > 0 && j.totalSize > 0 {
-		lom := heap.Pop(h).(*core.LOM)
+		lom := heap.Pop(h).(lruCand).lom
 		if !j.evictObj(lom) {
 			core.FreeLOM(lom)
 			continue
@@ -498,6 +549,7 @@ func (j *lruJ) sortBsize(bcks []cmn.Bck) {
 	}
 }
 
+// (also, (re)start with a new bucket)
 func (j *lruJ) allow() (ok bool, err error) {
 	var (
 		bowner = core.T.Bowner()
@@ -506,7 +558,8 @@ func (j *lruJ) allow() (ok bool, err error) {
 	if err = b.Init(bowner); err != nil {
 		return
 	}
-	ok = b.Props.LRU.Enabled && b.Allow(apc.AceObjDELETE) == nil
+	j.lru = b.Props.LRU
+	ok = j.lru.Enabled && b.Allow(apc.AceObjDELETE) == nil
 	return
 }
 
@@ -514,10 +567,17 @@ func (j *lruJ) allow() (ok bool, err error) {
 // min-heap //
 //////////////
 
-func (h minHeap) Len() int           { return len(h) }
-func (h minHeap) Less(i, j int) bool { return h[i].Atime().Before(h[j].Atime()) }
-func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-func (h *minHeap) Push(x any)        { *h = append(*h, x.(*core.LOM)) }
+func (h minHeap) Len() int { return len(h) }
+
+func (h minHeap) Less(i, j int) bool {
+	if h[i].k1 != h[j].k1 {
+		return h[i].k1 < h[j].k1
+	}
+	return h[i].k2 < h[j].k2
+}
+
+func (h minHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)   { *h = append(*h, x.(lruCand)) }
 func (h *minHeap) Pop() any {
 	old := *h
 	n := len(old)
@@ -140,13 +140,7 @@ var _ = Describe("space evict/cleanup tests", func() {
 					Skip("skipping in short mode")
 				}
 
-				ini.GetFSStats = func(string) (blocks, bavail uint64, bsize int64, err error) {
-					bsize = blockSize
-					btaken := uint64(totalSize / blockSize)
-					blocks = uint64(float64(btaken) / initialDiskUsagePct)
-					bavail = blocks - btaken
-					return
-				}
+				ini.GetFSStats = getMockGetFSStatsSize(totalSize)
 
 				// files sum up to 32Mb
 				files := []fileMetadata{
@@ -171,6 +165,121 @@ var _ = Describe("space evict/cleanup tests", func() {
 				}
 			})
 
+			It("should evict the largest files first [size policy]", func() {
+				const totalSize = 32 * cos.MiB
+				core.T = newTargetLRUMock(cmn.LRUConf{Enabled: true, Policy: cmn.LRUPolicySize})
+				ini.GetFSStats = getMockGetFSStatsSize(totalSize)
+
+				files := []fileMetadata{
+					{getRandomFileName(0), int64(4 * cos.MiB)},
+					{getRandomFileName(1), int64(16 * cos.MiB)},
+					{getRandomFileName(2), int64(4 * cos.MiB)},
+					{getRandomFileName(3), int64(8 * cos.MiB)},
+				}
+				saveRandomFilesWithMetadata(filesPath, files)
+
+				// evicting the 16MB file suffices to go under lwm
+				space.RunLRU(ini)
+
+				filesLeft, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(filesLeft)).To(Equal(3))
+				for _, name := range filesLeft {
+					Expect(name.Name()).NotTo(Equal(files[1].name))
+				}
+			})
+
+			It("should weigh size by time since last access [size policy]", func() {
+				const totalSize = 32 * cos.MiB
+				core.T = newTargetLRUMock(cmn.LRUConf{Enabled: true, Policy: cmn.LRUPolicySize})
+				ini.GetFSStats = getMockGetFSStatsSize(totalSize)
+
+				files := []fileMetadata{
+					{getRandomFileName(0), int64(4 * cos.MiB)},
+					{getRandomFileName(1), int64(16 * cos.MiB)},
+					{getRandomFileName(2), int64(4 * cos.MiB)},
+					{getRandomFileName(3), int64(8 * cos.MiB)},
+				}
+				saveRandomFilesWithMetadata(filesPath, files)
+				// smaller but not accessed for an hour
+				setAtime(path.Join(filesPath, files[3].name), time.Now().Add(-time.Hour))
+
+				space.RunLRU(ini)
+
+				Expect(path.Join(filesPath, files[3].name)).NotTo(BeAnExistingFile())
+				Expect(path.Join(filesPath, files[0].name)).To(BeARegularFile())
+				Expect(path.Join(filesPath, files[2].name)).To(BeARegularFile())
+			})
+
+			It("should evict least frequently accessed files first [lfu policy]", func() {
+				const numberOfFiles = 4
+				core.T = newTargetLRUMock(cmn.LRUConf{Enabled: true, Policy: cmn.LRUPolicyLFU})
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+
+				// the oldest two are also the most frequently accessed
+				names := make([]string, numberOfFiles)
+				for i := range names {
+					names[i] = getRandomFileName(i)
+					saveRandomFileAccessed(path.Join(filesPath, names[i]), fileSize, numberOfFiles-i)
+				}
+
+				space.RunLRU(ini)
+
+				filesLeft, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(filesLeft)).To(Equal(2))
+				for _, name := range filesLeft {
+					Expect(cos.StringInSlice(name.Name(), names[:2])).To(BeTrue())
+				}
+			})
+
+			It("should evict only expired files [ttl policy]", func() {
+				const numberOfFiles = 4
+				core.T = newTargetLRUMock(cmn.LRUConf{Enabled: true, Policy: cmn.LRUPolicyTTL, TTL: cos.Duration(time.Hour)})
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+
+				names := make([]string, numberOfFiles)
+				for i := range names {
+					names[i] = getRandomFileName(i)
+					saveRandomFile(path.Join(filesPath, names[i]), fileSize)
+				}
+				// only the one stored 2h ago is expired (even though it's been accessed since);
+				// the one that's not been accessed for 2h but stored just now is not
+				setMtime(path.Join(filesPath, names[3]), time.Now().Add(-2*time.Hour))
+				setAtime(path.Join(filesPath, names[2]), time.Now().Add(-2*time.Hour))
+
+				space.RunLRU(ini)
+
+				filesLeft, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(filesLeft)).To(Equal(3))
+				for _, name := range filesLeft {
+					Expect(name.Name()).NotTo(Equal(names[3]))
+				}
+			})
+
+			It("should not evict pinned files", func() {
+				const numberOfFiles = 6
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+
+				oldFiles := []fileMetadata{
+					{"pinned/" + getRandomFileName(3), fileSize},
+					{getRandomFileName(4), fileSize},
+					{getRandomFileName(5), fileSize},
+				}
+				core.T = newTargetLRUMock(cmn.LRUConf{Enabled: true, Pinned: []string{"pinned/"}})
+				saveRandomFilesWithMetadata(filesPath, oldFiles)
+				time.Sleep(1 * time.Second)
+				saveRandomFiles(filesPath, 3)
+
+				space.RunLRU(ini)
+
+				Expect(path.Join(filesPath, oldFiles[0].name)).To(BeARegularFile())
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(3)) // including "pinned/" directory
+			})
+
 			It("should evict only files from requested bucket [ignores LRU prop]", func() {
 				if testing.Short() {
 					Skip("skipping in short mode")
@@ -282,6 +391,38 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(files)).To(Equal(0))
 			})
+
+			It("should remove only abandoned blob downloads", func() {
+				var (
+					bck     = cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					dir     = fs.GetAvail()[basePath].MakePathCT(&bck, fs.BlobDlType)
+					expired = time.Now().Add(-fs.BlobDlExpiry - time.Hour)
+				)
+				cos.CreateDir(dir)
+				// name that looks like a (unique) workfile - must not be mistaken for one
+				for _, name := range []string{"active.1.ab", "abandoned", "resumed"} {
+					for _, prefix := range []string{fs.WorkfileBlobDl, fs.WorkfileBlobCkpt} {
+						saveRandomFile(path.Join(dir, prefix+"."+name), 1024)
+					}
+				}
+				for _, prefix := range []string{fs.WorkfileBlobDl, fs.WorkfileBlobCkpt} {
+					Expect(os.Chtimes(path.Join(dir, prefix+".abandoned"), expired, expired)).NotTo(HaveOccurred())
+				}
+				// (old checkpoint, recently updated workfile)
+				ckpt := path.Join(dir, fs.WorkfileBlobCkpt+".resumed")
+				Expect(os.Chtimes(ckpt, expired, expired)).NotTo(HaveOccurred())
+
+				space.RunCleanup(ini)
+
+				files, err := os.ReadDir(dir)
+				Expect(err).NotTo(HaveOccurred())
+				names := make([]string, 0, len(files))
+				for _, f := range files {
+					names = append(names, f.Name())
+				}
+				Expect(names).To(ConsistOf(fs.WorkfileBlobDl+".active.1.ab", fs.WorkfileBlobCkpt+".active.1.ab",
+					fs.WorkfileBlobDl+".resumed", fs.WorkfileBlobCkpt+".resumed"))
+			})
 		})
 	})
 })
@@ -313,7 +454,21 @@ func getMockGetFSStats(currentFilesNum int) func(string) (uint64, uint64, int64,
 	}
 }
 
-func newTargetLRUMock() *mock.TargetMock {
+func getMockGetFSStatsSize(totalSize int64) func(string) (uint64, uint64, int64, error) {
+	return func(string) (blocks, bavail uint64, bsize int64, err error) {
+		bsize = blockSize
+		btaken := uint64(totalSize / blockSize)
+		blocks = uint64(float64(btaken) / initialDiskUsagePct)
+		bavail = blocks - btaken
+		return
+	}
+}
+
+func newTargetLRUMock(lru ...cmn.LRUConf) *mock.TargetMock {
+	lruConf := cmn.LRUConf{Enabled: true}
+	if len(lru) > 0 {
+		lruConf = lru[0]
+	}
 	// Bucket owner mock, required for LOM
 	var (
 		bmdMock = mock.NewBaseBownerMock(
@@ -321,7 +476,7 @@ func newTargetLRUMock() *mock.TargetMock {
 				bucketName, apc.AIS, cmn.NsGlobal,
 				&cmn.Bprops{
 					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
-					LRU:    cmn.LRUConf{Enabled: true},
+					LRU:    lruConf,
 					Access: apc.AccessAll,
 					BID:    0xa7b8c1d2,
 				},
@@ -380,6 +535,7 @@ func createAndAddMountpath(path string) {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.BlobDlType, &fs.BlobDlContentResolver{}, true)
 }
 
 func getRandomFileName(fileCounter int) string {
@@ -387,6 +543,10 @@ func getRandomFileName(fileCounter int) string {
 }
 
 func saveRandomFile(filename string, size int64) {
+	saveRandomFileAccessed(filename, size, 0)
+}
+
+func saveRandomFileAccessed(filename string, size int64, accessCnt int) {
 	buff := make([]byte, size)
 	_, err := cos.SaveReader(filename, rand.Reader, buff, cos.ChecksumNone, size)
 	Expect(err).NotTo(HaveOccurred())
@@ -396,9 +556,28 @@ func saveRandomFile(filename string, size int64) {
 	lom.SetSize(size)
 	lom.IncVersion()
 	lom.SetAtimeUnix(time.Now().UnixNano())
+	for i := 0; i < accessCnt; i++ {
+		lom.IncAccessCnt()
+	}
 	Expect(lom.Persist()).NotTo(HaveOccurred())
 }
 
+func setAtime(fqn string, atime time.Time) {
+	finfo, err := os.Stat(fqn)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(os.Chtimes(fqn, atime, finfo.ModTime())).NotTo(HaveOccurred())
+	lom := &core.LOM{}
+	Expect(lom.InitFQN(fqn, nil)).NotTo(HaveOccurred())
+	lom.Uncache()
+}
+
+func setMtime(fqn string, mtime time.Time) {
+	Expect(os.Chtimes(fqn, time.Now(), mtime)).NotTo(HaveOccurred())
+	lom := &core.LOM{}
+	Expect(lom.InitFQN(fqn, nil)).NotTo(HaveOccurred())
+	lom.Uncache()
+}
+
 func saveRandomFilesWithMetadata(filesPath string, files []fileMetadata) {
 	for _, file := range files {
 		saveRandomFile(path.Join(filesPath, file.name), file.size)
@@ -73,6 +73,8 @@ type (
 		K8sPodName     string         `json:"k8s_pod_name"` // (via ais-k8s/operator `MY_POD` env var)
 		MemCPUInfo     apc.MemCPUInfo `json:"sys_info"`
 		SmapVersion    int64          `json:"smap_version,string"`
+		// primary only: keepalive suspicion levels (phi) of the other nodes (see cmn.KeepalivePhiAccrual)
+		Suspicion map[string]float64 `json:"suspicion,omitempty"`
 	}
 )
 
@@ -42,6 +42,9 @@ const (
 	GetColdCount = "get.cold.n"
 	GetColdSize  = "get.cold.size"
 
+	// GETs served from in-progress cold GETs of the same objects (see ais/tgtcoalesce.go)
+	GetColdCoalescedCount = "get.cold.coalesced.n"
+
 	LruEvictCount = "lru.evict.n"
 	LruEvictSize  = "lru.evict.size"
 
@@ -58,10 +61,11 @@ const (
 	StreamsInObjSize   = transport.InObjSize
 
 	// errors
-	ErrCksumCount    = "err.cksum.n"
-	ErrCksumSize     = "err.cksum.size"
-	ErrMetadataCount = "err.md.n"
-	ErrIOCount       = "err.io.n"
+	ErrCksumCount     = "err.cksum.n"
+	ErrCksumSize      = "err.cksum.size"
+	ErrMetadataCount  = "err.md.n"
+	ErrIOCount        = "err.io.n"
+	ErrSlowMpathCount = "err.slow.mpath.n" // flagged by FSHC slow-disk detection
 
 	// target restarted (effectively, boolean)
 	RestartCount = "restart.n"
@@ -99,9 +103,10 @@ const (
 	// core
 	RemoteDeletedDelCount = core.RemoteDeletedDelCount // compare w/ common `DeleteCount`
 
-	LcacheCollisionCount = core.LcacheCollisionCount
-	LcacheEvictedCount   = core.LcacheEvictedCount
-	LcacheFlushColdCount = core.LcacheFlushColdCount
+	LcacheCollisionCount  = core.LcacheCollisionCount
+	LcacheEvictedCount    = core.LcacheEvictedCount
+	LcacheFlushColdCount  = core.LcacheFlushColdCount
+	LcacheSnapLoadedCount = core.LcacheSnapLoadedCount
 
 	// variable label used for prometheus disk metrics
 	diskMetricLabel = "disk"
@@ -216,6 +221,7 @@ func extractPromDiskMetricName(name string) (diskName, metricName string) {
 func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, GetColdCount, KindCounter)
 	r.reg(node, GetColdSize, KindSize)
+	r.reg(node, GetColdCoalescedCount, KindCounter)
 
 	r.reg(node, LruEvictCount, KindCounter)
 	r.reg(node, LruEvictSize, KindSize)
@@ -245,6 +251,7 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 
 	r.reg(node, ErrMetadataCount, KindCounter)
 	r.reg(node, ErrIOCount, KindCounter)
+	r.reg(node, ErrSlowMpathCount, KindCounter)
 
 	// streams
 	r.reg(node, StreamsOutObjCount, KindCounter)
@@ -272,6 +279,7 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, LcacheCollisionCount, KindCounter)
 	r.reg(node, LcacheEvictedCount, KindCounter)
 	r.reg(node, LcacheFlushColdCount, KindCounter)
+	r.reg(node, LcacheSnapLoadedCount, KindCounter)
 
 	// Prometheus
 	r.core.initProm(node)
@@ -220,6 +220,12 @@ func vmdInitMPI(tid string, config *cmn.Config, vmd *VMD, pass int, ignoreMissin
 			if err = mi.AddEnabled(tid, availablePaths, config); err != nil {
 				return
 			}
+			if fsMpathMD.ReadOnly {
+				mi.SetReadOnly()
+			}
+			if mi.Label == "" {
+				mi.Label = fsMpathMD.Label // (not in the config)
+			}
 			if err = mi.CheckDisks(); err != nil {
 				nlog.Errorf("Warning: %v", err)
 			}
@@ -22,12 +22,14 @@ const vmdCopies = 3
 
 type (
 	fsMpathMD struct {
-		Ext     any      `json:"ext,omitempty"` // reserved for within-metaversion extensions
-		Path    string   `json:"mountpath"`
-		Fs      string   `json:"fs"`
-		FsType  string   `json:"fs_type"`
-		FsID    cos.FsID `json:"fs_id"`
-		Enabled bool     `json:"enabled"`
+		Ext      any      `json:"ext,omitempty"` // reserved for within-metaversion extensions
+		Path     string   `json:"mountpath"`
+		Fs       string   `json:"fs"`
+		FsType   string   `json:"fs_type"`
+		FsID     cos.FsID `json:"fs_id"`
+		Enabled  bool     `json:"enabled"`
+		ReadOnly bool     `json:"read_only,omitempty"` // see fs.FlagReadOnly
+		Label    string   `json:"label,omitempty"`     // see fs.Mountpath.Label
 	}
 
 	// VMD is AIS target's volume metadata structure
@@ -66,11 +68,13 @@ func (*VMD) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverVMD) }
 
 func (vmd *VMD) addMountpath(mi *fs.Mountpath, enabled bool) {
 	vmd.Mountpaths[mi.Path] = &fsMpathMD{
-		Path:    mi.Path,
-		Enabled: enabled,
-		Fs:      mi.Fs,
-		FsType:  mi.FsType,
-		FsID:    mi.FsID,
+		Path:     mi.Path,
+		Enabled:  enabled,
+		ReadOnly: enabled && mi.IsAnySet(fs.FlagReadOnly),
+		Fs:       mi.Fs,
+		FsType:   mi.FsType,
+		FsID:     mi.FsID,
+		Label:    mi.Label,
 	}
 }
 
@@ -118,8 +122,11 @@ func (vmd *VMD) _string() string {
 	i := 0
 	for mpath, md := range vmd.Mountpaths {
 		mps[i] = mpath
-		if !md.Enabled {
+		switch {
+		case !md.Enabled:
 			mps[i] += "(-)"
+		case md.ReadOnly:
+			mps[i] += "(ro)"
 		}
 		i++
 	}
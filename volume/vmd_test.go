@@ -25,6 +25,7 @@ func TestVMD(t *testing.T) {
 
 	t.Run("CreateNewVMD", func(t *testing.T) { testVMDCreate(t, mpaths, daemonID) })
 	t.Run("VMDPersist", func(t *testing.T) { testVMDPersist(t, daemonID) })
+	t.Run("VMDReadOnly", func(t *testing.T) { testVMDReadOnly(t, mpaths, daemonID) })
 }
 
 func testVMDCreate(t *testing.T, mpaths fs.MPI, daemonID string) {
@@ -56,3 +57,22 @@ func testVMDPersist(t *testing.T, daemonID string) {
 	tassert.Errorf(t, reflect.DeepEqual(newVMD.Mountpaths, vmd.Mountpaths),
 		"expected VMDs to be equal. got: %+v vs %+v", newVMD, vmd)
 }
+
+func testVMDReadOnly(t *testing.T, mpaths fs.MPI, daemonID string) {
+	var rmpath string
+	for rmpath = range mpaths {
+		break
+	}
+	_, err := fs.ReadOnlyMpath(rmpath, true, nil)
+	tassert.CheckFatal(t, err)
+	defer fs.ReadOnlyMpath(rmpath, false, nil)
+
+	_, err = volume.NewFromMPI(daemonID)
+	tassert.CheckFatal(t, err)
+	vmd, err := volume.LoadVMDTest()
+	tassert.CheckFatal(t, err)
+	for mpath, md := range vmd.Mountpaths {
+		tassert.Errorf(t, md.Enabled, "expecting %q enabled", mpath)
+		tassert.Errorf(t, md.ReadOnly == (mpath == rmpath), "%q: unexpected read-only=%t", mpath, md.ReadOnly)
+	}
+}
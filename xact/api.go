@@ -77,6 +77,26 @@ type (
 
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*core.Snap // by target ID (tid)
+
+	// job that cannot start right away due to "limited coexistence" conflict(s)
+	// and is, therefore, queued by the primary (see feat.QueueLimitedCoexistence)
+	QueuedJob struct {
+		ID       string  `json:"id"`
+		Kind     string  `json:"kind"`
+		Cause    string  `json:"cause"` // the most recent conflict
+		Bck      cmn.Bck `json:"bck"`
+		DstBck   cmn.Bck `json:"dst_bck"`
+		Queued   int64   `json:"queued"` // unix nano
+		Attempts int     `json:"attempts"`
+		Priority int     `json:"priority"` // higher-priority jobs start first
+	}
+
+	// implemented by `xact.Base` but honored only by `Descriptor.Pausable` kinds
+	Pausable interface {
+		Pause() bool
+		Resume() bool
+		IsPaused() bool
+	}
 )
 
 type (
@@ -101,6 +121,11 @@ type (
 		// xaction returns extended xaction-specific stats
 		// (see related: `Snap.Ext` in core/xaction.go)
 		ExtendedStats bool
+
+		// xaction can be paused and resumed (via `api.PauseXaction` and `api.ResumeXaction`);
+		// in addition, it checkpoints its progress so that the next run of the same job
+		// (e.g., after abort or node restart) resumes from where it left off
+		Pausable bool
 	}
 )
 
@@ -144,7 +169,7 @@ var Table = map[string]Descriptor{
 	//
 	// on-demand multi-object (consider setting ConflictRebRes = true)
 	//
-	apc.ActArchive: {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActArchive: {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true, Pausable: true},
 	apc.ActCopyObjects: {
 		DisplayName: "copy-objects",
 		Scope:       ScopeB,
@@ -152,6 +177,7 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 		Idles:       true,
+		Pausable:    true,
 	},
 	apc.ActETLObjects: {
 		DisplayName: "etl-objects",
@@ -161,6 +187,7 @@ var Table = map[string]Descriptor{
 		RefreshCap:  true,
 		Idles:       true,
 		AbortRebRes: true,
+		Pausable:    true,
 	},
 
 	apc.ActBlobDl: {Access: apc.AccessRW, Scope: ScopeB, Startable: true, AbortRebRes: true, RefreshCap: true},
@@ -207,6 +234,7 @@ var Table = map[string]Descriptor{
 		Access:      apc.AccessRW,
 		Startable:   true,
 		RefreshCap:  true,
+		Pausable:    true,
 	},
 
 	// entire bucket (storage svcs)
@@ -218,6 +246,16 @@ var Table = map[string]Descriptor{
 		Metasync:       true,
 		RefreshCap:     true,
 		ConflictRebRes: true,
+		Pausable:       true,
+	},
+	apc.ActECReencode: {
+		DisplayName:    "ec-reencode",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		Pausable:       true,
 	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
@@ -226,6 +264,14 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		Metasync:    true,
 		RefreshCap:  true,
+		Pausable:    true,
+	},
+	apc.ActTier: {
+		DisplayName: "tier",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   true,
+		RefreshCap:  true,
 	},
 	apc.ActMoveBck: {
 		DisplayName:    "rename-bucket",
@@ -244,6 +290,7 @@ var Table = map[string]Descriptor{
 		Metasync:       true,
 		RefreshCap:     true,
 		ConflictRebRes: true,
+		Pausable:       true,
 	},
 	apc.ActETLBck: {
 		DisplayName: "etl-bucket",
@@ -253,6 +300,13 @@ var Table = map[string]Descriptor{
 		Metasync:    true,
 		RefreshCap:  true,
 		AbortRebRes: true,
+		Pausable:    true,
+	},
+	apc.ActBckDiff: {
+		DisplayName: "diff-bucket",
+		Scope:       ScopeB,
+		Access:      apc.AccessRO,
+		Startable:   false, // via api.DiffBuckets
 	},
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true},
@@ -38,6 +38,11 @@ type (
 			err  ratomic.Pointer[error]
 			done atomic.Bool
 		}
+		pause struct {
+			ch  chan struct{} // closed upon resume or abort
+			mu  sync.Mutex
+			yes atomic.Bool
+		}
 		stats struct {
 			objs     atomic.Int64 // locally processed
 			bytes    atomic.Int64
@@ -162,12 +167,73 @@ func (xctn *Base) Abort(err error) bool {
 	xctn.abort.ch <- err
 	close(xctn.abort.ch)
 
+	xctn.unpause() // wake up paused workers, if any
+
 	if xctn.Kind() != apc.ActList {
 		nlog.InfoDepth(1, xctn.Name(), err)
 	}
 	return true
 }
 
+//
+// pausing (see also: mpather.JgroupOpts.Pauser)
+//
+
+// Pause is advisory: it's up to the xaction to call WaitResumed at the points where
+// it can safely stop (and checkpoint its progress) - see `Descriptor.Pausable`
+func (xctn *Base) Pause() bool {
+	if !xctn.Running() {
+		return false
+	}
+	xctn.pause.mu.Lock()
+	if xctn.pause.yes.Load() || xctn.IsAborted() {
+		xctn.pause.mu.Unlock()
+		return false
+	}
+	xctn.pause.ch = make(chan struct{})
+	xctn.pause.yes.Store(true)
+	xctn.pause.mu.Unlock()
+	nlog.Infoln(xctn.Name(), "paused")
+	return true
+}
+
+func (xctn *Base) Resume() bool {
+	if !xctn.unpause() {
+		return false
+	}
+	nlog.Infoln(xctn.Name(), "resumed")
+	return true
+}
+
+func (xctn *Base) unpause() bool {
+	xctn.pause.mu.Lock()
+	defer xctn.pause.mu.Unlock()
+	if !xctn.pause.yes.Load() {
+		return false
+	}
+	xctn.pause.yes.Store(false)
+	close(xctn.pause.ch)
+	return true
+}
+
+func (xctn *Base) IsPaused() bool { return xctn.pause.yes.Load() }
+
+// block while paused; return abort error, if any
+func (xctn *Base) WaitResumed() error {
+	xctn.pause.mu.Lock()
+	if !xctn.pause.yes.Load() {
+		xctn.pause.mu.Unlock()
+		return nil
+	}
+	ch := xctn.pause.ch
+	xctn.pause.mu.Unlock()
+	<-ch
+	if xctn.IsAborted() {
+		return cmn.NewErrAborted(xctn.Name(), "paused", nil)
+	}
+	return nil
+}
+
 //
 // multi-error
 //
@@ -386,6 +452,7 @@ func (xctn *Base) ToSnap(snap *core.Snap) {
 		snap.AbortErr = err.Error()
 		snap.AbortedX = true
 	}
+	snap.PausedX = xctn.IsPaused()
 	snap.Err = xctn.err.Error() // TODO: a (verbose) option to respond with xctn.err.JoinErr() :NOTE
 	if b := xctn.Bck(); b != nil {
 		snap.Bck = b.Clone()
@@ -5,19 +5,30 @@
 package xact
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/OneOfOne/xxhash"
 )
 
 type BckJog struct {
 	Config  *cmn.Config
 	joggers *mpather.Jgroup
+	cptag   string // checkpoint tag (pausable kinds only)
 	Base
 }
 
 func (r *BckJog) Init(id, kind string, bck *meta.Bck, opts *mpather.JgroupOpts, config *cmn.Config) {
 	r.InitBase(id, kind, bck)
+	if Table[kind].Pausable {
+		opts.Pauser = r
+		r.cptag = opts.Checkpoint
+	}
 	r.joggers = mpather.NewJoggerGroup(opts, config, "")
 	r.Config = config
 }
@@ -28,11 +39,26 @@ func (r *BckJog) Wait() error {
 	select {
 	case errCause := <-r.ChanAbort():
 		r.joggers.Stop()
+		if r.cptag != "" && errCause == cmn.ErrXactUserAbort {
+			// (unlike pause, user abort means "start over next time")
+			fs.RemoveCheckpoints(r.cptag)
+		}
 		if cmn.IsErrAborted(errCause) {
 			return errCause
 		}
 		return cmn.NewErrAborted(r.Name(), "x-bck-jog", errCause)
 	case <-r.joggers.ListenFinished():
-		return r.joggers.Stop()
+		err := r.joggers.Stop()
+		if err != nil && r.cptag != "" && (!r.IsAborted() || r.AbortErr() == cmn.ErrXactUserAbort) {
+			// failed: start over next time as well
+			fs.RemoveCheckpoints(r.cptag)
+		}
+		return err
 	}
 }
+
+// same job (kind and, e.g., source and destination buckets, prefix, etc.) => same checkpoint tag
+func CheckpointTag(kind string, parts ...string) string {
+	digest := xxhash.Checksum64S(cos.UnsafeB(strings.Join(parts, "|")), cos.MLCG32)
+	return kind + "-" + strconv.FormatUint(digest, 36)
+}
@@ -26,6 +26,12 @@ type (
 		BckTo   *meta.Bck
 		DP      core.DP
 	}
+	BckDiffArgs struct {
+		BckFrom *meta.Bck
+		BckTo   *meta.Bck
+		Report  *meta.Bck
+		Msg     *apc.BckDiffMsg
+	}
 	DsortArgs struct {
 		BckFrom *meta.Bck
 		BckTo   *meta.Bck
@@ -63,10 +69,18 @@ func RenewBucketXact(kind string, bck *meta.Bck, args Args, buckets ...*meta.Bck
 	return dreg.renew(e, bck, buckets...)
 }
 
+func RenewBckDiff(uuid string, custom *BckDiffArgs) RenewRes {
+	return RenewBucketXact(apc.ActBckDiff, custom.BckFrom, Args{Custom: custom, UUID: uuid}, custom.BckFrom, custom.BckTo)
+}
+
 func RenewECEncode(bck *meta.Bck, uuid, phase string) RenewRes {
 	return RenewBucketXact(apc.ActECEncode, bck, Args{Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+func RenewECReencode(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActECReencode, bck, Args{UUID: uuid})
+}
+
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -106,6 +120,10 @@ func RenewPromote(uuid string, bck *meta.Bck, args *apc.PromoteArgs) RenewRes {
 	return RenewBucketXact(apc.ActPromote, bck, Args{Custom: args, UUID: uuid})
 }
 
+func RenewTier(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActTier, bck, Args{UUID: uuid})
+}
+
 func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
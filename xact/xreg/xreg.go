@@ -264,6 +264,47 @@ func DoAbort(flt Flt, err error) {
 	}
 }
 
+// pause or resume running xaction(s) - see `xact.Descriptor.Pausable`
+func DoPause(flt Flt, pause bool) error {
+	var xctns []core.Xact
+	if flt.Kind != "" && !xact.IsValidKind(flt.Kind) {
+		return cmn.NewErrXactNotFoundError(flt.Kind)
+	}
+	if flt.ID != "" {
+		xctn, err := dreg.getXact(flt.ID)
+		if err != nil {
+			return err
+		}
+		if xctn == nil || xctn.Finished() {
+			return cmn.NewErrXactNotFoundError("[" + flt.ID + "]")
+		}
+		if !xact.Table[xctn.Kind()].Pausable {
+			return cmn.NewErrUnsupp("pause", xctn.Name())
+		}
+		xctns = append(xctns, xctn)
+	} else {
+		onl := true
+		flt.OnlyRunning = &onl
+		dreg.entries.forEach(func(entry Renewable) bool {
+			xctn := entry.Get()
+			if xact.Table[xctn.Kind()].Pausable && flt.Matches(xctn) {
+				xctns = append(xctns, xctn)
+			}
+			return true
+		})
+	}
+	for _, xctn := range xctns {
+		px, ok := xctn.(xact.Pausable)
+		debug.Assert(ok, xctn.String())
+		if pause {
+			px.Pause()
+		} else {
+			px.Resume()
+		}
+	}
+	return nil
+}
+
 func GetSnap(flt Flt) ([]*core.Snap, error) {
 	var onlyRunning bool
 	if flt.OnlyRunning != nil {
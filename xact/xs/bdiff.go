@@ -0,0 +1,647 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/transport/bundle"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// x-bck-diff compares source and destination buckets by name, size, and checksum.
+//
+// Each object name is visited by exactly one target in each of the two passes:
+// 1. src => dst: objects "owned" by this target in the source bucket (in terms of HRW);
+//    the destination counterpart is then looked up locally, via t2t HEAD, or in the
+//    listed remote bucket (below) to determine missing and mismatched objects;
+// 2. dst => src: same, in reverse, to find extra destination objects.
+//
+// The job runs as a two-phase transaction: in the begin phase each target renews x-bck-diff
+// (and registers to receive remote listings), so that by commit time all targets are ready.
+//
+// Local (ais://) bucket is walked locally. Remote (Cloud and remote AIS) bucket is listed
+// only once, by a single designated target, along with object sizes and checksums; the
+// designated target then sends each target its own HRW share of the listing, so that
+// remote objects are compared using their listed attributes (and never HEAD-ed).
+//
+// Differences, if any, are written into a per-target report (one line per object) that,
+// once finished, gets stored as `<xaction ID>/<target ID>.tsv` in the (ais://) report bucket.
+
+const bdiffWorkers = 8
+
+// report line prefixes
+const (
+	bdiffMissing  = "missing"
+	bdiffExtra    = "extra"
+	bdiffMismatch = "mismatch"
+)
+
+type (
+	bdiffFactory struct {
+		xreg.RenewBase
+		xctn *XactBckDiff
+		args *xreg.BckDiffArgs
+	}
+	// this target's share of the remote bucket listing
+	bdiffShare struct {
+		bck    *meta.Bck
+		objs   map[string]*cmn.ObjAttrs // listed attributes (size, checksum)
+		doneCh chan struct{}            // closed when fully received
+		mu     sync.Mutex
+	}
+	XactBckDiff struct {
+		args   *xreg.BckDiffArgs
+		smap   *meta.Smap
+		config *cmn.Config
+		dm     *bundle.DataMover // to receive (and send) remote listings; nil when single target
+		shares [2]*bdiffShare    // (src, dst); nil for local buckets
+		// report
+		rlom  *core.LOM
+		rfh   *os.File
+		rbuf  *bufio.Writer
+		rmu   sync.Mutex
+		stats struct {
+			matched, unverified, missing, extra, mismatched ratomic.Int64
+		}
+		report string
+		xact.Base
+	}
+	bdiffCb func(objName string, oa *cmn.ObjAttrs)
+)
+
+// interface guard
+var (
+	_ xreg.Renewable = (*bdiffFactory)(nil)
+	_ core.Xact      = (*XactBckDiff)(nil)
+)
+
+//////////////////
+// bdiffFactory //
+//////////////////
+
+func (*bdiffFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	custom := args.Custom.(*xreg.BckDiffArgs)
+	p := &bdiffFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, args: custom}
+	return p
+}
+
+func (p *bdiffFactory) Start() (err error) {
+	r := &XactBckDiff{args: p.args, config: cmn.GCO.Get()}
+	r.InitBase(p.UUID(), p.Kind(), p.args.BckFrom)
+
+	var remote bool
+	for i, bck := range []*meta.Bck{p.args.BckFrom, p.args.BckTo} {
+		if bck.IsRemote() {
+			r.shares[i] = &bdiffShare{bck: bck, objs: make(map[string]*cmn.ObjAttrs, 1024), doneCh: make(chan struct{})}
+			remote = true
+		}
+	}
+	// open streams iff there's a remote bucket to share
+	if remote && core.T.Sowner().Get().CountActiveTs() > 1 {
+		trname := "bdiff-" + p.UUID()
+		dmxtra := bundle.Extra{Multiplier: 1, Config: r.config}
+		if r.dm, err = bundle.NewDataMover(trname, r.recv, cmn.OwtPut, dmxtra); err != nil {
+			return err
+		}
+		if err = r.dm.RegRecv(); err != nil {
+			return err
+		}
+		r.dm.SetXact(r)
+		r.dm.Open()
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*bdiffFactory) Kind() string     { return apc.ActBckDiff }
+func (p *bdiffFactory) Get() core.Xact { return p.xctn }
+
+func (*bdiffFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+/////////////////
+// XactBckDiff //
+/////////////////
+
+func (r *XactBckDiff) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	nlog.Infoln(r.Name(), r.args.BckFrom.Cname(""), "vs", r.args.BckTo.Cname(""))
+
+	r.smap = core.T.Sowner().Get()
+
+	// 0. remote bucket(s): list or receive this target's share
+	err := r.shareRemote()
+	// 1. src => dst
+	if err == nil && !r.IsAborted() {
+		err = r.walk(r.args.BckFrom, r.shares[0], r.visitSrc)
+	}
+	// 2. dst => src
+	if err == nil && !r.IsAborted() {
+		err = r.walk(r.args.BckTo, r.shares[1], r.visitDst)
+	}
+	if err != nil {
+		r.AddErr(err)
+	}
+	if r.dm != nil {
+		r.dm.Close(r.Err())
+		r.dm.UnregRecv()
+	}
+	if errR := r.storeReport(); errR != nil {
+		r.AddErr(errR)
+	}
+	r.Finish()
+}
+
+// (compare w/ XactTCB.TxnAbort)
+func (r *XactBckDiff) TxnAbort(err error) {
+	err = cmn.NewErrAborted(r.Name(), "bdiff: txn-abort", err)
+	if r.dm != nil {
+		r.dm.Close(err)
+		r.dm.UnregRecv()
+	}
+	r.AddErr(err)
+	r.Finish()
+}
+
+// designated target lists remote bucket(s) and sends out HRW shares;
+// all other targets wait to receive theirs
+func (r *XactBckDiff) shareRemote() error {
+	lister, err := r.smap.HrwName2T(r.ID())
+	if err != nil {
+		return err
+	}
+	for i, share := range r.shares {
+		if share == nil {
+			continue
+		}
+		if lister.ID() == core.T.SID() {
+			if err := r.list(i, share); err != nil {
+				r.sendTerm(i, err)
+				return err
+			}
+			r.sendTerm(i, nil)
+			continue
+		}
+		select {
+		case <-share.doneCh:
+		case <-r.ChanAbort():
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *XactBckDiff) list(i int, share *bdiffShare) error {
+	var (
+		bck = share.bck
+		msg = &apc.LsoMsg{Prefix: r.args.Msg.Prefix}
+	)
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum)
+	for !r.IsAborted() {
+		lst := &cmn.LsoResult{Entries: allocLsoEntries()}
+		errCode, err := core.T.Backend(bck).ListObjects(bck, msg, lst)
+		if err != nil {
+			freeLsoEntries(lst.Entries)
+			if errCode == http.StatusNotFound && !cos.IsNotExist(err, 0) {
+				err = cos.NewErrNotFound(nil, err.Error())
+			}
+			return err
+		}
+		pages := make(map[string]*cmn.LsoResult, r.smap.CountActiveTs())
+		for _, en := range lst.Entries {
+			if en.Flags&apc.EntryIsDir != 0 {
+				continue
+			}
+			tsi, err := r.smap.HrwName2T(bck.MakeUname(en.Name))
+			if err != nil {
+				freeLsoEntries(lst.Entries)
+				return err
+			}
+			if tsi.ID() == core.T.SID() {
+				share.add(en)
+				continue
+			}
+			page, ok := pages[tsi.ID()]
+			if !ok {
+				page = &cmn.LsoResult{}
+				pages[tsi.ID()] = page
+			}
+			page.Entries = append(page.Entries, en)
+		}
+		for tid, page := range pages {
+			if err := r.send(i, page, r.smap.GetTarget(tid)); err != nil {
+				freeLsoEntries(lst.Entries)
+				return err
+			}
+		}
+		freeLsoEntries(lst.Entries)
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	return nil
+}
+
+// visit all object names owned by this target (compare w/ lriterator._prefix):
+// remote bucket - its (listed) share; otherwise, local walk
+func (r *XactBckDiff) walk(bck *meta.Bck, share *bdiffShare, cb bdiffCb) error {
+	wg := cos.NewLimitedWaitGroup(bdiffWorkers, 0)
+	if share != nil {
+		for name, oa := range share.objs {
+			if r.IsAborted() {
+				break
+			}
+			wg.Add(1)
+			go func(name string, oa *cmn.ObjAttrs) {
+				cb(name, oa)
+				wg.Done()
+			}(name, oa)
+		}
+		wg.Wait()
+		return nil
+	}
+
+	var (
+		msg = &apc.LsoMsg{Prefix: r.args.Msg.Prefix, Props: apc.GetPropsStatus}
+		npg = newNpgCtx(bck, msg, noopCb)
+	)
+	for !r.IsAborted() {
+		npg.page.Entries = allocLsoEntries()
+		if err := npg.nextPageA(); err != nil {
+			return err
+		}
+		lst := &npg.page
+		for _, be := range lst.Entries {
+			if !be.IsStatusOK() || be.Flags&apc.EntryIsDir != 0 {
+				continue
+			}
+			wg.Add(1)
+			go func(name string) {
+				cb(name, nil)
+				wg.Done()
+			}(be.Name)
+		}
+		wg.Wait()
+		freeLsoEntries(lst.Entries)
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	return nil
+}
+
+func (r *XactBckDiff) visitSrc(objName string, src *cmn.ObjAttrs) {
+	var err error
+	if src == nil {
+		if src, err = r.attrs(0, objName); err != nil {
+			if !cos.IsNotExist(err, 0) { // otherwise, removed in the meantime
+				r.AddErr(err, 5, cos.SmoduleXs)
+			}
+			return
+		}
+	}
+	dst, err := r.attrs(1, objName)
+	switch {
+	case err == nil:
+		r.ObjsAdd(1, src.Size)
+		if diff, verified := bdiffCompare(src, dst); diff != "" {
+			r.stats.mismatched.Add(1)
+			r.write(bdiffMismatch, objName, diff)
+		} else {
+			r.stats.matched.Add(1)
+			if !verified {
+				r.stats.unverified.Add(1)
+			}
+		}
+	case cos.IsNotExist(err, 0):
+		r.stats.missing.Add(1)
+		r.write(bdiffMissing, objName, "")
+	default:
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+}
+
+func (r *XactBckDiff) visitDst(objName string, _ *cmn.ObjAttrs) {
+	_, err := r.attrs(0, objName)
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err, 0):
+		r.stats.extra.Add(1)
+		r.write(bdiffExtra, objName, "")
+	default:
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+}
+
+// object attributes (size, checksum, custom metadata) given bucket index (0: src, 1: dst) and name;
+// returns cos.ErrNotFound when the object does not exist
+func (r *XactBckDiff) attrs(i int, objName string) (oa *cmn.ObjAttrs, err error) {
+	if share := r.shares[i]; share != nil {
+		if oa = share.objs[objName]; oa == nil {
+			err = cos.NewErrNotFound(core.T, share.bck.Cname(objName))
+		}
+		return oa, err
+	}
+
+	var (
+		errCode int
+		tsi     *meta.Snode
+		local   bool
+		bck     = r.args.BckFrom
+	)
+	if i > 0 {
+		bck = r.args.BckTo
+	}
+	lom := core.AllocLOM(objName)
+	if err = lom.InitBck(bck.Bucket()); err != nil {
+		core.FreeLOM(lom)
+		return nil, err
+	}
+	tsi, local, err = lom.HrwTarget(r.smap)
+	switch {
+	case err != nil:
+	case local:
+		if err = lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+			oa = &cmn.ObjAttrs{}
+			*oa = *lom.ObjAttrs()
+		} else if cmn.IsErrObjNought(err) {
+			errCode = http.StatusNotFound
+		}
+	default:
+		oa, errCode, err = core.T.HeadObjAttrsT2T(lom, tsi)
+	}
+	if err != nil && cos.IsNotExist(err, errCode) {
+		err = cos.NewErrNotFound(core.T, lom.Cname())
+	}
+	core.FreeLOM(lom)
+	return oa, err
+}
+
+// - size must match
+// - checksums of the same type (including Cloud-provided MD5 and CRC32C) must match
+// - returns `verified` = false when there's nothing to compare other than size
+func bdiffCompare(src, dst *cmn.ObjAttrs) (diff string, verified bool) {
+	if src.Size != dst.Size {
+		return fmt.Sprintf("size %d vs %d", src.Size, dst.Size), false
+	}
+	a, b := bdiffCksums(src), bdiffCksums(dst)
+	for ty, va := range a {
+		vb, ok := b[ty]
+		if !ok {
+			continue
+		}
+		if va != vb {
+			return fmt.Sprintf("%s %s vs %s", ty, va, vb), false
+		}
+		verified = true
+	}
+	return "", verified
+}
+
+func bdiffCksums(oa *cmn.ObjAttrs) cos.StrKVs {
+	m := make(cos.StrKVs, 3)
+	for _, ty := range []string{cmn.MD5ObjMD, cmn.CRC32CObjMD} {
+		if v, ok := oa.GetCustomKey(ty); ok && v != "" {
+			m[ty] = v
+		}
+	}
+	if !oa.Cksum.IsEmpty() {
+		m[oa.Cksum.Ty()] = oa.Cksum.Val()
+	}
+	return m
+}
+
+// listed attributes: size and, for Cloud buckets, MD5 (unless multipart ETag)
+func bdiffListed(bck *meta.Bck, en *cmn.LsoEntry) *cmn.ObjAttrs {
+	oa := &cmn.ObjAttrs{Size: en.Size}
+	if bck.IsCloud() && en.Checksum != "" && !strings.Contains(en.Checksum, cmn.AwsMultipartDelim) {
+		oa.SetCustomKey(cmn.MD5ObjMD, en.Checksum)
+	}
+	return oa
+}
+
+////////////////
+// bdiffShare //
+////////////////
+
+func (share *bdiffShare) add(en *cmn.LsoEntry) {
+	oa := bdiffListed(share.bck, en)
+	share.mu.Lock()
+	share.objs[en.Name] = oa
+	share.mu.Unlock()
+}
+
+/////////////
+// streams //
+/////////////
+
+// send remote bucket's listed page to its HRW owner; opaque: bucket index
+func (r *XactBckDiff) send(i int, page *cmn.LsoResult, tsi *meta.Snode) error {
+	debug.Assert(r.dm != nil && tsi != nil)
+	var (
+		mm        = core.T.PageMM()
+		buf, slab = mm.AllocSize(cmn.MsgpLsoBufSize)
+		sgl       = mm.NewSGL(0)
+		mw        = msgp.NewWriterBuf(sgl, buf)
+		err       = page.EncodeMsg(mw)
+	)
+	if err == nil {
+		err = mw.Flush()
+	}
+	slab.Free(buf)
+	if err != nil {
+		sgl.Free()
+		return err
+	}
+	o := transport.AllocSend()
+	{
+		o.Hdr.Bck = r.shares[i].bck.Clone()
+		o.Hdr.Opaque = []byte{byte(i)}
+		o.Hdr.ObjAttrs.Size = sgl.Len()
+	}
+	o.Callback, o.CmplArg = r.sentCb, sgl
+	return r.dm.Send(o, memsys.NewReader(sgl), tsi)
+}
+
+func (r *XactBckDiff) sentCb(hdr *transport.ObjHdr, _ io.ReadCloser, arg any, err error) {
+	if err == nil {
+		r.OutObjsAdd(1, hdr.ObjAttrs.Size) // (counting sent pages)
+	} else if cmn.Rom.FastV(4, cos.SmoduleXs) || !cos.IsRetriableConnErr(err) {
+		nlog.Warningln(r.Name(), "failed to send:", err)
+	}
+	sgl, ok := arg.(*memsys.SGL)
+	debug.Assertf(ok, "%T", arg)
+	sgl.Free()
+}
+
+// tell all other targets that the listing is done (or failed - see recv)
+func (r *XactBckDiff) sendTerm(i int, err error) {
+	if r.dm == nil {
+		return
+	}
+	o := transport.AllocSend()
+	o.Hdr.Opaque = []byte{byte(i)}
+	if err == nil {
+		o.Hdr.Opcode = opcodeDone
+	} else {
+		o.Hdr.Opcode = opcodeAbrt
+		o.Hdr.ObjName = err.Error()
+	}
+	if errB := r.dm.Bcast(o, nil); errB != nil {
+		nlog.Errorln(r.Name(), "failed to broadcast:", errB)
+	}
+}
+
+func (r *XactBckDiff) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+	defer transport.DrainAndFreeReader(objReader)
+	if hdr.Opcode == opcodeAbrt {
+		err = errors.New(hdr.ObjName)
+	}
+	if err != nil && !cos.IsEOF(err) {
+		r.Abort(err)
+		return err
+	}
+	if len(hdr.Opaque) != 1 || int(hdr.Opaque[0]) >= len(r.shares) || r.shares[hdr.Opaque[0]] == nil {
+		err = fmt.Errorf("%s: invalid opaque %v", r.Name(), hdr.Opaque)
+		debug.AssertNoErr(err)
+		return err
+	}
+	share := r.shares[hdr.Opaque[0]]
+	if hdr.Opcode == opcodeDone {
+		close(share.doneCh)
+		return nil
+	}
+
+	var (
+		page      = &cmn.LsoResult{}
+		buf, slab = core.T.PageMM().AllocSize(cmn.MsgpLsoBufSize)
+	)
+	err = page.DecodeMsg(msgp.NewReaderBuf(objReader, buf))
+	slab.Free(buf)
+	if err != nil {
+		err = fmt.Errorf("%s: failed to receive %s listing from %s: %w", r.Name(), share.bck.Cname(""), hdr.SID, err)
+		r.Abort(err)
+		return err
+	}
+	for _, en := range page.Entries {
+		share.add(en)
+	}
+	r.InObjsAdd(1, hdr.ObjAttrs.Size) // (counting received pages)
+	return nil
+}
+
+////////////
+// report //
+////////////
+
+func (r *XactBckDiff) write(what, objName, diff string) {
+	r.rmu.Lock()
+	defer r.rmu.Unlock()
+	if r.rbuf == nil {
+		if r.rlom != nil {
+			return // failed to open (see below)
+		}
+		if err := r.openReport(); err != nil {
+			r.AddErr(err)
+			return
+		}
+	}
+	r.rbuf.WriteString(what)
+	r.rbuf.WriteByte('\t')
+	r.rbuf.WriteString(objName)
+	if diff != "" {
+		r.rbuf.WriteByte('\t')
+		r.rbuf.WriteString(diff)
+	}
+	r.rbuf.WriteByte('\n')
+}
+
+// (locked)
+func (r *XactBckDiff) openReport() (err error) {
+	r.report = r.ID() + "/" + core.T.SID() + ".tsv"
+	r.rlom = core.AllocLOM(r.report)
+	if err = r.rlom.InitBck(r.args.Report.Bucket()); err != nil {
+		return err
+	}
+	wfqn := fs.CSM.Gen(r.rlom, fs.WorkfileType, "bdiff")
+	if r.rfh, err = cos.CreateFile(wfqn); err != nil {
+		return err
+	}
+	r.rbuf = bufio.NewWriter(r.rfh)
+	return nil
+}
+
+func (r *XactBckDiff) storeReport() (err error) {
+	if r.rlom == nil {
+		return nil
+	}
+	defer core.FreeLOM(r.rlom)
+	if r.rbuf == nil {
+		return nil
+	}
+	wfqn := r.rfh.Name()
+	err = r.rbuf.Flush()
+	if errC := r.rfh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		params := &core.PromoteParams{Bck: r.args.Report, Config: r.config}
+		params.SrcFQN, params.ObjName = wfqn, r.report
+		params.OverwriteDst, params.DeleteSrc, params.SrcIsNotFshare = true, true, true
+		_, err = core.T.Promote(params)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(wfqn); errRm != nil {
+			nlog.Errorln(r.Name(), "failed to remove", wfqn, "err:", errRm)
+		}
+		r.report = ""
+		return fmt.Errorf("%s: failed to store report: %w", r.Name(), err)
+	}
+	debug.Assert(r.report != "")
+	return nil
+}
+
+func (r *XactBckDiff) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	st := &apc.BckDiffStats{
+		Matched:    r.stats.matched.Load(),
+		Unverified: r.stats.unverified.Load(),
+		Missing:    r.stats.missing.Load(),
+		Extra:      r.stats.extra.Load(),
+		Mismatched: r.stats.mismatched.Load(),
+	}
+	if r.Finished() {
+		st.Report = r.report
+	}
+	snap.Ext = st
+	return
+}
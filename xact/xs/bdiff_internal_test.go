@@ -0,0 +1,87 @@
+// Package xs - bucket diff (object comparison) unit tests.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBckDiffCompare(t *testing.T) {
+	newOA := func(size int64, cksum *cos.Cksum, md cos.StrKVs) *cmn.ObjAttrs {
+		return &cmn.ObjAttrs{Size: size, Cksum: cksum, CustomMD: md}
+	}
+	tests := []struct {
+		name     string
+		src, dst *cmn.ObjAttrs
+		differ   bool
+		verified bool
+	}{
+		{"same-cksum", newOA(10, cos.NewCksum(cos.ChecksumXXHash, "abc"), nil),
+			newOA(10, cos.NewCksum(cos.ChecksumXXHash, "abc"), nil), false, true},
+		{"diff-size", newOA(10, nil, nil), newOA(11, nil, nil), true, false},
+		{"diff-cksum", newOA(10, cos.NewCksum(cos.ChecksumXXHash, "abc"), nil),
+			newOA(10, cos.NewCksum(cos.ChecksumXXHash, "abd"), nil), true, false},
+		{"size-only", newOA(10, cos.NewCksum(cos.ChecksumXXHash, "abc"), nil),
+			newOA(10, nil, cos.StrKVs{cmn.ETag: "xyz"}), false, false},
+		{"cloud-md5", newOA(10, cos.NewCksum(cos.ChecksumMD5, "0123"), nil),
+			newOA(10, nil, cos.StrKVs{cmn.MD5ObjMD: "0123"}), false, true},
+		{"cloud-md5-mismatch", newOA(10, nil, cos.StrKVs{cmn.MD5ObjMD: "0123"}),
+			newOA(10, nil, cos.StrKVs{cmn.MD5ObjMD: "4567"}), true, false},
+	}
+	for _, test := range tests {
+		diff, verified := bdiffCompare(test.src, test.dst)
+		tassert.Errorf(t, (diff != "") == test.differ, "%s: expected differ=%t, got %q", test.name, test.differ, diff)
+		tassert.Errorf(t, verified == test.verified, "%s: expected verified=%t", test.name, test.verified)
+	}
+}
+
+func TestBckDiffListed(t *testing.T) {
+	var (
+		s3  = meta.NewBck("abc", apc.AWS, cmn.NsGlobal)
+		ais = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+	)
+	tests := []struct {
+		name  string
+		bck   *meta.Bck
+		cksum string
+		md5   string
+	}{
+		{"cloud", s3, "0123", "0123"},
+		{"cloud-multipart", s3, "0123" + cmn.AwsMultipartDelim + "3", ""},
+		{"cloud-none", s3, "", ""},
+		{"non-cloud", ais, "0123", ""}, // (checksum type unknown)
+	}
+	for _, test := range tests {
+		oa := bdiffListed(test.bck, &cmn.LsoEntry{Name: "o", Size: 10, Checksum: test.cksum})
+		tassert.Errorf(t, oa.Size == 10, "%s: expected size 10, got %d", test.name, oa.Size)
+		v, _ := oa.GetCustomKey(cmn.MD5ObjMD)
+		tassert.Errorf(t, v == test.md5, "%s: expected md5 %q, got %q", test.name, test.md5, v)
+	}
+
+	// listed vs loaded (e.g., copied into ais://)
+	src := bdiffListed(s3, &cmn.LsoEntry{Name: "o", Size: 10, Checksum: "0123"})
+	dst := &cmn.ObjAttrs{Size: 10, Cksum: cos.NewCksum(cos.ChecksumXXHash, "abc"), CustomMD: cos.StrKVs{cmn.MD5ObjMD: "0123"}}
+	diff, verified := bdiffCompare(src, dst)
+	tassert.Errorf(t, diff == "" && verified, "expected verified match, got %q (%t)", diff, verified)
+}
+
+func TestBckDiffShare(t *testing.T) {
+	s3 := meta.NewBck("abc", apc.AWS, cmn.NsGlobal)
+	r := &XactBckDiff{}
+	r.shares[1] = &bdiffShare{bck: s3, objs: make(map[string]*cmn.ObjAttrs)}
+	r.shares[1].add(&cmn.LsoEntry{Name: "a", Size: 1})
+
+	oa, err := r.attrs(1, "a")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, oa.Size == 1, "expected size 1, got %d", oa.Size)
+	_, err = r.attrs(1, "b")
+	tassert.Errorf(t, cos.IsNotExist(err, 0), "expected not-found, got %v", err)
+}
@@ -10,26 +10,41 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
+// Resumable downloads:
+// - each chunk reader retries independently, with exponential backoff and per-chunk timeout;
+// - chunks are written sequentially, and so the completed (and resumable) part of the
+//   workfile is always [0, woff);
+// - every so often (blobCkptSize) the workfile gets fsync-ed and the offset - along with
+//   the remote object's size, version, and ETag - saved in a checkpoint file;
+// - when download fails or gets aborted, the workfile and the checkpoint remain in place,
+//   so that the next download of the same (unchanged) remote object resumes from there;
+// - both are stored as a separate content type (fs.BlobDlType) - space cleanup removes
+//   them only when abandoned (not updated for fs.BlobDlExpiry);
+// - prior to finalizing, the content is verified against the remote checksum (if any).
+
 // TODO:
-// 1. load, latest-ver, checksum, write, finalize
-// 2. track each chunk reader with 'started' timestamp; abort/retry individual chunks; timeout
-// 3. tune-up: (chunk size, slab size, full size) vs memory pressure
+// 1. tune-up: (chunk size, slab size, full size) vs memory pressure
 
 // default tunables (can override via apc.BlobMsg)
 const (
@@ -40,18 +55,28 @@ const (
 
 	maxInitialSizeSGL = 128           // vec length
 	maxTotalChunks    = 128 * cos.MiB // max mem per blob downloader
+
+	blobChunkTimeout = 2 * time.Minute // to GET a single chunk
+	blobChunkRetries = 5               // max retries per chunk
+	blobRetryBackoff = time.Second     // initial; doubles with every retry
+	blobCkptSize     = 256 * cos.MiB   // fsync and checkpoint every so often
 )
 
 type (
 	blobArgs struct {
 		lom        *core.LOM
-		expCksum   *cos.Cksum
-		lmfh       *os.File
-		wfqn       string
+		expCksum   *cos.Cksum // remote checksum to verify the content against
 		chunkSize  int64
 		fullSize   int64
 		numReaders int
 	}
+	// resume state (see "Resumable downloads" above)
+	blobCkpt struct {
+		Version string `json:"version,omitempty"`
+		ETag    string `json:"etag,omitempty"`
+		Size    int64  `json:"size"`
+		Woff    int64  `json:"woff"`
+	}
 	blobReader struct {
 		parent *XactBlobDl
 	}
@@ -71,16 +96,21 @@ type (
 	}
 	XactBlobDl struct {
 		writer   io.Writer
+		lmfh     *os.File
 		p        *blobFactory
 		readers  []*blobReader
 		workCh   chan blobWork
 		doneCh   chan blobDone
+		wfqn     string // workfile
+		cfqn     string // checkpoint
 		nextRoff int64
 		woff     int64
+		ckptOff  int64 // last checkpointed woff
 		xact.Base
-		sgls  []*memsys.SGL
-		cksum cos.CksumHash
-		wg    sync.WaitGroup
+		sgls   []*memsys.SGL
+		cksum  cos.CksumHash // to store with LOM
+		vcksum cos.CksumHash // to verify (when the remote checksum type differs)
+		wg     sync.WaitGroup
 	}
 )
 
@@ -90,11 +120,9 @@ var (
 	_ xreg.Renewable = (*blobFactory)(nil)
 )
 
-func RenewBlobDl(uuid string, lom *core.LOM, oa *cmn.ObjAttrs, wfqn string, lmfh *os.File, msg *apc.BlobMsg) xreg.RenewRes {
+func RenewBlobDl(uuid string, lom *core.LOM, oa *cmn.ObjAttrs, msg *apc.BlobMsg) xreg.RenewRes {
 	args := &blobArgs{
 		lom:        lom,
-		lmfh:       lmfh,
-		wfqn:       wfqn,
 		chunkSize:  msg.ChunkSize,
 		numReaders: msg.NumWorkers,
 	}
@@ -113,7 +141,7 @@ func RenewBlobDl(uuid string, lom *core.LOM, oa *cmn.ObjAttrs, wfqn string, lmfh
 	lom.SetAtimeUnix(oa.Atime)
 	// and separately:
 	args.fullSize = oa.Size
-	args.expCksum = oa.Cksum
+	args.expCksum = blobExpCksum(oa)
 
 	if msg.FullSize > 0 && msg.FullSize != args.fullSize {
 		name := xact.Cname(apc.ActBlobDl, uuid) + "/" + lom.Cname()
@@ -146,6 +174,28 @@ func RenewBlobDl(uuid string, lom *core.LOM, oa *cmn.ObjAttrs, wfqn string, lmfh
 	return xreg.RenewBucketXact(apc.ActBlobDl, lom.Bck(), xreg.Args{UUID: uuid, Custom: args})
 }
 
+// AIS checksum (when the object was written via AIS) or MD5 (e.g., single-part S3 ETag)
+func blobExpCksum(oa *cmn.ObjAttrs) *cos.Cksum {
+	cksum := oa.Cksum
+	if cksum.IsEmpty() { // (nil or none)
+		v, ok := oa.GetCustomKey(cmn.MD5ObjMD)
+		if !ok || v == "" {
+			return nil
+		}
+		cksum = cos.NewCksum(cos.ChecksumMD5, v)
+	}
+	if cos.ValidateCksumType(cksum.Ty()) != nil {
+		return nil
+	}
+	return cksum
+}
+
+// deterministic, to resume (see fs.BlobDlContentResolver)
+func blobWfqn(lom *core.LOM, prefix string) string {
+	dir, fname := filepath.Split(lom.ObjName)
+	return lom.Mountpath().MakePathFQN(lom.Bucket(), fs.BlobDlType, dir+prefix+"."+fname)
+}
+
 //
 // blobFactory
 //
@@ -211,11 +261,12 @@ func (p *blobFactory) Start() error {
 		r.sgls[i] = mm.NewSGL(cnt*slabSize, slabSize)
 	}
 
-	if ty := p.args.lom.CksumConf().Type; ty != cos.ChecksumNone {
+	ty := p.args.lom.CksumConf().Type
+	if ty != cos.ChecksumNone {
 		r.cksum.Init(ty)
-		r.writer = io.MultiWriter(p.args.lmfh, r.cksum.H)
-	} else {
-		r.writer = p.args.lmfh
+	}
+	if exp := p.args.expCksum; exp != nil && exp.Ty() != ty {
+		r.vcksum.Init(exp.Ty())
 	}
 	p.xctn = r
 	return nil
@@ -243,9 +294,19 @@ func (r *XactBlobDl) Run(*sync.WaitGroup) {
 		err     error
 		pending []blobDone
 		eof     bool
+		aborted bool
 	)
+	if err = r.open(); err != nil {
+		r.Abort(err)
+		r.cleanup()
+		r.Finish()
+		return
+	}
 	nlog.Infoln(r.Name()+": chunk-size", cos.ToSizeIEC(r.p.args.chunkSize, 0)+
 		", num-concurrent-readers", r.p.args.numReaders)
+	if r.woff >= r.p.args.fullSize {
+		goto fin // (checkpointed as fully downloaded)
+	}
 	r.start()
 outer:
 	for {
@@ -319,31 +380,27 @@ outer:
 				}
 			}
 		case <-r.ChanAbort():
+			aborted = true
 			goto fin
 		}
 	}
 fin:
 	close(r.workCh)
-	if err == nil && cmn.Rom.Features().IsSet(feat.FsyncPUT) {
-		err = r.p.args.lmfh.Sync()
-	}
-	cos.Close(r.p.args.lmfh)
-
-	if err == nil {
-		debug.Assert(r.p.args.fullSize == r.woff)
-		r.p.args.lom.SetSize(r.woff)
-		if r.cksum.H != nil {
-			r.cksum.Finalize()
-			r.p.args.lom.SetCksum(r.cksum.Clone())
+	if err == nil && !aborted {
+		if err = r.finalize(); err == nil {
+			r.ObjsAdd(1, 0)
+			if errRemove := cos.RemoveFile(r.cfqn); errRemove != nil {
+				nlog.Errorln("nested err:", errRemove)
+			}
+		} else {
+			r.discard()
 		}
-		_, err = core.T.FinalizeObj(r.p.args.lom, r.p.args.wfqn, r, cmn.OwtGetPrefetchLock)
-	}
-	if err == nil {
-		r.ObjsAdd(1, 0)
 	} else {
-		if errRemove := cos.RemoveFile(r.p.args.wfqn); errRemove != nil && !os.IsNotExist(errRemove) {
-			nlog.Errorln("nested err:", errRemove)
-		}
+		// keep the workfile to resume from the last written offset
+		r.checkpoint()
+		cos.Close(r.lmfh)
+	}
+	if err != nil {
 		r.Abort(err)
 	}
 
@@ -353,12 +410,165 @@ fin:
 	r.Finish()
 }
 
+// open the workfile and, if possible, resume from the checkpointed offset
+func (r *XactBlobDl) open() (err error) {
+	var (
+		ckpt blobCkpt
+		woff int64
+		lom  = r.p.args.lom
+	)
+	r.wfqn, r.cfqn = blobWfqn(lom, fs.WorkfileBlobDl), blobWfqn(lom, fs.WorkfileBlobCkpt)
+	if _, errL := jsp.Load(r.cfqn, &ckpt, jsp.Plain()); errL == nil && r.resumable(&ckpt) {
+		if err = r.resume(ckpt.Woff); err == nil {
+			woff = ckpt.Woff
+		} else {
+			nlog.Warningln(r.Name(), "cannot resume at offset", ckpt.Woff, "- starting over, err:", err)
+			cos.Close(r.lmfh)
+			if r.cksum.H != nil {
+				r.cksum.H.Reset()
+			}
+			if r.vcksum.H != nil {
+				r.vcksum.H.Reset()
+			}
+		}
+	}
+	if woff == 0 {
+		if errRemove := cos.RemoveFile(r.cfqn); errRemove != nil {
+			nlog.Errorln("nested err:", errRemove)
+		}
+		if r.lmfh, err = lom.CreateFile(r.wfqn); err != nil {
+			return err
+		}
+	} else {
+		nlog.Infoln(r.Name(), "resuming at offset", woff, "of", r.p.args.fullSize)
+		r.ObjsAdd(0, woff)
+	}
+	r.woff, r.nextRoff, r.ckptOff = woff, woff, woff
+
+	w := []io.Writer{r.lmfh}
+	if r.cksum.H != nil {
+		w = append(w, r.cksum.H)
+	}
+	if r.vcksum.H != nil {
+		w = append(w, r.vcksum.H)
+	}
+	r.writer = io.MultiWriter(w...)
+	return nil
+}
+
+// same remote object (and not downloaded from scratch)
+func (r *XactBlobDl) resumable(ckpt *blobCkpt) bool {
+	lom := r.p.args.lom
+	etag, _ := lom.GetCustomKey(cmn.ETag)
+	return ckpt.Woff > 0 && ckpt.Woff <= r.p.args.fullSize && ckpt.Size == r.p.args.fullSize &&
+		ckpt.Version == lom.Version() && ckpt.ETag == etag
+}
+
+// open existing workfile, truncate it to the checkpointed offset,
+// and compute checksum(s) of the already downloaded content
+func (r *XactBlobDl) resume(woff int64) (err error) {
+	if r.lmfh, err = os.OpenFile(r.wfqn, os.O_RDWR, cos.PermRWR); err != nil {
+		return err
+	}
+	finfo, err := r.lmfh.Stat()
+	if err != nil {
+		return err
+	}
+	if finfo.Size() < woff {
+		return fmt.Errorf("workfile size %d is smaller than checkpointed offset %d", finfo.Size(), woff)
+	}
+	if err = r.lmfh.Truncate(woff); err != nil {
+		return err
+	}
+	var w []io.Writer
+	if r.cksum.H != nil {
+		w = append(w, r.cksum.H)
+	}
+	if r.vcksum.H != nil {
+		w = append(w, r.vcksum.H)
+	}
+	if len(w) > 0 {
+		buf, slab := core.T.PageMM().AllocSize(memsys.MaxPageSlabSize)
+		_, err = io.CopyBuffer(io.MultiWriter(w...), io.NewSectionReader(r.lmfh, 0, woff), buf)
+		slab.Free(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = r.lmfh.Seek(woff, io.SeekStart)
+	return err
+}
+
+// fsync the workfile and save the resume state
+func (r *XactBlobDl) checkpoint() {
+	if r.woff == r.ckptOff {
+		return
+	}
+	var (
+		lom     = r.p.args.lom
+		etag, _ = lom.GetCustomKey(cmn.ETag)
+		ckpt    = blobCkpt{Version: lom.Version(), ETag: etag, Size: r.p.args.fullSize, Woff: r.woff}
+	)
+	err := r.lmfh.Sync()
+	if err == nil {
+		err = jsp.Save(r.cfqn, &ckpt, jsp.Plain(), nil)
+	}
+	if err != nil {
+		nlog.Errorln(r.Name(), "failed to checkpoint at offset", r.woff, "err:", err)
+		return
+	}
+	r.ckptOff = r.woff
+}
+
+func (r *XactBlobDl) finalize() (err error) {
+	lom := r.p.args.lom
+	if cmn.Rom.Features().IsSet(feat.FsyncPUT) {
+		err = r.lmfh.Sync()
+	}
+	cos.Close(r.lmfh)
+	if err != nil {
+		return err
+	}
+	debug.Assert(r.p.args.fullSize == r.woff)
+	lom.SetSize(r.woff)
+	if r.cksum.H != nil {
+		r.cksum.Finalize()
+		lom.SetCksum(r.cksum.Clone())
+	}
+
+	// end-to-end: verify against the remote checksum
+	if exp := r.p.args.expCksum; exp != nil {
+		computed := r.cksum.Clone()
+		if r.vcksum.H != nil {
+			r.vcksum.Finalize()
+			computed = r.vcksum.Clone()
+		}
+		if !exp.Equal(computed) {
+			return cos.NewErrDataCksum(exp, computed, lom.Cname())
+		}
+	}
+	_, err = core.T.FinalizeObj(lom, r.wfqn, r, cmn.OwtGetPrefetchLock)
+	return err
+}
+
+// remove the workfile and the checkpoint (nothing to resume)
+func (r *XactBlobDl) discard() {
+	for _, fqn := range []string{r.wfqn, r.cfqn} {
+		if errRemove := cos.RemoveFile(fqn); errRemove != nil {
+			nlog.Errorln("nested err:", errRemove)
+		}
+	}
+}
+
 func (r *XactBlobDl) start() {
 	r.wg.Add(len(r.readers))
 	for i := range r.readers {
 		go r.readers[i].run()
 	}
 	for i := range r.readers {
+		if r.nextRoff >= r.p.args.fullSize {
+			break // (resuming)
+		}
 		r.workCh <- blobWork{r.sgls[i], r.nextRoff}
 		r.nextRoff += r.p.args.chunkSize
 	}
@@ -379,6 +589,9 @@ func (r *XactBlobDl) write(sgl *memsys.SGL) error {
 	r.woff += size
 	r.ObjsAdd(0, size)
 	sgl.Reset()
+	if r.woff-r.ckptOff >= blobCkptSize && r.woff < r.p.args.fullSize {
+		r.checkpoint()
+	}
 	return nil
 }
 
@@ -396,38 +609,80 @@ func (r *XactBlobDl) cleanup() {
 //
 
 func (reader *blobReader) run() {
-	var (
-		err     error
-		written int64
-		a       = reader.parent.p.args
-		ctx     = context.Background()
-	)
 	for {
 		msg, ok := <-reader.parent.workCh
 		if !ok {
 			break
 		}
 		sgl := msg.sgl
-		res := core.T.Backend(a.lom.Bck()).GetObjReader(ctx, a.lom, msg.roff, a.chunkSize)
+		err := reader.read(sgl, msg.roff)
 		if reader.parent.IsAborted() {
 			break
 		}
-		if err = res.Err; err == nil {
-			written, err = io.Copy(sgl, res.R)
-		}
+		reader.parent.doneCh <- blobDone{err, sgl, msg.roff}
 		if err != nil {
-			reader.parent.doneCh <- blobDone{err, sgl, msg.roff}
 			break
 		}
-		debug.Assert(res.Size == written, res.Size, " ", written)
-		debug.Assert(sgl.Size() == written, sgl.Size(), " ", written)
-		debug.Assert(sgl.Size() == sgl.Len(), sgl.Size(), " ", sgl.Len())
-
-		reader.parent.doneCh <- blobDone{nil, sgl, msg.roff}
 	}
 	reader.parent.wg.Done()
 }
 
+// read chunk; retry with exponential backoff
+func (reader *blobReader) read(sgl *memsys.SGL, roff int64) (err error) {
+	var (
+		r     = reader.parent
+		sleep = blobRetryBackoff
+	)
+	for retry := 0; ; retry++ {
+		var errCode int
+		errCode, err = reader.get(sgl, roff)
+		if err == nil || retry == blobChunkRetries || !blobRetriable(errCode) {
+			return err
+		}
+		nlog.Warningln(r.Name(), "failed to read chunk at offset", roff, "err:", err, "- retrying in", sleep)
+		select {
+		case <-r.ChanAbort():
+			return err
+		case <-time.After(sleep):
+		}
+		sleep *= 2
+	}
+}
+
+func (reader *blobReader) get(sgl *memsys.SGL, roff int64) (int, error) {
+	var (
+		a    = reader.parent.p.args
+		size = min(a.chunkSize, a.fullSize-roff)
+	)
+	sgl.Reset()
+	ctx, cancel := context.WithTimeout(context.Background(), blobChunkTimeout)
+	defer cancel()
+	res := core.T.Backend(a.lom.Bck()).GetObjReader(ctx, a.lom, roff, size)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	written, err := io.Copy(sgl, res.R)
+	cos.Close(res.R)
+	if err != nil {
+		return 0, err
+	}
+	if written != size {
+		return 0, fmt.Errorf("%s: chunk at offset %d: expected %d bytes, got %d: %w",
+			reader.parent.Name(), roff, size, written, io.ErrUnexpectedEOF)
+	}
+	debug.Assert(sgl.Size() == sgl.Len(), sgl.Size(), " ", sgl.Len())
+	return 0, nil
+}
+
+func blobRetriable(errCode int) bool {
+	switch errCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound,
+		http.StatusMethodNotAllowed, http.StatusPreconditionFailed, http.StatusRequestedRangeNotSatisfiable:
+		return false
+	}
+	return true
+}
+
 func (r *XactBlobDl) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
@@ -0,0 +1,234 @@
+// Package xs - blob downloader (resume, retry, and checksum) unit tests.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/cryptorand"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const blobTestChunk = memsys.MaxPageSlabSize
+
+type (
+	// remote object; fails GETs at the given offsets as many times as specified
+	blobBackend struct {
+		core.BackendProvider
+		fails  map[int64]int // offset => number of times to fail
+		status int           // failure status
+		data   []byte
+		offs   []int64 // requested offsets
+		mu     sync.Mutex
+	}
+	// target that uses the backend (above) and finalizes by renaming the workfile
+	blobTarget struct {
+		*mock.TargetMock
+		bp *blobBackend
+		mm *memsys.MMSA
+	}
+)
+
+func (bp *blobBackend) GetObjReader(_ context.Context, _ *core.LOM, off, length int64) core.GetReaderResult {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.offs = append(bp.offs, off)
+	if bp.fails[off] > 0 {
+		bp.fails[off]--
+		return core.GetReaderResult{Err: errors.New("injected failure"), ErrCode: bp.status}
+	}
+	return core.GetReaderResult{R: io.NopCloser(bytes.NewReader(bp.data[off : off+length])), Size: length}
+}
+
+func (t *blobTarget) Backend(*meta.Bck) core.BackendProvider { return t.bp }
+func (t *blobTarget) PageMM() *memsys.MMSA                   { return t.mm }
+
+func (*blobTarget) FinalizeObj(lom *core.LOM, wfqn string, _ core.Xact, _ cmn.OWT) (int, error) {
+	if err := cos.CreateDir(filepath.Dir(lom.FQN)); err != nil {
+		return 0, err
+	}
+	return 0, os.Rename(wfqn, lom.FQN)
+}
+
+func _blobInit(t *testing.T, size int) (*blobTarget, *meta.Bck) {
+	mpath := t.TempDir()
+	fs.TestNew(nil)
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.BlobDlType, &fs.BlobDlContentResolver{}, true)
+
+	bck := meta.NewBck("blob", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+	errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tassert.Fatalf(t, len(errs) == 0, "%v", errs)
+	bp := &blobBackend{data: make([]byte, size), fails: make(map[int64]int), status: http.StatusServiceUnavailable}
+	_, _ = cryptorand.Read(bp.data)
+
+	mm, err := memsys.NewMMSA("blob.test", true)
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { mm.Terminate(false) })
+
+	tgt := &blobTarget{TargetMock: &mock.TargetMock{BO: mock.NewBaseBownerMock(bck)}, bp: bp, mm: mm}
+	core.Tinit(tgt, mock.NewStatsTracker(), false)
+	return tgt, bck
+}
+
+// run blob download synchronously
+func _blobRun(t *testing.T, bck *meta.Bck, expCksum *cos.Cksum) (*XactBlobDl, *core.LOM) {
+	lom := core.AllocLOM("dir/obj")
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	lom.SetVersion("1")
+	fqn := lom.FQN
+
+	bp := core.T.Backend(bck).(*blobBackend)
+	p := &blobFactory{
+		RenewBase: xreg.RenewBase{Args: xreg.Args{UUID: cos.GenUUID()}, Bck: bck},
+		args: &blobArgs{
+			lom:        lom,
+			expCksum:   expCksum,
+			chunkSize:  blobTestChunk,
+			fullSize:   int64(len(bp.data)),
+			numReaders: 2,
+		},
+	}
+	tassert.CheckFatal(t, p.Start())
+	r := p.xctn
+	r.Run(nil)
+
+	// (LOM is freed upon completion)
+	lom = core.AllocLOM("dir/obj")
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	tassert.Fatalf(t, lom.FQN == fqn, "FQN mismatch")
+	return r, lom
+}
+
+func _blobCheck(t *testing.T, lom *core.LOM, data []byte) {
+	b, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "content mismatch (%d vs %d)", len(b), len(data))
+	for _, prefix := range []string{fs.WorkfileBlobDl, fs.WorkfileBlobCkpt} {
+		fqn := blobWfqn(lom, prefix)
+		_, err := os.Stat(fqn)
+		tassert.Errorf(t, os.IsNotExist(err), "%s must be removed upon completion", fqn)
+	}
+}
+
+func TestBlobDlResume(t *testing.T) {
+	const numChunks = 8
+	tgt, bck := _blobInit(t, numChunks*blobTestChunk+13)
+	bp := tgt.bp
+
+	// 1. fail (non-retriable) in the middle
+	bp.fails[5*blobTestChunk], bp.status = 1, http.StatusNotFound
+	r, lom := _blobRun(t, bck, nil)
+	tassert.Fatalf(t, r.IsAborted(), "expected download to fail")
+
+	var ckpt blobCkpt
+	_, err := os.Stat(blobWfqn(lom, fs.WorkfileBlobDl))
+	tassert.CheckFatal(t, err)
+	_, err = jsp.Load(blobWfqn(lom, fs.WorkfileBlobCkpt), &ckpt, jsp.Plain())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, ckpt.Woff > 0 && ckpt.Woff <= 5*blobTestChunk && ckpt.Woff%blobTestChunk == 0,
+		"unexpected checkpointed offset %d", ckpt.Woff)
+	core.FreeLOM(lom)
+
+	// 2. resume: must not re-read what's been checkpointed
+	bp.offs = bp.offs[:0]
+	r, lom = _blobRun(t, bck, nil)
+	tassert.CheckFatal(t, r.Err())
+	for _, off := range bp.offs {
+		tassert.Errorf(t, off >= ckpt.Woff, "re-read chunk at offset %d (checkpointed %d)", off, ckpt.Woff)
+	}
+	_blobCheck(t, lom, bp.data)
+	core.FreeLOM(lom)
+}
+
+func TestBlobDlResumeChangedObject(t *testing.T) {
+	tgt, bck := _blobInit(t, 4*blobTestChunk)
+	bp := tgt.bp
+
+	bp.fails[2*blobTestChunk], bp.status = 1, http.StatusNotFound
+	r, lom := _blobRun(t, bck, nil)
+	tassert.Fatalf(t, r.IsAborted(), "expected download to fail")
+
+	// remote object changed in the meantime: the checkpoint must be ignored
+	var ckpt blobCkpt
+	cfqn := blobWfqn(lom, fs.WorkfileBlobCkpt)
+	_, err := jsp.Load(cfqn, &ckpt, jsp.Plain())
+	tassert.CheckFatal(t, err)
+	ckpt.Version = "0"
+	tassert.CheckFatal(t, jsp.Save(cfqn, &ckpt, jsp.Plain(), nil))
+	_, _ = cryptorand.Read(bp.data)
+	core.FreeLOM(lom)
+
+	bp.offs = bp.offs[:0]
+	r, lom = _blobRun(t, bck, nil)
+	tassert.CheckFatal(t, r.Err())
+	tassert.Errorf(t, len(bp.offs) > 0 && bp.offs[0] == 0, "expected to start over, got %v", bp.offs)
+	_blobCheck(t, lom, bp.data)
+	core.FreeLOM(lom)
+}
+
+func TestBlobDlRetry(t *testing.T) {
+	tgt, bck := _blobInit(t, 3*blobTestChunk+1)
+	bp := tgt.bp
+
+	// retriable: fail the second chunk twice
+	bp.fails[blobTestChunk] = 2
+	r, lom := _blobRun(t, bck, nil)
+	tassert.CheckFatal(t, r.Err())
+	var n int
+	for _, off := range bp.offs {
+		if off == blobTestChunk {
+			n++
+		}
+	}
+	tassert.Errorf(t, n == 3, "expected 3 attempts to read chunk at offset %d, got %d", blobTestChunk, n)
+	_blobCheck(t, lom, bp.data)
+	core.FreeLOM(lom)
+}
+
+func TestBlobDlChecksum(t *testing.T) {
+	tgt, bck := _blobInit(t, 2*blobTestChunk+7)
+	bp := tgt.bp
+
+	// 1. mismatch: must fail and discard (nothing to resume)
+	bad := cos.NewCksum(cos.ChecksumMD5, "00000000000000000000000000000000")
+	r, lom := _blobRun(t, bck, bad)
+	tassert.Fatalf(t, r.IsAborted(), "expected checksum mismatch")
+	tassert.Errorf(t, cos.IsErrBadCksum(r.AbortErr()), "expected bad checksum, got %v", r.AbortErr())
+	for _, prefix := range []string{fs.WorkfileBlobDl, fs.WorkfileBlobCkpt} {
+		_, err := os.Stat(blobWfqn(lom, prefix))
+		tassert.Errorf(t, os.IsNotExist(err), "%s: expected to be discarded", prefix)
+	}
+	core.FreeLOM(lom)
+
+	// 2. match (different checksum type - computed separately)
+	cksum, err := cos.ChecksumBytes(bp.data, cos.ChecksumMD5)
+	tassert.CheckFatal(t, err)
+	r, lom = _blobRun(t, bck, cksum)
+	tassert.CheckFatal(t, r.Err())
+	_blobCheck(t, lom, bp.data)
+	core.FreeLOM(lom)
+}
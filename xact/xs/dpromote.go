@@ -6,16 +6,22 @@
 package xs
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/prmagent"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/xact"
@@ -23,6 +29,7 @@ import (
 )
 
 // promotes (i.e., copies) locally accessible (within the cluster) directory => bucket
+// or, alternatively, files and directories streamed by remote promote agents (see ext/prmagent)
 
 type (
 	proFactory struct {
@@ -44,6 +51,12 @@ var (
 	_ xreg.Renewable = (*proFactory)(nil)
 )
 
+// clients to talk to promote agents (no timeout: streaming arbitrary sizes)
+var prmClients struct {
+	h, tls *prmagent.Client
+	once   sync.Once
+}
+
 ////////////////
 // proFactory //
 ////////////////
@@ -77,6 +90,12 @@ func (r *XactDirPromote) SetFshare(v bool) { r.confirmedFshare = v } // is calle
 func (r *XactDirPromote) Run(wg *sync.WaitGroup) {
 	wg.Done()
 
+	if len(r.p.args.Sources) > 0 {
+		r.runRemote()
+		r.Finish()
+		return
+	}
+
 	dir := r.p.args.SrcFQN
 	nlog.Infof("%s(%s)", r.Name(), dir)
 
@@ -142,6 +161,143 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 	return err
 }
 
+//
+// remote sources
+//
+
+func (r *XactDirPromote) runRemote() {
+	var (
+		args = r.p.args
+		ctx  = context.Background()
+		bck  = r.Bck()
+	)
+	r.smap = core.T.Sowner().Get()
+	hrw := &prmagent.HrwArgs{
+		TID:    core.T.SID(),
+		TIDs:   make([]string, 0, len(r.smap.Tmap)),
+		Ubase:  bck.MakeUname(""),
+		Prefix: args.ObjName,
+	}
+	for tid, tsi := range r.smap.Tmap {
+		if !tsi.InMaintOrDecomm() { // (compare with meta.Smap.HrwHash2T)
+			hrw.TIDs = append(hrw.TIDs, tid)
+		}
+	}
+	for i := range args.Sources {
+		src := &args.Sources[i]
+		client := prmClient(src.Agent)
+		nlog.Infof("%s(%s:%s)", r.Name(), src.Agent, src.Path)
+		largs := &prmagent.ListArgs{Path: src.Path, Recursive: args.Recursive, DeleteSrc: args.DeleteSrc, Hrw: hrw}
+		res, err := client.List(ctx, src.Agent, largs)
+		if err != nil {
+			r.AddErr(err, 0)
+			continue
+		}
+		var dir string
+		if res.IsDir {
+			dir = src.Path
+		}
+		for j := range res.Entries {
+			if r.IsAborted() {
+				return
+			}
+			e := &res.Entries[j]
+			err := r.promoteRemote(ctx, client, src, dir, e)
+			switch {
+			case err == nil:
+			case cos.IsErrOOS(err):
+				r.Abort(err)
+				return
+			default:
+				r.AddErr(fmt.Errorf("%s:%s: %w", src.Agent, e.FQN, err), 5, cos.SmoduleXs)
+			}
+		}
+	}
+}
+
+// NOTE: the agent lists only the files that "land" locally (see prmagent.HrwArgs)
+func (r *XactDirPromote) promoteRemote(ctx context.Context, client *prmagent.Client, src *apc.PromoteSrc, dir string,
+	e *prmagent.Entry) error {
+	var (
+		args = r.p.args
+		bck  = r.Bck()
+	)
+	objName, err := PrmObjName(e.FQN, dir, args.ObjName)
+	if err != nil {
+		return err
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		if si, err := r.smap.HrwName2T(bck.MakeUname(objName)); err == nil && si.ID() != core.T.SID() {
+			nlog.Warningln(r.Name(), "unexpected", src.Agent+":"+e.FQN, "=>", si.StringEx()) // (Smap changed)
+		}
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	if !args.OverwriteDst && lom.Load(false /*cache it*/, false /*locked*/) == nil {
+		return nil
+	}
+	reader, size, err := client.Open(ctx, src.Agent, e.FQN)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return nil // removed in the meantime
+		}
+		return err
+	}
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfilePut
+		params.Reader = reader
+		params.Atime = time.Now()
+		params.Size = size
+		params.OWT = cmn.OwtPut // (not counting as "in-objects" - see OwtPromote)
+		params.Xact = r
+	}
+	err = core.T.PutObject(lom, params) // (computes checksum according to the bucket's props)
+	core.FreePutParams(params)
+	if err != nil {
+		return err
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+	if args.DeleteSrc {
+		if errRm := client.Remove(ctx, src.Agent, e.FQN); errRm != nil {
+			nlog.Errorf("%s: failed to remove promoted source %s:%s: %v", r.Name(), src.Agent, e.FQN, errRm)
+		}
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Infof("%s: %s:%s => %s (over=%t, del=%t)", r.Base.Name(), src.Agent, e.FQN, bck.Cname(objName),
+			args.OverwriteDst, args.DeleteSrc)
+	}
+	return nil
+}
+
+// (begin phase) make sure promote agents are reachable and sources exist - without listing them
+// (listing is done by each target in the commit phase, for its own share)
+func PrmStatRemote(args *apc.PromoteArgs) error {
+	for i := range args.Sources {
+		src := &args.Sources[i]
+		if _, err := prmClient(src.Agent).List(context.Background(), src.Agent, &prmagent.ListArgs{Path: src.Path, Stat: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func prmClient(agent string) *prmagent.Client {
+	prmClients.once.Do(func() {
+		h, tls := cmn.NewDefaultClients(0)
+		secret := os.Getenv(env.AIS.PrmSecret)
+		prmClients.h = &prmagent.Client{HTTP: h, Secret: secret}
+		prmClients.tls = &prmagent.Client{HTTP: tls, Secret: secret}
+	})
+	if cos.IsHTTPS(agent) {
+		return prmClients.tls
+	}
+	return prmClients.h
+}
+
 func (r *XactDirPromote) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
@@ -154,19 +310,7 @@ func (r *XactDirPromote) Snap() (snap *core.Snap) {
 // destination naming
 //
 
-func PrmObjName(objfqn, dirfqn, prefix string) (_ string, err error) {
-	var baseName string
-	if dirfqn == "" {
-		if prefix != "" && !cos.IsLastB(prefix, filepath.Separator) {
-			return prefix, nil
-		}
-		baseName = filepath.Base(objfqn)
-	} else {
-		baseName, err = filepath.Rel(dirfqn, objfqn)
-		if err != nil {
-			debug.Assert(false, err, dirfqn, objfqn)
-			return "", err
-		}
-	}
-	return prefix + baseName, nil
+// (promote agents use the same naming to list only the files that "land" on a given target)
+func PrmObjName(objfqn, dirfqn, prefix string) (string, error) {
+	return prmagent.ObjName(objfqn, dirfqn, prefix)
 }
@@ -30,6 +30,7 @@ func Xreg(xeleOnly bool) {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&tierFactory{})
 
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
@@ -40,4 +41,6 @@ func Xreg(xeleOnly bool) {
 	xreg.RegBckXact(&lsoFactory{streamingF: streamingF{kind: apc.ActList}})
 
 	xreg.RegBckXact(&blobFactory{})
+
+	xreg.RegBckXact(&bdiffFactory{})
 }
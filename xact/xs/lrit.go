@@ -7,6 +7,7 @@ package xs
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -15,6 +16,8 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -41,7 +44,10 @@ type (
 	// lriterator needs for itself
 	lrxact interface {
 		IsAborted() bool
+		AbortErr() error
 		Finished() bool
+		IsPaused() bool
+		WaitResumed() error
 	}
 	// common multi-obj operation context and iterList()/iterRangeOrPref() logic
 	lriterator struct {
@@ -51,9 +57,19 @@ type (
 		pt     *cos.ParsedTemplate
 		prefix string
 		lrp    int // { lrpList, ... } enum
+		cp     struct {
+			mi   *fs.Mountpath
+			tag  string
+			from string // resume after (see resumable below)
+			last string // last processed
+			safe string // last persisted
+			num  int64
+		}
 	}
 )
 
+const lrpCheckpointNum = 256 // unit of checkpointing (resumable only)
+
 // concrete list-range type xactions (see also: archive.go)
 type (
 	TestXFactory struct{ prfFactory } // tests only
@@ -110,7 +126,38 @@ pref:
 	return nil
 }
 
+// make it resumable: checkpoint the last processed name (on a single, HRW-selected,
+// mountpath) so that the next run of the same job skips everything up to and including it
+func (r *lriterator) resumable(kind string, extra ...string) {
+	var (
+		msg   = r.msg
+		parts = append([]string{r.bck.MakeUname(""), msg.Template}, extra...)
+	)
+	if msg.IsList() {
+		parts = append(parts, strings.Join(msg.ObjNames, ","))
+	}
+	r.cp.tag = xact.CheckpointTag(kind, parts...)
+}
+
+func (r *lriterator) loadCP() {
+	mi, _, err := fs.Hrw(r.cp.tag)
+	if err != nil {
+		nlog.Errorln(err)
+		r.cp.tag = ""
+		return
+	}
+	r.cp.mi = mi
+	r.cp.from = mi.LoadCheckpoint(r.cp.tag)
+	r.cp.safe = r.cp.from
+	if r.cp.from != "" && r.msg.IsList() && !cos.StringInSlice(r.cp.from, r.msg.ObjNames) {
+		r.cp.from = "" // (unlikely)
+	}
+}
+
 func (r *lriterator) run(wi lrwi, smap *meta.Smap) (err error) {
+	if r.cp.tag != "" {
+		r.loadCP()
+	}
 	switch r.lrp {
 	case lrpList:
 		err = r._list(wi, smap)
@@ -119,10 +166,69 @@ func (r *lriterator) run(wi lrwi, smap *meta.Smap) (err error) {
 	case lrpPrefix:
 		err = r._prefix(wi, smap)
 	}
+	if r.cp.tag == "" {
+		return err
+	}
+	if r.parent.IsAborted() && r.parent.AbortErr() != cmn.ErrXactUserAbort {
+		// interrupted (e.g., node restart): resume next time
+		r.checkpoint()
+		return err
+	}
+	// done (nothing to resume), failed, or user-aborted: start over next time
+	if errV := r.cp.mi.RemoveCheckpoint(r.cp.tag); errV != nil {
+		nlog.Errorln(errV)
+	}
 	return err
 }
 
-func (r *lriterator) done() bool { return r.parent.IsAborted() || r.parent.Finished() }
+// is called prior to processing the next object, which makes it the place to pause
+func (r *lriterator) done() bool {
+	if r.parent.IsPaused() {
+		r.checkpoint()
+		if err := r.parent.WaitResumed(); err != nil {
+			return true
+		}
+	}
+	return r.parent.IsAborted() || r.parent.Finished()
+}
+
+// returns true if the object was processed prior to checkpointing (and must be skipped now)
+func (r *lriterator) skip(objName string) bool {
+	if r.cp.from == "" {
+		return false
+	}
+	if r.lrp == lrpPrefix {
+		if objName <= r.cp.from { // (listed in lexicographical order)
+			return true
+		}
+	} else if objName != r.cp.from {
+		return true
+	}
+	r.cp.from = ""
+	return r.lrp != lrpPrefix
+}
+
+func (r *lriterator) processed(objName string) {
+	if r.cp.tag == "" {
+		return
+	}
+	r.cp.last = objName
+	r.cp.num++
+	if r.cp.num%lrpCheckpointNum == 0 {
+		r.checkpoint()
+	}
+}
+
+func (r *lriterator) checkpoint() {
+	if r.cp.tag == "" || r.cp.last == "" || r.cp.last == r.cp.safe {
+		return
+	}
+	if err := r.cp.mi.PersistCheckpoint(r.cp.tag, r.cp.last); err != nil {
+		nlog.Errorln("failed to checkpoint", r.cp.tag, "err:", err)
+		return
+	}
+	r.cp.safe = r.cp.last
+}
 
 func (r *lriterator) _list(wi lrwi, smap *meta.Smap) error {
 	r.lrp = lrpList
@@ -130,12 +236,16 @@ func (r *lriterator) _list(wi lrwi, smap *meta.Smap) error {
 		if r.done() {
 			break
 		}
+		if r.skip(objName) {
+			continue
+		}
 		lom := core.AllocLOM(objName)
 		err := r.do(lom, wi, smap)
 		core.FreeLOM(lom)
 		if err != nil {
 			return err
 		}
+		r.processed(objName)
 	}
 	return nil
 }
@@ -146,12 +256,16 @@ func (r *lriterator) _range(wi lrwi, smap *meta.Smap) error {
 		if r.done() {
 			return nil
 		}
+		if r.skip(objName) {
+			continue
+		}
 		lom := core.AllocLOM(objName)
 		err := r.do(lom, wi, smap)
 		core.FreeLOM(lom)
 		if err != nil {
 			return err
 		}
+		r.processed(objName)
 	}
 	return nil
 }
@@ -202,6 +316,9 @@ func (r *lriterator) _prefix(wi lrwi, smap *meta.Smap) error {
 				freeLsoEntries(lst.Entries)
 				return nil
 			}
+			if r.skip(be.Name) {
+				continue
+			}
 			lom := core.AllocLOM(be.Name)
 			err := r.do(lom, wi, smap)
 			core.FreeLOM(lom)
@@ -209,6 +326,7 @@ func (r *lriterator) _prefix(wi lrwi, smap *meta.Smap) error {
 				freeLsoEntries(lst.Entries)
 				return err
 			}
+			r.processed(be.Name)
 		}
 		freeLsoEntries(lst.Entries)
 		// last page listed
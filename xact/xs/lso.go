@@ -59,6 +59,7 @@ type (
 			wor          bool               // wantOnlyRemote
 			dontPopulate bool               // when listing remote obj-s: don't include local MD (in re: LsDonAddRemote)
 			this         bool               // r.msg.SID == core.T.SID(): true when this target does remote paging
+			lc           *lsoCache          // when this target serves remote pages from its list cache
 		}
 		streamingX
 		lensgl int64
@@ -360,8 +361,16 @@ func (r *LsoXact) nextPageR() (err error) {
 
 	// TODO -- FIXME: not counting/sizing (locally) present objects that are missing (deleted?) remotely
 	if r.walk.this {
-		nentries := allocLsoEntries()
-		page, err = npg.nextPageR(nentries, !r.walk.dontPopulate)
+		// all pages of a given list-objects come either from the cache or from the backend
+		if r.msg.ContinuationToken == "" {
+			r.walk.lc = lsoCacheFresh(r.p.Bck, r.msg)
+		}
+		if r.walk.lc != nil {
+			page, err = npg.nextPageC(r.walk.lc, !r.walk.dontPopulate)
+		} else {
+			nentries := allocLsoEntries()
+			page, err = npg.nextPageR(nentries, !r.walk.dontPopulate)
+		}
 		if !r.walk.wor && !r.IsAborted() {
 			if err == nil {
 				// bcast page
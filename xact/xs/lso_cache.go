@@ -0,0 +1,650 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Persistent (target-side) cache of remote bucket listings (cmn.LsoCacheConf).
+//
+// * A single target - the bucket's "owner" (see LsoCacheOwner) - maintains the cache
+//   and, when the cache is enabled, does all remote paging for the bucket.
+// * The cache contains the entire bucket (all names and remote properties) - a sorted
+//   sequence of pages. It gets populated - and later refreshed - in the background, by
+//   listing the bucket page by page, whereby each remote page replaces the cached entries
+//   in its range of names (see splice) - the rest keeps being served in the meantime.
+// * When not older than the configured TTL, the cache serves list-objects requests
+//   (remote pages) - all pages of a given list-objects request come either from the
+//   cache or from the remote backend.
+// * AIS-side PUT and DELETE update the cache incrementally (LsoCacheUpdate) - one page
+//   at a time; other changes - made out-of-band - become visible upon the next refresh.
+// * The cache is persisted (page by page) on a (HRW-selected) mountpath, under the
+//   bucket's directory, and loaded on demand.
+
+const (
+	lsoCacheFname   = "list"           // under fs.LsoCacheType
+	lsoCacheIdle    = 10 * time.Minute // unload (in-memory) entries when not used
+	lsoCacheHkIval  = time.Minute
+	lsoCacheBufSize = 64 * cos.KiB
+
+	lsoCachePageSize = 1000 // (nominal) number of entries per page; split at twice that
+)
+
+// remote properties to cache (local ones are filled-in upon listing - see npgCtx.populate)
+var lsoCacheProps = strings.Join([]string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum,
+	apc.GetPropsVersion, apc.GetPropsCustom}, apc.LsPropsSepa)
+
+type (
+	lsoCache struct {
+		bck        cmn.Bck
+		pages      []*lsoCachePage // sorted by name, non-empty
+		pending    []*LsoCacheUpd  // updates that arrive while refreshing (see splice)
+		refreshed  int64           // time of the last refresh (unix nanoseconds); zero - never
+		lastUsed   atomic.Int64    // mono.NanoTime
+		mtx        sync.RWMutex
+		loaded     bool // in memory (or not persisted at all)
+		dirty      bool // not persisted
+		refreshing atomic.Bool
+	}
+
+	lsoCachePage struct {
+		entries cmn.LsoEntries // sorted by name
+	}
+
+	// incremental update (AIS-side PUT and DELETE);
+	// batched via Add, so that Put and Del never refer to the same name
+	LsoCacheUpd struct {
+		idx map[string]int // name => Put index or, if negative, -(Del index + 1)
+		Put cmn.LsoEntries `json:"put,omitempty"`
+		Del []string       `json:"del,omitempty"`
+	}
+)
+
+var (
+	lsoCaches   sync.Map // bucket ID (cmn.Bprops.BID) => *lsoCache
+	lsoCacheReg sync.Once
+)
+
+// the target that maintains the cache and does remote paging for the bucket
+func LsoCacheOwner(smap *meta.Smap, bck *meta.Bck) (*meta.Snode, error) {
+	return smap.HrwName2T(bck.MakeUname(""))
+}
+
+// remove in-memory and persisted cache, if any
+func LsoCacheInval(bck *meta.Bck) {
+	if bck.Props == nil {
+		return
+	}
+	if v, ok := lsoCaches.LoadAndDelete(bck.Props.BID); ok {
+		lc := v.(*lsoCache)
+		lc.mtx.Lock()
+		lc.pages, lc.pending = nil, nil
+		lc.mtx.Unlock()
+	}
+	if fqn := lsoCacheFQN(bck.Bucket()); fqn != "" {
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Errorln(core.T.String(), "failed to remove list cache", bck.Cname(""), "err:", err)
+		}
+	}
+}
+
+// apply AIS-side changes; no-op if the cache does not exist
+func LsoCacheUpdate(bck *meta.Bck, upd *LsoCacheUpd) {
+	if bck.Props == nil || !bck.Props.ListCache.Enabled {
+		return
+	}
+	v, ok := lsoCaches.Load(bck.Props.BID)
+	if !ok {
+		fqn := lsoCacheFQN(bck.Bucket())
+		if fqn == "" {
+			return
+		}
+		if _, err := os.Stat(fqn); err != nil {
+			return
+		}
+		v = lsoCacheGet(bck)
+	}
+	lc := v.(*lsoCache)
+	if lc.refreshing.Load() {
+		// apply now (if loaded) and, again, when the refresh is done
+		lc.mtx.Lock()
+		lc.pending = append(lc.pending, upd)
+		if lc.loaded {
+			lc.apply(upd)
+			lc.dirty = true
+		}
+		lc.mtx.Unlock()
+		return
+	}
+	if err := lc.load(); err != nil {
+		return
+	}
+	lc.mtx.Lock()
+	lc.apply(upd)
+	lc.dirty = true
+	lc.mtx.Unlock()
+}
+
+// returns the cache iff it can serve the list-objects request that starts now;
+// otherwise, returns nil and, if need be, triggers background refresh
+func lsoCacheFresh(bck *meta.Bck, msg *apc.LsoMsg) *lsoCache {
+	if bck.Props == nil || !bck.Props.ListCache.Enabled {
+		return nil
+	}
+	// not supported: non-recursive listing and listing archived content
+	if msg.IsFlagSet(apc.LsNoRecursion) || msg.IsFlagSet(apc.LsArchDir) {
+		return nil
+	}
+	var (
+		lc  = lsoCacheGet(bck)
+		ttl = bck.Props.ListCache.TTL.D()
+	)
+	if ttl == 0 {
+		ttl = cmn.DefaultLsoCacheTTL
+	}
+	if err := lc.load(); err != nil {
+		lc.refresh(bck)
+		return nil
+	}
+	lc.mtx.RLock()
+	age := time.Duration(time.Now().UnixNano() - lc.refreshed)
+	never := lc.refreshed == 0
+	lc.mtx.RUnlock()
+
+	switch {
+	case never || age > ttl:
+		lc.refresh(bck)
+		return nil
+	case age > ttl/2:
+		lc.refresh(bck) // refresh ahead of time and keep serving the current one
+	}
+	return lc
+}
+
+func lsoCacheGet(bck *meta.Bck) *lsoCache {
+	lsoCacheReg.Do(func() { hk.Reg("lso-cache"+hk.NameSuffix, lsoCacheHk, lsoCacheHkIval) })
+	if v, ok := lsoCaches.Load(bck.Props.BID); ok {
+		return v.(*lsoCache)
+	}
+	lc := &lsoCache{bck: bck.Clone()}
+	lc.lastUsed.Store(mono.NanoTime())
+	v, _ := lsoCaches.LoadOrStore(bck.Props.BID, lc)
+	return v.(*lsoCache)
+}
+
+// (empty string when there are no mountpaths)
+func lsoCacheFQN(bck *cmn.Bck) string {
+	mi, _, err := fs.Hrw(bck.MakeUname(""))
+	if err != nil {
+		return ""
+	}
+	return mi.MakePathFQN(bck, fs.LsoCacheType, lsoCacheFname)
+}
+
+//////////////
+// lsoCache //
+//////////////
+
+// serve remote page: entries that follow the continuation token (the last name
+// in the previous page) and match the prefix
+func (lc *lsoCache) page(msg *apc.LsoMsg) *cmn.LsoResult {
+	var (
+		props    = msg.PropsSet()
+		nameOnly = msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize)
+		pageSize = int(msg.PageSize)
+		lst      = &cmn.LsoResult{UUID: msg.UUID}
+	)
+	if pageSize == 0 {
+		pageSize = apc.DefaultPageSizeCloud
+	}
+	var (
+		prefix = msg.Prefix
+		token  = msg.ContinuationToken
+		first  = func(name string) bool { return name >= prefix && (token == "" || name > token) }
+		n      int
+	)
+	lc.lastUsed.Store(mono.NanoTime())
+	lc.mtx.RLock()
+	p, i := lc.seek(first)
+outer:
+	for ; p < len(lc.pages); p, i = p+1, 0 {
+		entries := lc.pages[p].entries
+		for ; i < len(entries); i++ {
+			src := entries[i]
+			if !strings.HasPrefix(src.Name, prefix) {
+				break outer
+			}
+			if n == pageSize {
+				lst.ContinuationToken = lst.Entries[n-1].Name
+				break outer
+			}
+			if nameOnly {
+				lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: src.Name, Size: src.Size})
+			} else {
+				lst.Entries = append(lst.Entries, src.CopyWithProps(props))
+			}
+			n++
+		}
+	}
+	lc.mtx.RUnlock()
+	return lst
+}
+
+// returns the position (page, index in the page) of the first entry that satisfies
+// the (monotonic) predicate
+func (lc *lsoCache) seek(first func(name string) bool) (p, i int) {
+	p = sort.Search(len(lc.pages), func(p int) bool { return first(lc.pages[p].last()) })
+	if p < len(lc.pages) {
+		entries := lc.pages[p].entries
+		i = sort.Search(len(entries), func(i int) bool { return first(entries[i].Name) })
+	}
+	return p, i
+}
+
+func (lc *lsoCache) numEntries() (n int) {
+	for _, pg := range lc.pages {
+		n += len(pg.entries)
+	}
+	return n
+}
+
+// add PUT (put != nil) or DELETE to the batch; the last operation on a given name wins
+func (upd *LsoCacheUpd) Add(put *cmn.LsoEntry, del string) {
+	name := del
+	if put != nil {
+		name = put.Name
+	}
+	if upd.idx == nil {
+		upd.idx = make(map[string]int, 16)
+	}
+	if i, ok := upd.idx[name]; ok {
+		switch {
+		case i >= 0 && put != nil:
+			upd.Put[i] = put
+			return
+		case i < 0 && put == nil:
+			return
+		default:
+			upd.remove(i)
+		}
+	}
+	if put != nil {
+		upd.idx[name] = len(upd.Put)
+		upd.Put = append(upd.Put, put)
+	} else {
+		upd.idx[name] = -len(upd.Del) - 1
+		upd.Del = append(upd.Del, del)
+	}
+}
+
+// swap with the last and truncate (the order within Put and Del does not matter)
+func (upd *LsoCacheUpd) remove(i int) {
+	if i >= 0 {
+		last := len(upd.Put) - 1
+		if i != last {
+			upd.Put[i] = upd.Put[last]
+			upd.idx[upd.Put[i].Name] = i
+		}
+		upd.Put = upd.Put[:last]
+		return
+	}
+	j, last := -i-1, len(upd.Del)-1
+	if j != last {
+		upd.Del[j] = upd.Del[last]
+		upd.idx[upd.Del[j]] = -j - 1
+	}
+	upd.Del = upd.Del[:last]
+}
+
+// (caller must hold the lock)
+func (lc *lsoCache) apply(upd *LsoCacheUpd) {
+	for _, name := range upd.Del {
+		lc.del(name)
+	}
+	for _, e := range upd.Put {
+		lc.put(e)
+	}
+}
+
+func (lc *lsoCache) del(name string) {
+	p, i := lc.seek(func(s string) bool { return s >= name })
+	if p == len(lc.pages) {
+		return
+	}
+	pg := lc.pages[p]
+	if pg.entries[i].Name != name {
+		return
+	}
+	if len(pg.entries) == 1 {
+		lc.pages = append(lc.pages[:p], lc.pages[p+1:]...)
+		return
+	}
+	pg.entries = append(pg.entries[:i], pg.entries[i+1:]...)
+}
+
+func (lc *lsoCache) put(e *cmn.LsoEntry) {
+	if len(lc.pages) == 0 {
+		lc.pages = append(lc.pages, &lsoCachePage{entries: cmn.LsoEntries{e}})
+		return
+	}
+	name := e.Name
+	p, i := lc.seek(func(s string) bool { return s >= name })
+	if p == len(lc.pages) {
+		p = len(lc.pages) - 1 // append to the last page
+		i = len(lc.pages[p].entries)
+	}
+	pg := lc.pages[p]
+	switch {
+	case i < len(pg.entries) && pg.entries[i].Name == name:
+		pg.entries[i] = e
+		return
+	case i == len(pg.entries):
+		pg.entries = append(pg.entries, e)
+	default:
+		pg.entries = append(pg.entries, nil)
+		copy(pg.entries[i+1:], pg.entries[i:])
+		pg.entries[i] = e
+	}
+	if len(pg.entries) >= 2*lsoCachePageSize {
+		half := len(pg.entries) / 2
+		next := &lsoCachePage{entries: append(cmn.LsoEntries(nil), pg.entries[half:]...)}
+		pg.entries = pg.entries[:half:half]
+		lc.pages = append(lc.pages, nil)
+		copy(lc.pages[p+2:], lc.pages[p+1:])
+		lc.pages[p+1] = next
+	}
+}
+
+// replace cached entries in the range of names (lo, hi] - or (lo, +inf) when `last` -
+// with the (sorted) entries of the remote page that covers the range
+// (caller must hold the lock)
+func (lc *lsoCache) splice(lo, hi string, last bool, entries cmn.LsoEntries) {
+	var (
+		i     = sort.Search(len(lc.pages), func(p int) bool { return lc.pages[p].last() > lo })
+		j     = len(lc.pages)
+		pages = make([]*lsoCachePage, 0, len(lc.pages)+len(entries)/lsoCachePageSize+2)
+	)
+	if !last {
+		j = sort.Search(len(lc.pages), func(p int) bool { return lc.pages[p].last() > hi })
+	}
+	pages = append(pages, lc.pages[:i]...)
+	if i < len(lc.pages) {
+		head := lc.pages[i].entries
+		k := sort.Search(len(head), func(k int) bool { return head[k].Name > lo })
+		if k > 0 {
+			pages = append(pages, &lsoCachePage{entries: head[:k:k]})
+		}
+	}
+	for len(entries) > 0 {
+		n := min(len(entries), lsoCachePageSize)
+		pages = append(pages, &lsoCachePage{entries: entries[:n:n]})
+		entries = entries[n:]
+	}
+	if j < len(lc.pages) {
+		tail := lc.pages[j].entries
+		k := sort.Search(len(tail), func(k int) bool { return tail[k].Name > hi })
+		pages = append(pages, &lsoCachePage{entries: tail[k:]})
+		pages = append(pages, lc.pages[j+1:]...)
+	}
+	lc.pages = pages
+}
+
+//////////////////
+// lsoCachePage //
+//////////////////
+
+func (pg *lsoCachePage) last() string { return pg.entries[len(pg.entries)-1].Name }
+
+// list the entire remote bucket in the background
+func (lc *lsoCache) refresh(bck *meta.Bck) {
+	if !lc.refreshing.CAS(false, true) {
+		return
+	}
+	go lc._refresh(bck.Clone())
+}
+
+// Remote pages replace (splice) cached entries one page at a time; AIS-side updates
+// that arrive in the meantime are applied right away and, again, after each splice
+// (given that the remote page may have been listed before the update).
+// Failure to refresh leaves the cache partially refreshed (and its time unchanged).
+func (lc *lsoCache) _refresh(cbck cmn.Bck) {
+	defer func() {
+		lc.mtx.Lock()
+		lc.pending = nil
+		lc.mtx.Unlock()
+		lc.refreshing.Store(false)
+	}()
+	var (
+		started = time.Now()
+		bck     = meta.CloneBck(&cbck)
+	)
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		nlog.Warningln(core.T.String(), "list cache:", err)
+		return
+	}
+	if err := lc.load(); err != nil {
+		lc.mtx.Lock()
+		lc.pages, lc.refreshed, lc.loaded = nil, 0, true // start from scratch
+		lc.mtx.Unlock()
+	}
+	var (
+		backend = core.T.Backend(bck)
+		msg     = &apc.LsoMsg{Props: lsoCacheProps, PageSize: backend.MaxPageSize()}
+		lo      string // the last name in the previous page (NOTE: continuation tokens may be opaque)
+		total   int
+	)
+	for {
+		lst := &cmn.LsoResult{}
+		if _, err := backend.ListObjects(bck, msg, lst); err != nil {
+			nlog.Warningln(core.T.String(), "failed to refresh list cache", bck.Cname(""), "err:", err)
+			return
+		}
+		var (
+			entries = make(cmn.LsoEntries, 0, len(lst.Entries))
+			hi      = lo
+			last    = lst.ContinuationToken == ""
+		)
+		for _, e := range lst.Entries {
+			hi = max(hi, e.Name)
+			if e.Flags&apc.EntryIsDir == 0 {
+				entries = append(entries, e)
+			}
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+		total += len(entries)
+
+		lc.mtx.Lock()
+		lc.splice(lo, hi, last, entries)
+		for _, upd := range lc.pending {
+			lc.apply(upd)
+		}
+		lc.pending = lc.pending[:0]
+		lc.dirty = true
+		lc.mtx.Unlock()
+
+		if last {
+			break
+		}
+		lo = hi
+		msg.ContinuationToken = lst.ContinuationToken
+		if !bck.Props.ListCache.Enabled {
+			return
+		}
+	}
+
+	lc.mtx.Lock()
+	lc.refreshed = started.UnixNano()
+	lc.mtx.Unlock()
+
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		nlog.Infoln(core.T.String(), "list cache refreshed:", bck.Cname(""), total, time.Since(started))
+	}
+	lc.persist()
+}
+
+// load persisted, if need be
+func (lc *lsoCache) load() error {
+	lc.lastUsed.Store(mono.NanoTime())
+	lc.mtx.RLock()
+	loaded := lc.loaded
+	lc.mtx.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	lc.mtx.Lock()
+	defer lc.mtx.Unlock()
+	if lc.loaded {
+		return nil
+	}
+	fqn := lsoCacheFQN(&lc.bck)
+	if fqn == "" {
+		return cmn.ErrNoMountpaths
+	}
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	finfo, err := fh.Stat()
+	if err != nil {
+		return err
+	}
+	var (
+		pages []*lsoCachePage
+		mr    = msgp.NewReaderSize(fh, lsoCacheBufSize)
+	)
+	// sequence of pages (see _persist)
+	for {
+		if _, err := mr.R.Peek(1); err == io.EOF {
+			break
+		}
+		lst := &cmn.LsoResult{}
+		if err := lst.DecodeMsg(mr); err != nil {
+			nlog.Errorln(core.T.String(), "failed to load list cache", fqn, "err:", err)
+			cos.RemoveFile(fqn)
+			return err
+		}
+		if len(lst.Entries) > 0 {
+			pages = append(pages, &lsoCachePage{entries: lst.Entries})
+		}
+	}
+	// (the refresh time is the file's mtime)
+	lc.pages, lc.refreshed, lc.loaded = pages, finfo.ModTime().UnixNano(), true
+	return nil
+}
+
+func (lc *lsoCache) persist() {
+	fqn := lsoCacheFQN(&lc.bck)
+	if fqn == "" {
+		return
+	}
+	lc.mtx.Lock()
+	defer lc.mtx.Unlock()
+	if !lc.dirty || !lc.loaded {
+		return
+	}
+	err := lc._persist(fqn)
+	if err == nil {
+		// preserve the refresh time
+		tm := time.Unix(0, lc.refreshed)
+		err = os.Chtimes(fqn, tm, tm)
+	}
+	if err != nil {
+		nlog.Errorln(core.T.String(), "failed to persist list cache", fqn, "err:", err)
+		cos.RemoveFile(fqn)
+		return
+	}
+	lc.dirty = false
+}
+
+func (lc *lsoCache) _persist(fqn string) error {
+	wfqn := fqn + ".tmp"
+	fh, err := cos.CreateFile(wfqn)
+	if err != nil {
+		return err
+	}
+	var (
+		bw = bufio.NewWriterSize(fh, lsoCacheBufSize)
+		mw = msgp.NewWriter(bw)
+	)
+	for _, pg := range lc.pages {
+		lst := &cmn.LsoResult{Entries: pg.entries}
+		if err = lst.EncodeMsg(mw); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = mw.Flush()
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		cos.RemoveFile(wfqn)
+		return err
+	}
+	return os.Rename(wfqn, fqn)
+}
+
+// housekeeping: persist changes, unload idle, and drop caches of the buckets
+// this target does not "own" any longer
+func lsoCacheHk() time.Duration {
+	var (
+		smap = core.T.Sowner().Get()
+		bmd  = core.T.Bowner().Get()
+		now  = mono.NanoTime()
+	)
+	lsoCaches.Range(func(k, v any) bool {
+		lc := v.(*lsoCache)
+		bck := meta.CloneBck(&lc.bck)
+		props, present := bmd.Get(bck)
+		if !present || props.BID != k.(uint64) || !props.ListCache.Enabled {
+			lsoCaches.Delete(k)
+			return true
+		}
+		bck.Props = props
+		if tsi, err := LsoCacheOwner(smap, bck); err != nil || tsi.ID() != core.T.SID() {
+			LsoCacheInval(bck)
+			return true
+		}
+		if lc.refreshing.Load() {
+			return true
+		}
+		lc.persist()
+		if time.Duration(now-lc.lastUsed.Load()) > lsoCacheIdle {
+			lc.mtx.Lock()
+			if !lc.dirty {
+				lc.pages, lc.loaded = nil, false
+			}
+			lc.mtx.Unlock()
+		}
+		return true
+	})
+	return lsoCacheHkIval
+}
@@ -0,0 +1,170 @@
+// Package xs - list cache (paging and incremental updates) unit tests.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLsoCachePaging(t *testing.T) {
+	lc := &lsoCache{loaded: true}
+	for _, prefix := range []string{"b/", "a/", "c/"} {
+		upd := &LsoCacheUpd{}
+		for i := 0; i < 10; i++ {
+			upd.Put = append(upd.Put, &cmn.LsoEntry{Name: fmt.Sprintf("%s%02d", prefix, i), Size: int64(i)})
+		}
+		lc.apply(upd)
+	}
+	lc.apply(&LsoCacheUpd{Del: []string{"a/03", "b/00", "nonexistent"}, Put: []*cmn.LsoEntry{{Name: "a/05", Size: 100}}})
+	tassert.Fatalf(t, lc.numEntries() == 28, "expected 28 entries, got %d", lc.numEntries())
+	_lsoCacheCheck(t, lc)
+
+	var (
+		msg   = &apc.LsoMsg{Prefix: "a/", PageSize: 4, Props: apc.GetPropsSize}
+		names []string
+	)
+	for {
+		lst := lc.page(msg)
+		tassert.Fatalf(t, len(lst.Entries) <= 4, "page size exceeded: %d", len(lst.Entries))
+		for _, e := range lst.Entries {
+			names = append(names, e.Name)
+			if e.Name == "a/05" {
+				tassert.Errorf(t, e.Size == 100, "expected updated size, got %d", e.Size)
+			}
+		}
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	tassert.Fatalf(t, len(names) == 9, "expected 9 names with prefix %q, got %v", "a/", names)
+	tassert.Errorf(t, names[0] == "a/00" && names[8] == "a/09", "unexpected first/last: %v", names)
+}
+
+// pages must be non-empty and the entries - sorted across pages
+func _lsoCacheCheck(t *testing.T, lc *lsoCache) (names []string) {
+	for _, pg := range lc.pages {
+		tassert.Fatalf(t, len(pg.entries) > 0, "empty page")
+		tassert.Fatalf(t, len(pg.entries) < 2*lsoCachePageSize, "page not split: %d", len(pg.entries))
+		for _, e := range pg.entries {
+			if len(names) > 0 {
+				tassert.Fatalf(t, names[len(names)-1] < e.Name, "not sorted: %q, %q", names[len(names)-1], e.Name)
+			}
+			names = append(names, e.Name)
+		}
+	}
+	return names
+}
+
+func _lsoCacheList(t *testing.T, lc *lsoCache, prefix string, pageSize uint) (names []string) {
+	msg := &apc.LsoMsg{Prefix: prefix, PageSize: pageSize}
+	for {
+		lst := lc.page(msg)
+		tassert.Fatalf(t, len(lst.Entries) <= int(pageSize), "page size exceeded: %d", len(lst.Entries))
+		for _, e := range lst.Entries {
+			names = append(names, e.Name)
+		}
+		if lst.ContinuationToken == "" {
+			return names
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func TestLsoCacheSplitPages(t *testing.T) {
+	const num = 5 * lsoCachePageSize
+	lc := &lsoCache{loaded: true}
+	// insert in reverse order, one at a time
+	for i := num - 1; i >= 0; i-- {
+		lc.apply(&LsoCacheUpd{Put: cmn.LsoEntries{{Name: fmt.Sprintf("o-%05d", i)}}})
+	}
+	names := _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, len(names) == num, "expected %d entries, got %d", num, len(names))
+	tassert.Errorf(t, len(lc.pages) > 2, "expected multiple pages, got %d", len(lc.pages))
+
+	// delete every other
+	upd := &LsoCacheUpd{}
+	for i := 0; i < num; i += 2 {
+		upd.Del = append(upd.Del, fmt.Sprintf("o-%05d", i))
+	}
+	lc.apply(upd)
+	names = _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, len(names) == num/2, "expected %d entries, got %d", num/2, len(names))
+
+	// page across page boundaries
+	listed := _lsoCacheList(t, lc, "", 333)
+	tassert.Fatalf(t, len(listed) == len(names), "expected %d names, got %d", len(names), len(listed))
+	for i := range listed {
+		tassert.Fatalf(t, listed[i] == names[i], "expected %q, got %q", names[i], listed[i])
+	}
+	listed = _lsoCacheList(t, lc, "o-01", 100)
+	tassert.Fatalf(t, len(listed) == 500, "expected %d names with prefix %q, got %d", 500, "o-01", len(listed))
+}
+
+// batched PUT and DELETE of the same name: the last one wins
+func TestLsoCacheBatch(t *testing.T) {
+	lc := &lsoCache{loaded: true}
+	lc.apply(&LsoCacheUpd{Put: cmn.LsoEntries{{Name: "a"}, {Name: "b"}}})
+
+	upd := &LsoCacheUpd{}
+	upd.Add(&cmn.LsoEntry{Name: "c"}, "") // put-then-delete
+	upd.Add(nil, "c")
+	upd.Add(nil, "a") // delete-then-put
+	upd.Add(&cmn.LsoEntry{Name: "a", Size: 2}, "")
+	upd.Add(&cmn.LsoEntry{Name: "d"}, "") // put, delete, and put again
+	upd.Add(nil, "d")
+	upd.Add(&cmn.LsoEntry{Name: "d", Size: 3}, "")
+	upd.Add(nil, "b") // delete twice
+	upd.Add(nil, "b")
+	tassert.Errorf(t, len(upd.Put) == 2 && len(upd.Del) == 2, "expected 2 puts and 2 deletes, got %d and %v",
+		len(upd.Put), upd.Del)
+
+	lc.apply(upd)
+	names := _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, strings.Join(names, ",") == "a,d", "expected a,d, got %v", names)
+	for _, e := range lc.pages[0].entries {
+		tassert.Errorf(t, e.Size > 1, "%s: expected the last put, got size %d", e.Name, e.Size)
+	}
+}
+
+func TestLsoCacheSplice(t *testing.T) {
+	lc := &lsoCache{loaded: true}
+	upd := &LsoCacheUpd{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		upd.Put = append(upd.Put, &cmn.LsoEntry{Name: name})
+	}
+	lc.apply(upd)
+
+	newEntries := func(names ...string) (entries cmn.LsoEntries) {
+		for _, name := range names {
+			entries = append(entries, &cmn.LsoEntry{Name: name, Size: 1})
+		}
+		return entries
+	}
+	// remote page #1: ("", "c"] - "b" gone, "bb" added
+	lc.splice("", "c", false, newEntries("a", "bb", "c"))
+	names := _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, strings.Join(names, ",") == "a,bb,c,d,e,f,g,h", "page #1: got %v", names)
+
+	// remote page #2: ("c", "f"] - "d" and "e" gone, "ee" added
+	lc.splice("c", "f", false, newEntries("ee", "f"))
+	names = _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, strings.Join(names, ",") == "a,bb,c,ee,f,g,h", "page #2: got %v", names)
+
+	// last remote page: ("f", +inf) - "g" and "h" gone, "z" added
+	lc.splice("f", "z", true, newEntries("z"))
+	names = _lsoCacheCheck(t, lc)
+	tassert.Fatalf(t, strings.Join(names, ",") == "a,bb,c,ee,f,z", "last page: got %v", names)
+
+	// empty bucket
+	lc.splice("", "", true, nil)
+	tassert.Fatalf(t, len(lc.pages) == 0, "expected empty cache, got %d pages", len(lc.pages))
+}
@@ -95,6 +95,15 @@ func (npg *npgCtx) nextPageR(nentries cmn.LsoEntries, inclStatusLocalMD bool) (*
 	return lst, err
 }
 
+// Same as above, with the remote page served from the list cache (see lso_cache.go).
+func (npg *npgCtx) nextPageC(lc *lsoCache, inclStatusLocalMD bool) (lst *cmn.LsoResult, err error) {
+	lst = lc.page(npg.wi.msg)
+	if inclStatusLocalMD {
+		err = npg.populate(lst)
+	}
+	return lst, err
+}
+
 func (npg *npgCtx) populate(lst *cmn.LsoResult) error {
 	post := npg.wi.lomVisitedCb
 	for _, obj := range lst.Entries {
@@ -220,6 +220,10 @@ func (r *XactNsumm) initRes(res *cmn.BsummResult, bck *meta.Bck) {
 	res.TotalSize.Disks = r.totalDiskSize
 	res.ObjSize.Min = math.MaxInt64
 	res.TotalSize.OnDisk = fs.OnDiskSize(bck.Bucket(), r.p.msg.Prefix)
+	if bck.Props != nil && bck.Props.Quota.IsSet() {
+		res.Quota.MaxSize, res.Quota.MaxObjects = int64(bck.Props.Quota.MaxSize), bck.Props.Quota.MaxObjects
+		res.Quota.Size, res.Quota.Objects, _ = core.QuotaUsage(bck)
+	}
 }
 
 func (r *XactNsumm) String() string { return r._str }
@@ -251,6 +255,7 @@ func (r *XactNsumm) Result() (cmn.AllBsummResults, error) {
 func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 	dst.Bck = src.Bck
 	dst.TotalSize.OnDisk = src.TotalSize.OnDisk
+	dst.Quota = src.Quota
 
 	dst.ObjCount.Present = ratomic.LoadUint64(&src.ObjCount.Present)
 	dst.TotalSize.PresentObjs = ratomic.LoadUint64(&src.TotalSize.PresentObjs)
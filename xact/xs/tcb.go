@@ -178,6 +178,12 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 		Throttle: true, // always trottling
 	}
 	mpopts.Bck.Copy(p.args.BckFrom.Bucket())
+
+	// resumable unless synchronizing (in which case prune relies on visiting each and every source object)
+	if msg := p.args.Msg; !msg.Sync && !msg.DryRun {
+		mpopts.Checkpoint = xact.CheckpointTag(p.kind, p.args.BckFrom.MakeUname(""), p.args.BckTo.MakeUname(""),
+			msg.Prefix, msg.Prepend, msg.Transform.Name)
+	}
 	r.BckJog.Init(p.UUID(), p.kind, p.args.BckTo, mpopts, config)
 
 	if p.args.Msg.Sync {
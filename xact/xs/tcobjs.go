@@ -172,6 +172,9 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			// run
 			var wg *sync.WaitGroup
 			if err = lrit.init(r, &msg.ListRange, r.Bck()); err == nil {
+				if !msg.Sync && !msg.DryRun {
+					lrit.resumable(r.Kind(), msg.ToBck.MakeUname(""), msg.Prepend, msg.Transform.Name)
+				}
 				if msg.Sync && lrit.lrp != lrpList {
 					wg = &sync.WaitGroup{}
 					wg.Add(1)
@@ -0,0 +1,199 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Lifecycle-driven tiering (see cmn.TieringConf): walk the ("hot") bucket and move
+// objects that haven't been accessed for at least `tiering.age` to the ("cold")
+// destination bucket, leaving behind stubs (core.LOM.ToTierStub).
+// Stubs get promoted back upon GET (see ais/tgtobj.go).
+
+type (
+	tierFactory struct {
+		xreg.RenewBase
+		xctn *XactTier
+	}
+	XactTier struct {
+		dst  *meta.Bck
+		conf cmn.TieringConf
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactTier)(nil)
+	_ xreg.Renewable = (*tierFactory)(nil)
+)
+
+/////////////////
+// tierFactory //
+/////////////////
+
+func (*tierFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &tierFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *tierFactory) Start() error {
+	conf := p.Bck.Props.Tiering
+	if !conf.Enabled {
+		return fmt.Errorf("%s: tiering is disabled", p.Bck)
+	}
+	b, err := conf.DstBck()
+	if err != nil {
+		return err
+	}
+	dst := meta.CloneBck(&b)
+	if err := dst.Init(core.T.Bowner()); err != nil {
+		return err
+	}
+	if dst.Equal(p.Bck, true /*same ID*/, true /*same backend*/) {
+		return fmt.Errorf("%s: tiering destination cannot be the bucket itself", p.Bck)
+	}
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newXactTier(p.UUID(), p.Bck, dst, &conf, slab)
+	return nil
+}
+
+func (*tierFactory) Kind() string     { return apc.ActTier }
+func (p *tierFactory) Get() core.Xact { return p.xctn }
+
+func (p *tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	err = fmt.Errorf("%s is currently running, cannot start a new %q", prevEntry.Get(), p.Str(p.Kind()))
+	return
+}
+
+//////////////
+// XactTier //
+//////////////
+
+// NOTE: always throttling
+func newXactTier(uuid string, bck, dst *meta.Bck, conf *cmn.TieringConf, slab *memsys.Slab) (r *XactTier) {
+	r = &XactTier{dst: dst, conf: *conf}
+	mpopts := &mpather.JgroupOpts{
+		CTs:                   []string{fs.ObjectType},
+		VisitObj:              r.visitObj,
+		Slab:                  slab,
+		DoLoad:                mpather.Load,
+		SkipGloballyMisplaced: true,
+		Throttle:              true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActTier, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactTier) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name(), "=>", r.dst.Cname(""))
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactTier) visitObj(lom *core.LOM, buf []byte) error {
+	if _, ok := lom.TierDst(); ok {
+		return nil // already a stub
+	}
+	if time.Since(lom.Atime()) < r.conf.Age.D() {
+		return nil
+	}
+	prev := cmn.ObjAttrs{Size: lom.SizeBytes(), Ver: lom.Version(), Cksum: lom.Checksum()}
+
+	// 1. copy
+	coiParams := core.AllocCOI()
+	{
+		coiParams.Xact = r
+		coiParams.Config = r.Config
+		coiParams.BckTo = r.dst
+		coiParams.ObjnameTo = lom.ObjName
+		coiParams.Buf = buf
+		if r.dst.IsRemote() {
+			coiParams.DP = &core.LDP{} // write through to the remote backend
+		}
+	}
+	size, err := core.T.CopyObject(lom, nil /*DM*/, coiParams) // (PUT to the destination's HRW target)
+	core.FreeCOI(coiParams)
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil
+		}
+		if cos.IsErrOOS(err) {
+			r.Abort(err)
+			return err
+		}
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return nil
+	}
+
+	// 2. replace with stub unless modified in the meantime
+	lom.Lock(true)
+	err = r.stub(lom, &prev)
+	lom.Unlock(true)
+	switch {
+	case err == nil:
+		if cmn.Rom.FastV(5, cos.SmoduleXs) {
+			nlog.Infoln(r.Name()+":", lom.Cname(), "=>", r.dst.Cname(lom.ObjName), size)
+		}
+	case errors.Is(err, cmn.ErrSkip):
+		// (skipping)
+	default:
+		r.AddErr(err, 4, cos.SmoduleXs)
+	}
+	return nil
+}
+
+// under wlock
+func (r *XactTier) stub(lom *core.LOM, prev *cmn.ObjAttrs) error {
+	lom.Uncache()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return cmn.ErrSkip
+		}
+		return err
+	}
+	if _, ok := lom.TierDst(); ok {
+		return cmn.ErrSkip
+	}
+	if lom.SizeBytes() != prev.Size || lom.Version() != prev.Version() ||
+		(!prev.Cksum.IsEmpty() && !lom.EqCksum(prev.Cksum)) {
+		return cmn.ErrSkip // overwritten while being copied
+	}
+	return lom.ToTierStub(r.dst)
+}
+
+func (r *XactTier) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.SrcBck, snap.DstBck = r.Bck().Clone(), r.dst.Clone()
+	return
+}
@@ -52,10 +52,14 @@ func (wi *walkInfo) setWanted(e *cmn.LsoEntry, lom *core.LOM) {
 		case apc.GetPropsCached: // via obj.SetPresent()
 
 		case apc.GetPropsSize:
-			if e.Size > 0 && lom.SizeBytes() != e.Size {
+			size := lom.SizeBytes()
+			if tsize, ok := lom.TierSize(); ok {
+				size = tsize // stub
+			}
+			if e.Size > 0 && size != e.Size {
 				e.SetVerChanged()
 			}
-			e.Size = lom.SizeBytes()
+			e.Size = size
 		case apc.GetPropsVersion:
 			e.Version = lom.Version()
 		case apc.GetPropsChecksum: